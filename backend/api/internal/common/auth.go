@@ -0,0 +1,33 @@
+package common
+
+import "context"
+
+type authUserKey struct{}
+
+// AuthUser 当前请求的登录用户，由UserAuth中间件从JWT中解析后注入context
+type AuthUser struct {
+	UserID         string // 用户ID（即Token的subject）
+	Username       string // 用户名
+	Role           string // 用户角色
+	TokenID        string // 访问Token的jti，登出时加入黑名单
+	TokenExpiresAt int64  // 访问Token的过期时间（Unix秒）
+}
+
+// WithAuthUser 将登录用户写入context
+func WithAuthUser(ctx context.Context, user *AuthUser) context.Context {
+	return context.WithValue(ctx, authUserKey{}, user)
+}
+
+// AuthUserFromContext 从context中获取登录用户
+func AuthUserFromContext(ctx context.Context) (*AuthUser, bool) {
+	user, ok := ctx.Value(authUserKey{}).(*AuthUser)
+	return user, ok && user != nil
+}
+
+// UserIDFromContext 从context中获取登录用户ID，未登录时返回空字符串
+func UserIDFromContext(ctx context.Context) string {
+	if user, ok := AuthUserFromContext(ctx); ok {
+		return user.UserID
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// ClientIP 客户端IP，trustedProxies 为网关前可信反向代理的层数，为0时不信任 X-Forwarded-For
+// 代理只会在 X-Forwarded-For 末尾追加地址，客户端可以伪造前面的地址，因此从右往左跳过可信代理追加的地址：
+// 取从右往左数第 trustedProxies 个地址，地址数不足时说明全部由可信代理追加，取第一个
+func ClientIP(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var entries []string
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			for _, entry := range strings.Split(value, ",") {
+				if entry = strings.TrimSpace(entry); entry != "" {
+					entries = append(entries, entry)
+				}
+			}
+		}
+		if len(entries) > 0 {
+			return entries[max(len(entries)-trustedProxies, 0)]
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// WithClientIP 将客户端IP写入context
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// ClientIPFromContext 从context中获取客户端IP，未写入时返回空字符串
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		forwarded []string
+		hops      int
+		want      string
+	}{
+		{name: "不信任时忽略请求头", forwarded: []string{"6.6.6.6"}, hops: 0, want: "10.0.0.1"},
+		{name: "没有请求头时取连接地址", hops: 1, want: "10.0.0.1"},
+		{name: "单层代理", forwarded: []string{"1.2.3.4"}, hops: 1, want: "1.2.3.4"},
+		{name: "忽略伪造的前置地址", forwarded: []string{"6.6.6.6, 1.2.3.4"}, hops: 1, want: "1.2.3.4"},
+		{name: "多个请求头按顺序合并", forwarded: []string{"6.6.6.6", "1.2.3.4"}, hops: 1, want: "1.2.3.4"},
+		{name: "跳过多层可信代理", forwarded: []string{"6.6.6.6, 1.2.3.4, 172.16.0.2"}, hops: 2, want: "1.2.3.4"},
+		{name: "地址数少于代理层数", forwarded: []string{"1.2.3.4"}, hops: 2, want: "1.2.3.4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = "10.0.0.1:12345"
+			for _, value := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if got := ClientIP(r, tt.hops); got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
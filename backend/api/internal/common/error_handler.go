@@ -45,6 +45,8 @@ func HandleRPCError(err error, successMessage string) types.BaseResp {
 		httpCode = 404
 	case codes.AlreadyExists:
 		httpCode = 409
+	case codes.ResourceExhausted:
+		httpCode = 429
 	case codes.Internal:
 		httpCode = 500
 	default:
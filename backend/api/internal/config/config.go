@@ -1,12 +1,23 @@
 package config
 
 import (
+	"backend/api/internal/ratelimit"
+	"backend/utils"
+
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type Config struct {
 	rest.RestConf
+	// JWT配置，需与RPC服务签发Token使用的密钥一致（非对称签名时只需公钥）
+	JWT utils.JWTConfig
+	// Token黑名单查询结果的本地缓存时间（秒）
+	TokenRevocationCacheSeconds int64 `json:",default=10"`
 	// RPC服务配置
 	SuperRpc zrpc.RpcClientConf `json:"SuperRpc"`
+	// AI同步请求调用RPC服务的超时（毫秒），需大于RPC服务中AIRequest方法的超时，其余调用使用SuperRpc.Timeout
+	AIRequestTimeout int64 `json:",default=70000"`
+	// 按路由组的限流配置
+	RateLimit ratelimit.Config `json:",optional"`
 }
@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"net/http"
+
+	"backend/api/internal/logic/admin"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func RevokeUserRoleHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.RevokeUserRoleReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewRevokeUserRoleLogic(r.Context(), svcCtx)
+		resp, err := l.RevokeUserRole(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend/api/internal/logic/ai"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logc"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func AiRequestStreamHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AIRequestReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// SSE连接需要长时间保持，移除http.Server默认的写超时
+		rc := http.NewResponseController(w)
+		if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+			logc.Debugf(r.Context(), "无法清除SSE连接的写超时: %v", err)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+
+		client := make(chan *types.AIStreamChunk, 16)
+
+		l := ai.NewAiRequestStreamLogic(r.Context(), svcCtx)
+		threading.GoSafeCtx(r.Context(), func() {
+			defer close(client)
+			if err := l.AiRequestStream(&req, client); err != nil {
+				logc.Errorw(r.Context(), "AiRequestStreamHandler", logc.Field("error", err))
+			}
+		})
+
+		for {
+			select {
+			case data, ok := <-client:
+				if !ok {
+					return
+				}
+
+				output, err := json.Marshal(data)
+				if err != nil {
+					logc.Errorw(r.Context(), "AiRequestStreamHandler", logc.Field("error", err))
+					continue
+				}
+
+				if _, err := fmt.Fprintf(w, "data: %s\n\n", output); err != nil {
+					logc.Errorw(r.Context(), "AiRequestStreamHandler", logc.Field("error", err))
+					return
+				}
+				if err := rc.Flush(); err != nil {
+					logc.Errorw(r.Context(), "AiRequestStreamHandler", logc.Field("error", err))
+					return
+				}
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package ai
+
+import (
+	"net/http"
+
+	"backend/api/internal/logic/ai"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func GetAICreditLedgerHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GetAICreditLedgerReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := ai.NewGetAICreditLedgerLogic(r.Context(), svcCtx)
+		resp, err := l.GetAICreditLedger(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package payment
+
+import (
+	"io"
+	"net/http"
+
+	"backend/api/internal/common"
+	"backend/api/internal/logic/payment"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func PaymentNotifyHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PaymentNotifyReq
+		if err := httpx.ParsePath(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 签名基于原始请求体计算，不能先按JSON解析
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := payment.NewPaymentNotifyLogic(r.Context(), svcCtx)
+		ack, err := l.PaymentNotify(&req, body, r.Header)
+		if err != nil {
+			// 返回非2xx状态码，支付平台会稍后重试
+			resp := common.HandleRPCError(err, "")
+			httpx.WriteJsonCtx(r.Context(), w, resp.Code, resp)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(ack))
+	}
+}
@@ -5,8 +5,11 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
+	admin "backend/api/internal/handler/admin"
 	ai "backend/api/internal/handler/ai"
+	payment "backend/api/internal/handler/payment"
 	user "backend/api/internal/handler/user"
 	vip "backend/api/internal/handler/vip"
 	"backend/api/internal/svc"
@@ -16,142 +19,406 @@ import (
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/ai/request",
-				Handler: ai.AiRequestHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/ai/usage",
-				Handler: ai.GetAIUsageHandler(serverCtx),
-			},
-		},
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserAuth, serverCtx.RateLimitUser, serverCtx.PermRoleManage},
+			[]rest.Route{
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/admin/user/:user_id/role",
+					Handler: admin.GrantUserRoleHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/admin/user/:user_id/role",
+					Handler: admin.RevokeUserRoleHandler(serverCtx),
+				},
+			}...,
+		),
 	)
 
 	server.AddRoutes(
-		[]rest.Route{
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/ai/usage/:user_id",
-				Handler: user.GetAIUsageHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/ai/usage/:user_id",
-				Handler: user.UpdateAIUsageHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id",
-				Handler: user.GetUserInfoHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPut,
-				Path:    "/api/user/:user_id",
-				Handler: user.UpdateUserInfoHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodDelete,
-				Path:    "/api/user/:user_id",
-				Handler: user.DeleteUserHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id/detail",
-				Handler: user.GetUserHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPut,
-				Path:    "/api/user/:user_id/password",
-				Handler: user.UpdateUserPasswordHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/user/:user_id/vip",
-				Handler: user.UpdateUserVipHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id/vip",
-				Handler: user.GetUserVipStatusHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id/vip/active",
-				Handler: user.GetUserActiveVipRecordHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPut,
-				Path:    "/api/user/:user_id/vip/auto-renew",
-				Handler: user.UpdateAutoRenewHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id/vip/check",
-				Handler: user.CheckUserVipHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id/vip/orders",
-				Handler: user.GetVipOrdersHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/user/:user_id/vip/orders",
-				Handler: user.CreateVipOrderHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/user/:user_id/vip/records",
-				Handler: user.GetVipHistoryHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/user/:user_id/vip/sync",
-				Handler: user.SyncUserVipStatusHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/user/login",
-				Handler: user.LoginHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/user/register",
-				Handler: user.RegisterHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/users",
-				Handler: user.GetUsersHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/users/count",
-				Handler: user.GetUserCountHandler(serverCtx),
-			},
-		},
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserAuth, serverCtx.RateLimitAI},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/ai/credits",
+					Handler: ai.GetAICreditsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/ai/credits/ledger",
+					Handler: ai.GetAICreditLedgerHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/ai/request",
+					Handler: ai.AiRequestHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/ai/request/stream",
+					Handler: ai.AiRequestStreamHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/ai/usage",
+					Handler: ai.GetAIUsageHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithTimeout(75000*time.Millisecond),
 	)
 
 	server.AddRoutes(
 		[]rest.Route{
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/vip/plans",
-				Handler: vip.GetVipPlansHandler(serverCtx),
-			},
 			{
 				Method:  http.MethodPost,
-				Path:    "/api/vip/plans",
-				Handler: vip.CreateVipPlanHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodGet,
-				Path:    "/api/vip/plans/:plan_id",
-				Handler: vip.GetVipPlanHandler(serverCtx),
+				Path:    "/api/payment/notify/:provider",
+				Handler: payment.PaymentNotifyHandler(serverCtx),
 			},
 		},
 	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RateLimitUser},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/oauth/:provider/authorize",
+					Handler: user.AuthorizeOAuthHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/oauth/:provider/login",
+					Handler: user.OauthLoginHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/oauth/providers",
+					Handler: user.GetOAuthProvidersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/login",
+					Handler: user.LoginHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/login/2fa",
+					Handler: user.VerifyTwoFactorLoginHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/password/forgot",
+					Handler: user.ForgotPasswordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/password/reset",
+					Handler: user.ResetPasswordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/refresh",
+					Handler: user.RefreshTokenHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/register",
+					Handler: user.RegisterHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/verify-email",
+					Handler: user.VerifyEmailHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserAuth, serverCtx.RateLimitUser},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/ai/usage/:user_id",
+					Handler: user.GetAIUsageHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/ai/usage/:user_id",
+					Handler: user.UpdateAIUsageHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/ai/usage/:user_id/history",
+					Handler: user.GetAIUsageHistoryHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id",
+					Handler: user.GetUserInfoHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/user/:user_id",
+					Handler: user.UpdateUserInfoHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/2fa",
+					Handler: user.GetTwoFactorStatusHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/2fa/disable",
+					Handler: user.DisableTwoFactorHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/2fa/enable",
+					Handler: user.EnableTwoFactorHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/2fa/setup",
+					Handler: user.SetupTwoFactorHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/deletion",
+					Handler: user.GetAccountDeletionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/deletion",
+					Handler: user.RequestAccountDeletionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/deletion",
+					Handler: user.CancelAccountDeletionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/detail",
+					Handler: user.GetUserHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/export",
+					Handler: user.StartDataExportHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/export/:export_id",
+					Handler: user.GetDataExportHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/identities",
+					Handler: user.GetUserIdentitiesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/identities/:provider",
+					Handler: user.UnlinkUserIdentityHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/identities/:provider/authorize",
+					Handler: user.AuthorizeUserIdentityHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/identities/:provider/link",
+					Handler: user.LinkUserIdentityHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/user/:user_id/password",
+					Handler: user.UpdateUserPasswordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/verify-email/send",
+					Handler: user.SendVerificationEmailHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/vip",
+					Handler: user.GetUserVipStatusHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/vip/active",
+					Handler: user.GetUserActiveVipRecordHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/user/:user_id/vip/auto-renew",
+					Handler: user.UpdateAutoRenewHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/vip/check",
+					Handler: user.CheckUserVipHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/vip/orders",
+					Handler: user.GetVipOrdersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/vip/orders",
+					Handler: user.CreateVipOrderHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/vip/orders/:order_no/cancel",
+					Handler: user.CancelVipOrderHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/vip/orders/:order_no/pay",
+					Handler: user.PayVipOrderHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/vip/records",
+					Handler: user.GetVipHistoryHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/vip/sync",
+					Handler: user.SyncUserVipStatusHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/logout",
+					Handler: user.LogoutHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserAuth, serverCtx.RateLimitUser, serverCtx.PermUserWrite},
+			[]rest.Route{
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id",
+					Handler: user.DeleteUserHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/2fa/reset",
+					Handler: user.ResetTwoFactorHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/restore",
+					Handler: user.RestoreUserHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/unlock",
+					Handler: user.UnlockUserHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/vip",
+					Handler: user.UpdateUserVipHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/users/reconcile",
+					Handler: user.ReconcileUsersHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserAuth, serverCtx.RateLimitUser, serverCtx.PermUserRead},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/users",
+					Handler: user.GetUsersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/users/count",
+					Handler: user.GetUserCountHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/users/deleted",
+					Handler: user.GetDeletedUsersHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RateLimitUser},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/export/download",
+					Handler: user.DownloadDataExportHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithTimeout(600000*time.Millisecond),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RateLimitVip},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/vip/credit-packs",
+					Handler: vip.GetCreditPacksHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/vip/plans",
+					Handler: vip.GetVipPlansHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/vip/plans/:plan_id",
+					Handler: vip.GetVipPlanHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.UserAuth, serverCtx.RateLimitVip, serverCtx.PermPlanWrite},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/vip/credit-packs",
+					Handler: vip.CreateCreditPackHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/vip/plans",
+					Handler: vip.CreateVipPlanHandler(serverCtx),
+				},
+			}...,
+		),
+	)
 }
@@ -0,0 +1,31 @@
+package user
+
+import (
+	"net/http"
+
+	"backend/api/internal/common"
+	"backend/api/internal/logic/user"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func CancelAccountDeletionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GetUserInfoReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 客户端IP用于注销审计
+		ctx := common.WithClientIP(r.Context(), common.ClientIP(r, svcCtx.Config.RateLimit.ProxyHops()))
+		l := user.NewCancelAccountDeletionLogic(ctx, svcCtx)
+		resp, err := l.CancelAccountDeletion(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
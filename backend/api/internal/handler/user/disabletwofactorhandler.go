@@ -0,0 +1,31 @@
+package user
+
+import (
+	"net/http"
+
+	"backend/api/internal/common"
+	"backend/api/internal/logic/user"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func DisableTwoFactorHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DisableTwoFactorReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 客户端IP用于按来源限制验证码错误次数
+		ctx := common.WithClientIP(r.Context(), common.ClientIP(r, svcCtx.Config.RateLimit.ProxyHops()))
+		l := user.NewDisableTwoFactorLogic(ctx, svcCtx)
+		resp, err := l.DisableTwoFactor(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
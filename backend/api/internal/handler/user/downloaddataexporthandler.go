@@ -0,0 +1,39 @@
+package user
+
+import (
+	"net/http"
+	"time"
+
+	"backend/api/internal/common"
+	"backend/api/internal/logic/user"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func DownloadDataExportHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DownloadDataExportReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 导出文件可能较大，移除http.Server默认的写超时
+		rc := http.NewResponseController(w)
+		if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+			logc.Debugf(r.Context(), "无法清除下载连接的写超时: %v", err)
+		}
+
+		// 客户端IP用于下载审计
+		ctx := common.WithClientIP(r.Context(), common.ClientIP(r, svcCtx.Config.RateLimit.ProxyHops()))
+		l := user.NewDownloadDataExportLogic(ctx, svcCtx)
+		if err := l.DownloadDataExport(&req, w); err != nil {
+			// 链接在浏览器中直接打开，以HTTP状态码返回错误
+			resp := common.HandleRPCError(err, "")
+			httpx.WriteJsonCtx(r.Context(), w, resp.Code, resp)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"backend/api/internal/common"
 	"backend/api/internal/logic/user"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
@@ -37,7 +38,9 @@ func LoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
-		l := user.NewLoginLogic(r.Context(), svcCtx)
+		// 客户端IP用于按来源限制登录失败次数
+		ctx := common.WithClientIP(r.Context(), common.ClientIP(r, svcCtx.Config.RateLimit.ProxyHops()))
+		l := user.NewLoginLogic(ctx, svcCtx)
 		resp, err := l.Login(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
@@ -0,0 +1,31 @@
+package user
+
+import (
+	"net/http"
+
+	"backend/api/internal/common"
+	"backend/api/internal/logic/user"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func OauthLoginHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.OAuthLoginReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 客户端IP用于登录审计和两步验证失败计数
+		ctx := common.WithClientIP(r.Context(), common.ClientIP(r, svcCtx.Config.RateLimit.ProxyHops()))
+		l := user.NewOauthLoginLogic(ctx, svcCtx)
+		resp, err := l.OauthLogin(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
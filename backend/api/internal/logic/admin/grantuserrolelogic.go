@@ -0,0 +1,49 @@
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GrantUserRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGrantUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GrantUserRoleLogic {
+	return &GrantUserRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GrantUserRoleLogic) GrantUserRole(req *types.GrantUserRoleReq) (resp *types.SetUserRoleResp, err error) {
+	// 调用RPC服务设置角色
+	rpcResp, err := l.svcCtx.SuperRpcClient.SetUserRole(l.ctx, &super.SetUserRoleReq{
+		UserId:     req.UserId,
+		Role:       req.Role,
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.SetUserRoleResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.SetUserRoleResp{
+		BaseResp: common.HandleRPCError(nil, "授予角色成功"),
+		Data: types.UserRoleData{
+			UserId: rpcResp.UserId,
+			Role:   rpcResp.Role,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/model"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserRoleLogic {
+	return &RevokeUserRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeUserRoleLogic) RevokeUserRole(req *types.RevokeUserRoleReq) (resp *types.SetUserRoleResp, err error) {
+	// 调用RPC服务设置角色
+	rpcResp, err := l.svcCtx.SuperRpcClient.SetUserRole(l.ctx, &super.SetUserRoleReq{
+		UserId:     req.UserId,
+		Role:       model.RoleUser,
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.SetUserRoleResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.SetUserRoleResp{
+		BaseResp: common.HandleRPCError(nil, "撤销角色成功"),
+		Data: types.UserRoleData{
+			UserId: rpcResp.UserId,
+			Role:   rpcResp.Role,
+		},
+	}, nil
+}
@@ -2,11 +2,15 @@ package ai
 
 import (
 	"context"
+	"time"
 
+	"backend/api/internal/common"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
+	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type AiRequestLogic struct {
@@ -24,7 +28,51 @@ func NewAiRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AiReque
 }
 
 func (l *AiRequestLogic) AiRequest(req *types.AIRequestReq) (resp *types.AIRequestResp, err error) {
-	// todo: add your logic here and delete this line
+	// 调用RPC服务，由RPC服务完成额度校验、模型调用和次数扣减
+	// 需要等待模型返回，使用单独的超时，避免模型已计费但请求因默认超时失败
+	rpcResp, err := l.svcCtx.SuperRpcClient.AIRequest(l.ctx, &super.AIRequestReq{
+		UserId:    common.UserIDFromContext(l.ctx),
+		Prompt:    req.Prompt,
+		UsageType: req.UsageType,
+		ModelTier: req.ModelTier,
+	}, zrpc.WithCallTimeout(time.Duration(l.svcCtx.Config.AIRequestTimeout)*time.Millisecond))
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.AIRequestResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	// 转换为API响应
+	return &types.AIRequestResp{
+		BaseResp: common.HandleRPCError(nil, "AI请求成功"),
+		Data: types.AIResponseData{
+			Response: rpcResp.Response,
+			Model:    rpcResp.Model,
+			Usage:    toAIUsageData(rpcResp.Usage),
+		},
+	}, nil
+}
 
-	return
+// toAIUsageData 将RPC的AI使用量转换为API结构
+func toAIUsageData(usage *super.AIUsageData) types.AIUsageData {
+	if usage == nil {
+		return types.AIUsageData{}
+	}
+
+	return types.AIUsageData{
+		IsVip:           usage.IsVip,
+		AIChatCount:     int(usage.AiChatCount),
+		AIChatLimit:     int(usage.AiChatLimit),
+		AIContentCount:  int(usage.AiContentCount),
+		AIContentLimit:  int(usage.AiContentLimit),
+		AIAnalysisCount: int(usage.AiAnalysisCount),
+		AIAnalysisLimit: int(usage.AiAnalysisLimit),
+		AILastResetAt:   usage.AiLastResetAt,
+		TokenUsed:       usage.TokenUsed,
+		TokenBudget:     usage.TokenBudget,
+		NextResetAt:     usage.NextResetAt,
+		ResetPolicy:     usage.ResetPolicy,
+		CreditBalance:   usage.CreditBalance,
+	}
 }
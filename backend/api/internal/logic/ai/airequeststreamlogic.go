@@ -0,0 +1,93 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AiRequestStreamLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAiRequestStreamLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AiRequestStreamLogic {
+	return &AiRequestStreamLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AiRequestStream 调用RPC流式接口，将分片转发给client；出错时发送一个带错误信息的结束分片
+func (l *AiRequestStreamLogic) AiRequestStream(req *types.AIRequestReq, client chan<- *types.AIStreamChunk) error {
+	// 客户端断开时ctx被取消，RPC流随之取消，由RPC服务负责完成计费
+	stream, err := l.svcCtx.SuperRpcClient.AIRequestStream(l.ctx, &super.AIRequestReq{
+		UserId:    common.UserIDFromContext(l.ctx),
+		Prompt:    req.Prompt,
+		UsageType: req.UsageType,
+		ModelTier: req.ModelTier,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return l.sendError(client, err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if l.ctx.Err() != nil {
+				return l.ctx.Err()
+			}
+			l.Errorf("接收AI流式响应失败: %v", err)
+			return l.sendError(client, err)
+		}
+
+		data := &types.AIStreamChunk{
+			Delta: chunk.Delta,
+			Done:  chunk.Done,
+			Model: chunk.Model,
+		}
+		if chunk.Usage != nil {
+			usage := toAIUsageData(chunk.Usage)
+			data.Usage = &usage
+		}
+		if err := l.send(client, data); err != nil {
+			return err
+		}
+	}
+}
+
+// sendError 发送错误分片
+func (l *AiRequestStreamLogic) sendError(client chan<- *types.AIStreamChunk, err error) error {
+	baseResp := common.HandleRPCError(err, "")
+	if sendErr := l.send(client, &types.AIStreamChunk{
+		Done:    true,
+		Code:    baseResp.Code,
+		Message: baseResp.Message,
+	}); sendErr != nil {
+		return sendErr
+	}
+	return err
+}
+
+// send 向client发送分片，客户端断开时立即返回
+func (l *AiRequestStreamLogic) send(client chan<- *types.AIStreamChunk, data *types.AIStreamChunk) error {
+	select {
+	case client <- data:
+		return nil
+	case <-l.ctx.Done():
+		return l.ctx.Err()
+	}
+}
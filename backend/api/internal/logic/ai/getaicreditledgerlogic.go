@@ -0,0 +1,60 @@
+package ai
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAICreditLedgerLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetAICreditLedgerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAICreditLedgerLogic {
+	return &GetAICreditLedgerLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAICreditLedgerLogic) GetAICreditLedger(req *types.GetAICreditLedgerReq) (resp *types.GetAICreditLedgerResp, err error) {
+	// 当前用户ID由UserAuth中间件从JWT中解析
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetAICreditLedger(l.ctx, &super.GetAICreditLedgerReq{
+		UserId:   common.UserIDFromContext(l.ctx),
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.GetAICreditLedgerResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	entries := make([]types.AICreditLedgerEntry, 0, len(rpcResp.Entries))
+	for _, e := range rpcResp.Entries {
+		entries = append(entries, types.AICreditLedgerEntry{
+			Id:        e.Id,
+			GrantId:   e.GrantId,
+			Type:      e.Type,
+			Amount:    int(e.Amount),
+			Feature:   e.Feature,
+			OrderId:   e.OrderId,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return &types.GetAICreditLedgerResp{
+		BaseResp: common.HandleRPCError(nil, "获取AI额度流水成功"),
+		Data:     entries,
+		Total:    int(rpcResp.Total),
+	}, nil
+}
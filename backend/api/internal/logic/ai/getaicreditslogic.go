@@ -0,0 +1,60 @@
+package ai
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAICreditsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetAICreditsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAICreditsLogic {
+	return &GetAICreditsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAICreditsLogic) GetAICredits(req *types.EmptyReq) (resp *types.GetAICreditsResp, err error) {
+	// 当前用户ID由UserAuth中间件从JWT中解析
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetAICredits(l.ctx, &super.GetAICreditsReq{
+		UserId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.GetAICreditsResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	grants := make([]types.AICreditGrant, 0, len(rpcResp.Grants))
+	for _, g := range rpcResp.Grants {
+		grants = append(grants, types.AICreditGrant{
+			Id:        g.Id,
+			PackId:    g.PackId,
+			OrderId:   g.OrderId,
+			Credits:   int(g.Credits),
+			Remaining: int(g.Remaining),
+			ExpiresAt: g.ExpiresAt,
+			CreatedAt: g.CreatedAt,
+		})
+	}
+
+	return &types.GetAICreditsResp{
+		BaseResp: common.HandleRPCError(nil, "获取AI额度成功"),
+		Data: types.AICreditsData{
+			Balance: rpcResp.Balance,
+			Grants:  grants,
+		},
+	}, nil
+}
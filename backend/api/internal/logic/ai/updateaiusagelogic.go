@@ -2,6 +2,8 @@ package ai
 
 import (
 	"context"
+
+	"backend/api/internal/common"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
 	"backend/rpc/pb/super"
@@ -24,8 +26,8 @@ func NewUpdateAIUsageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Upd
 }
 
 func (l *UpdateAIUsageLogic) UpdateAIUsage(req *types.UpdateAIUsageReq) (*types.UpdateAIUsageResp, error) {
-	// TODO: 从context或JWT中获取当前用户ID，目前使用默认用户ID
-	userId := "1" // 临时硬编码，需要从认证中获取
+	// 当前用户ID由UserAuth中间件从JWT中解析
+	userId := common.UserIDFromContext(l.ctx)
 
 	// 调用RPC服务更新AI使用量
 	rpcResp, err := l.svcCtx.SuperRpcClient.UpdateAIUsage(l.ctx, &super.UpdateAIUsageReq{
@@ -44,14 +46,19 @@ func (l *UpdateAIUsageLogic) UpdateAIUsage(req *types.UpdateAIUsageReq) (*types.
 			Success: true,
 		},
 		Data: types.AIUsageData{
-			IsVip:            rpcResp.Usage.IsVip,
-			AIChatCount:      int(rpcResp.Usage.AiChatCount),
-			AIChatLimit:      int(rpcResp.Usage.AiChatLimit),
-			AIContentCount:   int(rpcResp.Usage.AiContentCount),
-			AIContentLimit:   int(rpcResp.Usage.AiContentLimit),
-			AIAnalysisCount:  int(rpcResp.Usage.AiAnalysisCount),
-			AIAnalysisLimit:  int(rpcResp.Usage.AiAnalysisLimit),
-			AILastResetAt:    rpcResp.Usage.AiLastResetAt,
+			IsVip:           rpcResp.Usage.IsVip,
+			AIChatCount:     int(rpcResp.Usage.AiChatCount),
+			AIChatLimit:     int(rpcResp.Usage.AiChatLimit),
+			AIContentCount:  int(rpcResp.Usage.AiContentCount),
+			AIContentLimit:  int(rpcResp.Usage.AiContentLimit),
+			AIAnalysisCount: int(rpcResp.Usage.AiAnalysisCount),
+			AIAnalysisLimit: int(rpcResp.Usage.AiAnalysisLimit),
+			AILastResetAt:   rpcResp.Usage.AiLastResetAt,
+			TokenUsed:       rpcResp.Usage.TokenUsed,
+			TokenBudget:     rpcResp.Usage.TokenBudget,
+			NextResetAt:     rpcResp.Usage.NextResetAt,
+			ResetPolicy:     rpcResp.Usage.ResetPolicy,
+			CreditBalance:   rpcResp.Usage.CreditBalance,
 		},
 	}
 
@@ -0,0 +1,46 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PaymentNotifyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPaymentNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentNotifyLogic {
+	return &PaymentNotifyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// PaymentNotify 将支付回调的原始请求体和请求头透传给RPC服务，返回给支付平台的应答内容
+func (l *PaymentNotifyLogic) PaymentNotify(req *types.PaymentNotifyReq, body []byte, header http.Header) (string, error) {
+	headers := make(map[string]string, len(header))
+	for key := range header {
+		headers[key] = header.Get(key)
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.PaymentNotify(l.ctx, &super.PaymentNotifyReq{
+		Provider: req.Provider,
+		Body:     body,
+		Headers:  headers,
+	})
+	if err != nil {
+		l.Errorf("处理支付回调失败: %v", err)
+		return "", err
+	}
+
+	return rpcResp.Ack, nil
+}
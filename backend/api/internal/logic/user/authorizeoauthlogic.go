@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthorizeOAuthLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAuthorizeOAuthLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthorizeOAuthLogic {
+	return &AuthorizeOAuthLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AuthorizeOAuthLogic) AuthorizeOAuth(req *types.OAuthProviderReq) (resp *types.AuthorizeOAuthResp, err error) {
+	// 调用RPC服务生成授权页地址
+	rpcResp, err := l.svcCtx.SuperRpcClient.StartOAuth(l.ctx, &super.StartOAuthReq{
+		Provider: req.Provider,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.AuthorizeOAuthResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.AuthorizeOAuthResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data: types.AuthorizeOAuthData{
+			AuthorizationUrl: rpcResp.AuthorizationUrl,
+		},
+	}, nil
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AuthorizeUserIdentityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAuthorizeUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AuthorizeUserIdentityLogic {
+	return &AuthorizeUserIdentityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AuthorizeUserIdentityLogic) AuthorizeUserIdentity(req *types.UserIdentityReq) (resp *types.AuthorizeOAuthResp, err error) {
+	// 调用RPC服务生成绑定用的授权页地址
+	rpcResp, err := l.svcCtx.SuperRpcClient.StartOAuth(l.ctx, &super.StartOAuthReq{
+		Provider:   req.Provider,
+		LinkUserId: req.UserId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.AuthorizeOAuthResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.AuthorizeOAuthResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data: types.AuthorizeOAuthData{
+			AuthorizationUrl: rpcResp.AuthorizationUrl,
+		},
+	}, nil
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelAccountDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAccountDeletionLogic {
+	return &CancelAccountDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelAccountDeletionLogic) CancelAccountDeletion(req *types.GetUserInfoReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务撤销注销申请
+	_, err = l.svcCtx.SuperRpcClient.CancelAccountDeletion(l.ctx, &super.CancelAccountDeletionReq{
+		UserId:   req.UserId,
+		ClientIp: common.ClientIPFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "已撤销注销申请")
+	return &baseResp, nil
+}
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelVipOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelVipOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelVipOrderLogic {
+	return &CancelVipOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelVipOrderLogic) CancelVipOrder(req *types.CancelVipOrderReq) (resp *types.CancelVipOrderResp, err error) {
+	// 调用RPC服务取消订单
+	rpcResp, err := l.svcCtx.SuperRpcClient.CancelVipOrder(l.ctx, &super.CancelVipOrderReq{
+		UserId:  req.UserId,
+		OrderNo: req.OrderNo,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.CancelVipOrderResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.CancelVipOrderResp{
+		BaseResp: common.HandleRPCError(nil, "取消订单成功"),
+		Data: types.VipOrder{
+			Id:           rpcResp.Order.Id,
+			OrderNo:      rpcResp.Order.OrderNo,
+			UserId:       rpcResp.Order.UserId,
+			PlanId:       rpcResp.Order.PlanId,
+			PlanName:     rpcResp.Order.PlanName,
+			Amount:       float64(rpcResp.Order.Amount),
+			Status:       rpcResp.Order.Status,
+			CreatedAt:    rpcResp.Order.CreatedAt,
+			ProductType:  rpcResp.Order.ProductType,
+			CreditPackId: rpcResp.Order.CreditPackId,
+		},
+	}, nil
+}
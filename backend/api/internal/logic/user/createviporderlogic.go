@@ -28,8 +28,11 @@ func NewCreateVipOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cr
 func (l *CreateVipOrderLogic) CreateVipOrder(req *types.CreateVipOrderReq) (resp *types.CreateVipOrderResp, err error) {
 	// 调用RPC服务创建VIP订单
 	rpcResp, err := l.svcCtx.SuperRpcClient.CreateVipOrder(l.ctx, &super.CreateVipOrderReq{
-		UserId: req.UserId,
-		PlanId: req.PlanId,
+		UserId:         req.UserId,
+		PlanId:         req.PlanId,
+		ProductType:    req.ProductType,
+		CreditPackId:   req.CreditPackId,
+		IdempotencyKey: req.IdempotencyKey,
 	})
 	if err != nil {
 		return &types.CreateVipOrderResp{
@@ -40,14 +43,17 @@ func (l *CreateVipOrderLogic) CreateVipOrder(req *types.CreateVipOrderReq) (resp
 	return &types.CreateVipOrderResp{
 		BaseResp: common.HandleRPCError(nil, "创建VIP订单成功"),
 		Data: types.VipOrder{
-			Id:        rpcResp.Order.Id,
-			UserId:    rpcResp.Order.UserId,
-			PlanId:    rpcResp.Order.PlanId,
-			PlanName:  rpcResp.Order.PlanName,
-			Amount:    float64(rpcResp.Order.Amount),
-			Status:    rpcResp.Order.Status,
-			CreatedAt: rpcResp.Order.CreatedAt,
-			PaidAt:    rpcResp.Order.PaidAt,
+			Id:           rpcResp.Order.Id,
+			OrderNo:      rpcResp.Order.OrderNo,
+			UserId:       rpcResp.Order.UserId,
+			PlanId:       rpcResp.Order.PlanId,
+			PlanName:     rpcResp.Order.PlanName,
+			Amount:       float64(rpcResp.Order.Amount),
+			Status:       rpcResp.Order.Status,
+			CreatedAt:    rpcResp.Order.CreatedAt,
+			PaidAt:       rpcResp.Order.PaidAt,
+			ProductType:  rpcResp.Order.ProductType,
+			CreditPackId: rpcResp.Order.CreditPackId,
 		},
 	}, nil
 }
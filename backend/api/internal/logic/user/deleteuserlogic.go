@@ -27,8 +27,9 @@ func NewDeleteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delete
 
 func (l *DeleteUserLogic) DeleteUser(req *types.DeleteUserReq) (resp *types.DeleteUserResp, err error) {
 	// 调用RPC服务删除用户
-	_, err = l.svcCtx.SuperRpcClient.DeleteUser(l.ctx, &super.DeleteUserReq{
-		UserId: req.UserId,
+	rpcResp, err := l.svcCtx.SuperRpcClient.DeleteUser(l.ctx, &super.DeleteUserReq{
+		UserId:     req.UserId,
+		OperatorId: common.UserIDFromContext(l.ctx),
 	})
 	if err != nil {
 		return &types.DeleteUserResp{
@@ -39,5 +40,8 @@ func (l *DeleteUserLogic) DeleteUser(req *types.DeleteUserReq) (resp *types.Dele
 	// 转换为API响应
 	return &types.DeleteUserResp{
 		BaseResp: common.HandleRPCError(nil, "删除用户成功"),
+		Data: types.DeleteUserData{
+			PurgeAt: rpcResp.PurgeAt,
+		},
 	}, nil
 }
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisableTwoFactorLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDisableTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableTwoFactorLogic {
+	return &DisableTwoFactorLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DisableTwoFactorLogic) DisableTwoFactor(req *types.DisableTwoFactorReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务关闭两步验证
+	_, err = l.svcCtx.SuperRpcClient.DisableTwoFactor(l.ctx, &super.DisableTwoFactorReq{
+		UserId:   req.UserId,
+		Code:     req.Code,
+		ClientIp: common.ClientIPFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "两步验证已关闭")
+	return &baseResp, nil
+}
@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DownloadDataExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDownloadDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadDataExportLogic {
+	return &DownloadDataExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DownloadDataExport 调用RPC流式接口，将导出文件写入w
+// 写入响应头之前出错时返回错误，由调用方返回错误信息；之后出错只能中断下载
+func (l *DownloadDataExportLogic) DownloadDataExport(req *types.DownloadDataExportReq, w http.ResponseWriter) error {
+	stream, err := l.svcCtx.SuperRpcClient.DownloadDataExport(l.ctx, &super.DownloadDataExportReq{
+		ExportId:  req.Id,
+		Expires:   req.Expires,
+		Signature: req.Signature,
+		ClientIp:  common.ClientIPFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return err
+	}
+
+	// 第一个分片携带文件名和大小，链接无效时在此返回错误
+	chunk, err := stream.Recv()
+	if err != nil {
+		l.Errorf("接收导出文件失败: %v", err)
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+chunk.FileName+`"`)
+	w.Header().Set("Content-Length", strconv.FormatInt(chunk.Size, 10))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			return nil
+		}
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if l.ctx.Err() == nil {
+				l.Errorf("接收导出文件失败: %v", err)
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnableTwoFactorLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewEnableTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnableTwoFactorLogic {
+	return &EnableTwoFactorLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *EnableTwoFactorLogic) EnableTwoFactor(req *types.EnableTwoFactorReq) (resp *types.EnableTwoFactorResp, err error) {
+	// 调用RPC服务启用两步验证
+	rpcResp, err := l.svcCtx.SuperRpcClient.EnableTwoFactor(l.ctx, &super.EnableTwoFactorReq{
+		UserId: req.UserId,
+		Code:   req.Code,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.EnableTwoFactorResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.EnableTwoFactorResp{
+		BaseResp: common.HandleRPCError(nil, "两步验证已启用"),
+		Data: types.EnableTwoFactorData{
+			RecoveryCodes: rpcResp.RecoveryCodes,
+		},
+	}, nil
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ForgotPasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewForgotPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ForgotPasswordLogic {
+	return &ForgotPasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ForgotPasswordLogic) ForgotPassword(req *types.ForgotPasswordReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务发送重置密码邮件
+	_, err = l.svcCtx.SuperRpcClient.ForgotPassword(l.ctx, &super.ForgotPasswordReq{
+		Email: req.Email,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "如果该邮箱已注册，重置密码邮件已发送")
+	return &baseResp, nil
+}
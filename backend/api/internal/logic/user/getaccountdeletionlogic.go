@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAccountDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountDeletionLogic {
+	return &GetAccountDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAccountDeletionLogic) GetAccountDeletion(req *types.GetUserInfoReq) (resp *types.AccountDeletionResp, err error) {
+	// 调用RPC服务查询注销申请
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetAccountDeletion(l.ctx, &super.GetAccountDeletionReq{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.AccountDeletionResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.AccountDeletionResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data:     accountDeletionData(rpcResp),
+	}, nil
+}
+
+// accountDeletionData 转换注销申请状态
+func accountDeletionData(rpcResp *super.AccountDeletionResp) types.AccountDeletionData {
+	return types.AccountDeletionData{
+		Scheduled:   rpcResp.Scheduled,
+		RequestedAt: rpcResp.RequestedAt,
+		PurgeAt:     rpcResp.PurgeAt,
+	}
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAIUsageHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetAIUsageHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAIUsageHistoryLogic {
+	return &GetAIUsageHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAIUsageHistoryLogic) GetAIUsageHistory(req *types.GetAIUsageHistoryReq) (resp *types.GetAIUsageHistoryResp, err error) {
+	// 调用RPC服务查询AI调用流水
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetAIUsageHistory(l.ctx, &super.GetAIUsageHistoryReq{
+		UserId:    req.UserId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Page:      int32(req.Page),
+		PageSize:  int32(req.PageSize),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.GetAIUsageHistoryResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	// 转换为API响应格式
+	entries := make([]types.AIUsageLedgerEntry, 0, len(rpcResp.Entries))
+	for _, e := range rpcResp.Entries {
+		entries = append(entries, types.AIUsageLedgerEntry{
+			Id:               e.Id,
+			Feature:          e.Feature,
+			Model:            e.Model,
+			ModelTier:        e.ModelTier,
+			PromptTokens:     int(e.PromptTokens),
+			CompletionTokens: int(e.CompletionTokens),
+			TotalTokens:      int(e.TotalTokens),
+			LatencyMs:        e.LatencyMs,
+			Status:           e.Status,
+			PlanId:           e.PlanId,
+			OrderId:          e.OrderId,
+			CreatedAt:        e.CreatedAt,
+		})
+	}
+
+	return &types.GetAIUsageHistoryResp{
+		BaseResp: common.HandleRPCError(nil, "获取AI调用记录成功"),
+		Data:     entries,
+		Total:    int(rpcResp.Total),
+	}, nil
+}
@@ -49,6 +49,11 @@ func (l *GetAIUsageLogic) GetAIUsage(req *types.GetUserInfoReq) (resp *types.Get
 			AIAnalysisCount:  int(rpcResp.Usage.AiAnalysisCount),
 			AIAnalysisLimit:  int(rpcResp.Usage.AiAnalysisLimit),
 			AILastResetAt:    rpcResp.Usage.AiLastResetAt,
+			TokenUsed:        rpcResp.Usage.TokenUsed,
+			TokenBudget:      rpcResp.Usage.TokenBudget,
+			NextResetAt:      rpcResp.Usage.NextResetAt,
+			ResetPolicy:      rpcResp.Usage.ResetPolicy,
+			CreditBalance:    rpcResp.Usage.CreditBalance,
 		},
 	}
 
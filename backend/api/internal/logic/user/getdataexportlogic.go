@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDataExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDataExportLogic {
+	return &GetDataExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetDataExportLogic) GetDataExport(req *types.GetDataExportReq) (resp *types.DataExportResp, err error) {
+	// 调用RPC服务查询导出状态
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetDataExport(l.ctx, &super.GetDataExportReq{
+		UserId:   req.UserId,
+		ExportId: req.ExportId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.DataExportResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.DataExportResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data:     dataExportData(rpcResp),
+	}, nil
+}
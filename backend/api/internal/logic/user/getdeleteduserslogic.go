@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDeletedUsersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetDeletedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDeletedUsersLogic {
+	return &GetDeletedUsersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetDeletedUsersLogic) GetDeletedUsers(req *types.GetUsersReq) (resp *types.GetDeletedUsersResp, err error) {
+	// 调用RPC服务获取已删除的用户
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetDeletedUsers(l.ctx, &super.GetDeletedUsersReq{
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.GetDeletedUsersResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	users := make([]types.DeletedUser, 0, len(rpcResp.Users))
+	for _, user := range rpcResp.Users {
+		users = append(users, types.DeletedUser{
+			Id:        user.Id,
+			Username:  user.Username,
+			Email:     user.Email,
+			DeletedAt: user.DeletedAt,
+			Source:    user.Source,
+			PurgeAt:   user.PurgeAt,
+		})
+	}
+
+	return &types.GetDeletedUsersResp{
+		BaseResp: common.HandleRPCError(nil, "获取已删除用户成功"),
+		Data:     users,
+		Total:    int(rpcResp.Total),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOAuthProvidersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetOAuthProvidersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOAuthProvidersLogic {
+	return &GetOAuthProvidersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOAuthProvidersLogic) GetOAuthProviders(req *types.EmptyReq) (resp *types.GetOAuthProvidersResp, err error) {
+	// 调用RPC服务获取登录提供方
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetOAuthProviders(l.ctx, &super.GetOAuthProvidersReq{})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.GetOAuthProvidersResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	providers := make([]types.OAuthProvider, 0, len(rpcResp.Providers))
+	for _, p := range rpcResp.Providers {
+		providers = append(providers, types.OAuthProvider{
+			Name:        p.Name,
+			DisplayName: p.DisplayName,
+		})
+	}
+	return &types.GetOAuthProvidersResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data:     providers,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTwoFactorStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetTwoFactorStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTwoFactorStatusLogic {
+	return &GetTwoFactorStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTwoFactorStatusLogic) GetTwoFactorStatus(req *types.GetUserInfoReq) (resp *types.TwoFactorStatusResp, err error) {
+	// 调用RPC服务查询两步验证状态
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetTwoFactorStatus(l.ctx, &super.GetTwoFactorStatusReq{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.TwoFactorStatusResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.TwoFactorStatusResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data: types.TwoFactorStatusData{
+			Enabled:                rpcResp.Enabled,
+			RecoveryCodesRemaining: rpcResp.RecoveryCodesRemaining,
+		},
+	}, nil
+}
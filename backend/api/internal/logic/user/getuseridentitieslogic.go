@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserIdentitiesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetUserIdentitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserIdentitiesLogic {
+	return &GetUserIdentitiesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUserIdentitiesLogic) GetUserIdentities(req *types.GetUserInfoReq) (resp *types.GetUserIdentitiesResp, err error) {
+	// 调用RPC服务查询绑定的第三方账号
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetUserIdentities(l.ctx, &super.GetUserIdentitiesReq{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.GetUserIdentitiesResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	identities := make([]types.UserIdentity, 0, len(rpcResp.Identities))
+	for _, identity := range rpcResp.Identities {
+		identities = append(identities, toUserIdentity(identity))
+	}
+	return &types.GetUserIdentitiesResp{
+		BaseResp: common.HandleRPCError(nil, "获取成功"),
+		Data:     identities,
+	}, nil
+}
+
+// toUserIdentity 将RPC第三方账号转换为API结构
+func toUserIdentity(identity *super.UserIdentity) types.UserIdentity {
+	return types.UserIdentity{
+		Provider:    identity.Provider,
+		Email:       identity.Email,
+		CreatedAt:   identity.CreatedAt,
+		LastLoginAt: identity.LastLoginAt,
+	}
+}
@@ -44,14 +44,17 @@ func (l *GetVipOrdersLogic) GetVipOrders(req *types.GetVipOrdersReq) (resp *type
 	respOrders := make([]types.VipOrder, 0, len(rpcResp.Orders))
 	for _, order := range rpcResp.Orders {
 		respOrders = append(respOrders, types.VipOrder{
-			Id:        order.Id,
-			UserId:    order.UserId,
-			PlanId:    order.PlanId,
-			PlanName:  order.PlanName,
-			Amount:    float64(order.Amount),
-			Status:    order.Status,
-			CreatedAt: order.CreatedAt,
-			PaidAt:    order.PaidAt,
+			Id:           order.Id,
+			OrderNo:      order.OrderNo,
+			UserId:       order.UserId,
+			PlanId:       order.PlanId,
+			PlanName:     order.PlanName,
+			Amount:       float64(order.Amount),
+			Status:       order.Status,
+			CreatedAt:    order.CreatedAt,
+			PaidAt:       order.PaidAt,
+			ProductType:  order.ProductType,
+			CreditPackId: order.CreditPackId,
 		})
 	}
 
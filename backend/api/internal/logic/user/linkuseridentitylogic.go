@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LinkUserIdentityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkUserIdentityLogic {
+	return &LinkUserIdentityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LinkUserIdentityLogic) LinkUserIdentity(req *types.LinkUserIdentityReq) (resp *types.LinkUserIdentityResp, err error) {
+	// 调用RPC服务完成绑定
+	rpcResp, err := l.svcCtx.SuperRpcClient.LinkOAuthIdentity(l.ctx, &super.LinkOAuthIdentityReq{
+		UserId:   req.UserId,
+		Provider: req.Provider,
+		Code:     req.Code,
+		State:    req.State,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.LinkUserIdentityResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.LinkUserIdentityResp{
+		BaseResp: common.HandleRPCError(nil, "绑定成功"),
+		Data:     toUserIdentity(rpcResp.Identity),
+	}, nil
+}
@@ -31,6 +31,7 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		Username: req.Username,
 		Password: req.Password,
 		Email:    req.Email,
+		ClientIp: common.ClientIPFromContext(l.ctx),
 	})
 	if err != nil {
 		l.Errorf("调用RPC服务失败: %v", err)
@@ -39,28 +40,42 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		}, nil
 	}
 
-	// 转换为API响应
-	resp = &types.LoginResp{
-		BaseResp: common.HandleRPCError(nil, "登录成功"),
+	// 开启两步验证的用户需要继续校验验证码
+	message := "登录成功"
+	if rpcResp.TwoFactorRequired {
+		message = "请输入两步验证码"
+	}
+	return &types.LoginResp{
+		BaseResp: common.HandleRPCError(nil, message),
+		Data:     loginDataOf(rpcResp),
+	}, nil
+}
+
+// loginDataOf 将RPC登录响应转换为API响应数据
+func loginDataOf(rpcResp *super.LoginResp) types.LoginData {
+	data := types.LoginData{
+		Token:              rpcResp.Token,
+		RefreshToken:       rpcResp.RefreshToken,
+		ExpiresIn:          rpcResp.ExpiresIn,
+		TwoFactorRequired:  rpcResp.TwoFactorRequired,
+		ChallengeToken:     rpcResp.ChallengeToken,
+		ChallengeExpiresIn: rpcResp.ChallengeExpiresIn,
 	}
 
 	// 设置用户数据
 	if rpcResp.User != nil {
-		resp.Data = types.LoginData{
-			User: types.User{
-				Id:           rpcResp.User.Id,
-				Username:     rpcResp.User.Username,
-				Email:        rpcResp.User.Email,
-				Avatar:       rpcResp.User.Avatar,
-				CreatedAt:    rpcResp.User.CreatedAt,
-				UpdatedAt:    rpcResp.User.UpdatedAt,
-				IsVip:        rpcResp.User.IsVip,
-				VipExpiresAt: rpcResp.User.VipExpiresAt,
-				AutoRenew:    rpcResp.User.AutoRenew,
-			},
-			Token: rpcResp.Token,
+		data.User = types.User{
+			Id:           rpcResp.User.Id,
+			Username:     rpcResp.User.Username,
+			Email:        rpcResp.User.Email,
+			Avatar:       rpcResp.User.Avatar,
+			CreatedAt:    rpcResp.User.CreatedAt,
+			UpdatedAt:    rpcResp.User.UpdatedAt,
+			IsVip:        rpcResp.User.IsVip,
+			VipExpiresAt: rpcResp.User.VipExpiresAt,
+			AutoRenew:    rpcResp.User.AutoRenew,
+			Role:         rpcResp.User.Role,
 		}
 	}
-
-	return resp, nil
+	return data
 }
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LogoutLogic) Logout(req *types.LogoutReq) (resp *types.BaseResp, err error) {
+	user, ok := common.AuthUserFromContext(l.ctx)
+	if !ok {
+		return &types.BaseResp{Code: 401, Message: "未登录", Success: false}, nil
+	}
+
+	// 调用RPC服务，吊销当前访问Token和刷新令牌
+	_, err = l.svcCtx.SuperRpcClient.Logout(l.ctx, &super.LogoutReq{
+		UserId:         user.UserID,
+		TokenId:        user.TokenID,
+		TokenExpiresAt: user.TokenExpiresAt,
+		RefreshToken:   req.RefreshToken,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+	} else {
+		// 吊销后立即生效，不再使用网关缓存的状态
+		l.svcCtx.TokenRevocation.MarkRevoked(user.TokenID)
+	}
+
+	baseResp := common.HandleRPCError(err, "登出成功")
+	return &baseResp, nil
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type OauthLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewOauthLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OauthLoginLogic {
+	return &OauthLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *OauthLoginLogic) OauthLogin(req *types.OAuthLoginReq) (resp *types.LoginResp, err error) {
+	// 调用RPC服务完成第三方登录
+	rpcResp, err := l.svcCtx.SuperRpcClient.OAuthLogin(l.ctx, &super.OAuthLoginReq{
+		Provider: req.Provider,
+		Code:     req.Code,
+		State:    req.State,
+		ClientIp: common.ClientIPFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.LoginResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	// 开启两步验证的用户需要继续校验验证码
+	message := "登录成功"
+	if rpcResp.TwoFactorRequired {
+		message = "请输入两步验证码"
+	}
+	return &types.LoginResp{
+		BaseResp: common.HandleRPCError(nil, message),
+		Data:     loginDataOf(rpcResp),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PayVipOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPayVipOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PayVipOrderLogic {
+	return &PayVipOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PayVipOrderLogic) PayVipOrder(req *types.PayVipOrderReq) (resp *types.PayVipOrderResp, err error) {
+	// 调用RPC服务发起支付
+	rpcResp, err := l.svcCtx.SuperRpcClient.PayVipOrder(l.ctx, &super.PayVipOrderReq{
+		UserId:    req.UserId,
+		OrderNo:   req.OrderNo,
+		PayMethod: req.PayMethod,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.PayVipOrderResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.PayVipOrderResp{
+		BaseResp: common.HandleRPCError(nil, "发起支付成功"),
+		Data: types.PayVipOrderData{
+			OrderNo:  rpcResp.OrderNo,
+			Provider: rpcResp.Provider,
+			PayUrl:   rpcResp.PayUrl,
+			QrCode:   rpcResp.QrCode,
+		},
+	}, nil
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReconcileUsersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReconcileUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileUsersLogic {
+	return &ReconcileUsersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReconcileUsersLogic) ReconcileUsers(req *types.ReconcileUsersReq) (resp *types.ReconcileUsersResp, err error) {
+	// 调用RPC服务对账
+	rpcResp, err := l.svcCtx.SuperRpcClient.ReconcileUsers(l.ctx, &super.ReconcileUsersReq{
+		DryRun:     req.DryRun,
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.ReconcileUsersResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	drifts := make([]types.UserDrift, 0, len(rpcResp.Drifts))
+	for _, drift := range rpcResp.Drifts {
+		drifts = append(drifts, types.UserDrift{
+			UserId:   drift.UserId,
+			Kind:     drift.Kind,
+			Detail:   drift.Detail,
+			Repaired: drift.Repaired,
+			Error:    drift.Error,
+		})
+	}
+
+	return &types.ReconcileUsersResp{
+		BaseResp: common.HandleRPCError(nil, "对账完成"),
+		Data: types.ReconcileUsersData{
+			RemoteUsers: rpcResp.RemoteUsers,
+			LocalUsers:  rpcResp.LocalUsers,
+			Skipped:     rpcResp.Skipped,
+			Drifts:      drifts,
+		},
+	}, nil
+}
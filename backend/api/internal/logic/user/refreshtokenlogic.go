@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshTokenLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshTokenLogic {
+	return &RefreshTokenLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshTokenLogic) RefreshToken(req *types.RefreshTokenReq) (resp *types.RefreshTokenResp, err error) {
+	// 调用RPC服务轮换刷新令牌
+	rpcResp, err := l.svcCtx.SuperRpcClient.RefreshToken(l.ctx, &super.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.RefreshTokenResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	// 转换为API响应
+	return &types.RefreshTokenResp{
+		BaseResp: common.HandleRPCError(nil, "刷新成功"),
+		Data: types.RefreshTokenData{
+			Token:        rpcResp.Token,
+			RefreshToken: rpcResp.RefreshToken,
+			ExpiresIn:    rpcResp.ExpiresIn,
+		},
+	}, nil
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestAccountDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRequestAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestAccountDeletionLogic {
+	return &RequestAccountDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RequestAccountDeletionLogic) RequestAccountDeletion(req *types.RequestAccountDeletionReq) (resp *types.AccountDeletionResp, err error) {
+	// 调用RPC服务申请注销
+	rpcResp, err := l.svcCtx.SuperRpcClient.RequestAccountDeletion(l.ctx, &super.RequestAccountDeletionReq{
+		UserId:   req.UserId,
+		Password: req.Password,
+		ClientIp: common.ClientIPFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.AccountDeletionResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.AccountDeletionResp{
+		BaseResp: common.HandleRPCError(nil, "已申请注销账号"),
+		Data:     accountDeletionData(rpcResp),
+	}, nil
+}
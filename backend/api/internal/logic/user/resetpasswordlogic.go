@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetPasswordLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResetPasswordLogic) ResetPassword(req *types.ResetPasswordReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务重置密码
+	_, err = l.svcCtx.SuperRpcClient.ResetPassword(l.ctx, &super.ResetPasswordReq{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "密码已重置，请使用新密码登录")
+	return &baseResp, nil
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResetTwoFactorLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewResetTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetTwoFactorLogic {
+	return &ResetTwoFactorLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResetTwoFactorLogic) ResetTwoFactor(req *types.GetUserInfoReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务重置两步验证
+	_, err = l.svcCtx.SuperRpcClient.ResetTwoFactor(l.ctx, &super.ResetTwoFactorReq{
+		UserId:     req.UserId,
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "两步验证已重置")
+	return &baseResp, nil
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRestoreUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreUserLogic {
+	return &RestoreUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RestoreUserLogic) RestoreUser(req *types.GetUserInfoReq) (resp *types.RestoreUserResp, err error) {
+	// 调用RPC服务恢复用户
+	rpcResp, err := l.svcCtx.SuperRpcClient.RestoreUser(l.ctx, &super.RestoreUserReq{
+		UserId:     req.UserId,
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.RestoreUserResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.RestoreUserResp{
+		BaseResp: common.HandleRPCError(nil, "恢复用户成功"),
+		Data: types.RestoreUserData{
+			UserId:     req.UserId,
+			WasDeleted: rpcResp.WasDeleted,
+		},
+	}, nil
+}
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendVerificationEmailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSendVerificationEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendVerificationEmailLogic {
+	return &SendVerificationEmailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SendVerificationEmailLogic) SendVerificationEmail(req *types.GetUserInfoReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务发送验证邮件
+	_, err = l.svcCtx.SuperRpcClient.SendVerificationEmail(l.ctx, &super.SendVerificationEmailReq{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "验证邮件已发送")
+	return &baseResp, nil
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetupTwoFactorLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetupTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetupTwoFactorLogic {
+	return &SetupTwoFactorLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetupTwoFactorLogic) SetupTwoFactor(req *types.GetUserInfoReq) (resp *types.SetupTwoFactorResp, err error) {
+	// 调用RPC服务生成密钥
+	rpcResp, err := l.svcCtx.SuperRpcClient.SetupTwoFactor(l.ctx, &super.SetupTwoFactorReq{
+		UserId: req.UserId,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.SetupTwoFactorResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.SetupTwoFactorResp{
+		BaseResp: common.HandleRPCError(nil, "请使用验证器应用扫描二维码"),
+		Data: types.SetupTwoFactorData{
+			Secret:     rpcResp.Secret,
+			OtpauthUri: rpcResp.OtpauthUri,
+		},
+	}, nil
+}
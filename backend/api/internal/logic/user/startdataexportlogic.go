@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartDataExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewStartDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartDataExportLogic {
+	return &StartDataExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *StartDataExportLogic) StartDataExport(req *types.StartDataExportReq) (resp *types.DataExportResp, err error) {
+	// 调用RPC服务申请导出个人数据
+	rpcResp, err := l.svcCtx.SuperRpcClient.StartDataExport(l.ctx, &super.StartDataExportReq{
+		UserId:     req.UserId,
+		Fresh:      req.Fresh,
+		ClientIp:   common.ClientIPFromContext(l.ctx),
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.DataExportResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.DataExportResp{
+		BaseResp: common.HandleRPCError(nil, "已申请导出个人数据"),
+		Data:     dataExportData(rpcResp),
+	}, nil
+}
+
+// dataExportData 转换个人数据导出状态
+func dataExportData(rpcResp *super.DataExportResp) types.DataExportData {
+	return types.DataExportData{
+		Id:                rpcResp.Id,
+		Status:            rpcResp.Status,
+		Size:              rpcResp.Size,
+		CreatedAt:         rpcResp.CreatedAt,
+		CompletedAt:       rpcResp.CompletedAt,
+		ExpiresAt:         rpcResp.ExpiresAt,
+		DownloadUrl:       rpcResp.DownloadUrl,
+		DownloadExpiresAt: rpcResp.DownloadExpiresAt,
+	}
+}
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnlinkUserIdentityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUnlinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlinkUserIdentityLogic {
+	return &UnlinkUserIdentityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnlinkUserIdentityLogic) UnlinkUserIdentity(req *types.UserIdentityReq) (resp *types.BaseResp, err error) {
+	// 调用RPC服务解绑第三方账号
+	_, err = l.svcCtx.SuperRpcClient.UnlinkUserIdentity(l.ctx, &super.UnlinkUserIdentityReq{
+		UserId:   req.UserId,
+		Provider: req.Provider,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		baseResp := common.HandleRPCError(err, "")
+		return &baseResp, nil
+	}
+
+	baseResp := common.HandleRPCError(nil, "解绑成功")
+	return &baseResp, nil
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnlockUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUnlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlockUserLogic {
+	return &UnlockUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnlockUserLogic) UnlockUser(req *types.UnlockUserReq) (resp *types.UnlockUserResp, err error) {
+	// 调用RPC服务解除锁定
+	rpcResp, err := l.svcCtx.SuperRpcClient.UnlockUser(l.ctx, &super.UnlockUserReq{
+		UserId:     req.UserId,
+		OperatorId: common.UserIDFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.UnlockUserResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.UnlockUserResp{
+		BaseResp: common.HandleRPCError(nil, "解除锁定成功"),
+		Data: types.UnlockUserData{
+			UserId:    rpcResp.UserId,
+			WasLocked: rpcResp.WasLocked,
+		},
+	}, nil
+}
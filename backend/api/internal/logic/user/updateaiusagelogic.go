@@ -50,6 +50,11 @@ func (l *UpdateAIUsageLogic) UpdateAIUsage(req *types.UpdateAIUsageReq) (resp *t
 			AIAnalysisCount:  int(rpcResp.Usage.AiAnalysisCount),
 			AIAnalysisLimit:  int(rpcResp.Usage.AiAnalysisLimit),
 			AILastResetAt:    rpcResp.Usage.AiLastResetAt,
+			TokenUsed:        rpcResp.Usage.TokenUsed,
+			TokenBudget:      rpcResp.Usage.TokenBudget,
+			NextResetAt:      rpcResp.Usage.NextResetAt,
+			ResetPolicy:      rpcResp.Usage.ResetPolicy,
+			CreditBalance:    rpcResp.Usage.CreditBalance,
 		},
 	}
 	return resp, nil
@@ -32,6 +32,7 @@ func (l *UpdateUserInfoLogic) UpdateUserInfo(req *types.UpdateUserInfoReq) (resp
 		Username: req.Username,
 		Email:    req.Email,
 		Avatar:   req.Avatar,
+		Timezone: req.Timezone,
 	})
 	if err != nil {
 		return &types.UpdateUserInfoResp{
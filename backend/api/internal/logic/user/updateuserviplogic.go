@@ -28,9 +28,9 @@ func NewUpdateUserVipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Upd
 func (l *UpdateUserVipLogic) UpdateUserVip(req *types.UpdateUserVipReq) (resp *types.UpdateUserVipResp, err error) {
 	// 调用RPC服务
 	rpcResp, err := l.svcCtx.SuperRpcClient.UpdateUserVip(l.ctx, &super.UpdateUserVipReq{
-		UserId:     req.UserId,
-		IsVip:      req.IsVip,
-		VipExpires: req.VipExpires,
+		UserId: req.UserId,
+		IsVip:  req.IsVip,
+		PlanId: req.PlanId,
 	})
 	if err != nil {
 		return &types.UpdateUserVipResp{
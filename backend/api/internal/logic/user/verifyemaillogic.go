@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyEmailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyEmailLogic {
+	return &VerifyEmailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyEmailLogic) VerifyEmail(req *types.VerifyEmailReq) (resp *types.VerifyEmailResp, err error) {
+	// 调用RPC服务验证邮箱
+	rpcResp, err := l.svcCtx.SuperRpcClient.VerifyEmail(l.ctx, &super.VerifyEmailReq{
+		Token: req.Token,
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.VerifyEmailResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.VerifyEmailResp{
+		BaseResp: common.HandleRPCError(nil, "邮箱验证成功"),
+		Data: types.VerifyEmailData{
+			UserId: rpcResp.UserId,
+			Email:  rpcResp.Email,
+		},
+	}, nil
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyTwoFactorLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyTwoFactorLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyTwoFactorLoginLogic {
+	return &VerifyTwoFactorLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyTwoFactorLoginLogic) VerifyTwoFactorLogin(req *types.VerifyTwoFactorLoginReq) (resp *types.LoginResp, err error) {
+	// 调用RPC服务校验验证码
+	rpcResp, err := l.svcCtx.SuperRpcClient.VerifyTwoFactorLogin(l.ctx, &super.VerifyTwoFactorLoginReq{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+		ClientIp:       common.ClientIPFromContext(l.ctx),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.LoginResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.LoginResp{
+		BaseResp: common.HandleRPCError(nil, "登录成功"),
+		Data:     loginDataOf(rpcResp),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package vip
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCreditPackLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateCreditPackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCreditPackLogic {
+	return &CreateCreditPackLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCreditPackLogic) CreateCreditPack(req *types.CreateCreditPackReq) (resp *types.CreateCreditPackResp, err error) {
+	// 调用RPC服务
+	rpcResp, err := l.svcCtx.SuperRpcClient.CreateCreditPack(l.ctx, &super.CreateCreditPackReq{
+		Name:        req.Name,
+		Description: req.Description,
+		Price:       float32(req.Price),
+		Credits:     int32(req.Credits),
+		ValidDays:   int32(req.ValidDays),
+	})
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return &types.CreateCreditPackResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	return &types.CreateCreditPackResp{
+		BaseResp: common.HandleRPCError(nil, "创建加油包成功"),
+		Data:     toCreditPack(rpcResp.Pack),
+	}, nil
+}
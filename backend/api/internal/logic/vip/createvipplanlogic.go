@@ -32,6 +32,7 @@ func (l *CreateVipPlanLogic) CreateVipPlan(req *types.CreateVipPlanReq) (resp *t
 		Description:  req.Description,
 		Price:        float32(req.Price),
 		DurationDays: int32(req.DurationDays),
+		Features:     toPlanFeatures(req.Features),
 	})
 	if err != nil {
 		l.Errorf("调用RPC服务失败: %v", err)
@@ -43,14 +44,22 @@ func (l *CreateVipPlanLogic) CreateVipPlan(req *types.CreateVipPlanReq) (resp *t
 	// 转换为API响应
 	return &types.CreateVipPlanResp{
 		BaseResp: common.HandleRPCError(nil, "创建VIP套餐成功"),
-		Data: types.VipPlan{
-			Id:           rpcResp.Plan.Id,
-			Name:         rpcResp.Plan.Name,
-			Description:  rpcResp.Plan.Description,
-			Price:        float64(rpcResp.Plan.Price),
-			DurationDays: int(rpcResp.Plan.DurationDays),
-			CreatedAt:    rpcResp.Plan.CreatedAt,
-			UpdatedAt:    rpcResp.Plan.UpdatedAt,
-		},
+		Data:     toVipPlan(rpcResp.Plan),
 	}, nil
 }
+
+// toPlanFeatures 将API结构的套餐权益转换为RPC结构
+func toPlanFeatures(features types.PlanFeatures) *super.PlanFeatures {
+	quotas := make(map[string]int32, len(features.Quotas))
+	for feature, quota := range features.Quotas {
+		quotas[feature] = int32(quota)
+	}
+
+	return &super.PlanFeatures{
+		Quotas:      quotas,
+		ModelTiers:  features.ModelTiers,
+		MaxTokens:   int32(features.MaxTokens),
+		TokenBudget: int32(features.TokenBudget),
+		ResetPolicy: features.ResetPolicy,
+	}
+}
@@ -0,0 +1,60 @@
+package vip
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCreditPacksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetCreditPacksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCreditPacksLogic {
+	return &GetCreditPacksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetCreditPacksLogic) GetCreditPacks(req *types.EmptyReq) (resp *types.GetCreditPacksResp, err error) {
+	// 调用RPC服务获取加油包列表
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetCreditPacks(l.ctx, &super.GetCreditPacksReq{})
+	if err != nil {
+		return &types.GetCreditPacksResp{
+			BaseResp: common.HandleRPCError(err, ""),
+		}, nil
+	}
+
+	packs := make([]types.CreditPack, 0, len(rpcResp.Packs))
+	for _, pack := range rpcResp.Packs {
+		packs = append(packs, toCreditPack(pack))
+	}
+
+	return &types.GetCreditPacksResp{
+		BaseResp: common.HandleRPCError(nil, "获取加油包列表成功"),
+		Data:     packs,
+	}, nil
+}
+
+// toCreditPack 将RPC加油包转换为API结构
+func toCreditPack(pack *super.CreditPack) types.CreditPack {
+	return types.CreditPack{
+		Id:          pack.Id,
+		Name:        pack.Name,
+		Description: pack.Description,
+		Price:       float64(pack.Price),
+		Credits:     int(pack.Credits),
+		ValidDays:   int(pack.ValidDays),
+		CreatedAt:   pack.CreatedAt,
+		UpdatedAt:   pack.UpdatedAt,
+	}
+}
@@ -40,14 +40,6 @@ func (l *GetVipPlanLogic) GetVipPlan(req *types.GetVipPlanReq) (resp *types.GetV
 	// 转换为API响应
 	return &types.GetVipPlanResp{
 		BaseResp: common.HandleRPCError(nil, "获取VIP套餐成功"),
-		Data: types.VipPlan{
-			Id:           rpcResp.Plan.Id,
-			Name:         rpcResp.Plan.Name,
-			Description:  rpcResp.Plan.Description,
-			Price:        float64(rpcResp.Plan.Price),
-			DurationDays: int(rpcResp.Plan.DurationDays),
-			CreatedAt:    rpcResp.Plan.CreatedAt,
-			UpdatedAt:    rpcResp.Plan.UpdatedAt,
-		},
+		Data:     toVipPlan(rpcResp.Plan),
 	}, nil
 }
@@ -38,15 +38,7 @@ func (l *GetVipPlansLogic) GetVipPlans(req *types.EmptyReq) (resp *types.GetVipP
 	// 转换为API响应格式
 	respPlans := make([]types.VipPlan, 0, len(rpcResp.Plans))
 	for _, plan := range rpcResp.Plans {
-		respPlans = append(respPlans, types.VipPlan{
-			Id:           plan.Id,
-			Name:         plan.Name,
-			Description:  plan.Description,
-			Price:        float64(plan.Price),
-			DurationDays: int(plan.DurationDays),
-			CreatedAt:    plan.CreatedAt,
-			UpdatedAt:    plan.UpdatedAt,
-		})
+		respPlans = append(respPlans, toVipPlan(plan))
 	}
 
 	return &types.GetVipPlansResp{
@@ -54,3 +46,33 @@ func (l *GetVipPlansLogic) GetVipPlans(req *types.EmptyReq) (resp *types.GetVipP
 		Data:     respPlans,
 	}, nil
 }
+
+// toVipPlan 将RPC套餐转换为API结构
+func toVipPlan(plan *super.VipPlan) types.VipPlan {
+	features := types.PlanFeatures{
+		Quotas:     map[string]int{},
+		ModelTiers: []string{},
+	}
+	if plan.Features != nil {
+		for feature, quota := range plan.Features.Quotas {
+			features.Quotas[feature] = int(quota)
+		}
+		if plan.Features.ModelTiers != nil {
+			features.ModelTiers = plan.Features.ModelTiers
+		}
+		features.MaxTokens = int(plan.Features.MaxTokens)
+		features.TokenBudget = int(plan.Features.TokenBudget)
+		features.ResetPolicy = plan.Features.ResetPolicy
+	}
+
+	return types.VipPlan{
+		Id:           plan.Id,
+		Name:         plan.Name,
+		Description:  plan.Description,
+		Price:        float64(plan.Price),
+		DurationDays: int(plan.DurationDays),
+		Features:     features,
+		CreatedAt:    plan.CreatedAt,
+		UpdatedAt:    plan.UpdatedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/api/internal/common"
+	"backend/model"
+)
+
+// permissionMiddleware 权限校验中间件，需在UserAuth之后执行，
+// 各路由需要的权限在 super.api 的 @server middleware 中声明
+type permissionMiddleware struct {
+	permission string
+}
+
+func (m *permissionMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, ok := common.AuthUserFromContext(r.Context())
+		if !ok {
+			writeAuthError(w, r, http.StatusUnauthorized, "未登录或登录已过期")
+			return
+		}
+		if !model.HasPermission(user.Role, m.permission) {
+			writeAuthError(w, r, http.StatusForbidden, "没有操作权限")
+			return
+		}
+
+		next(w, r)
+	}
+}
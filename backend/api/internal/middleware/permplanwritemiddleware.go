@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/model"
+
+// PermPlanWriteMiddleware 要求登录用户拥有plan:write权限（管理VIP套餐）
+type PermPlanWriteMiddleware struct {
+	permissionMiddleware
+}
+
+func NewPermPlanWriteMiddleware() *PermPlanWriteMiddleware {
+	return &PermPlanWriteMiddleware{
+		permissionMiddleware{permission: model.PermPlanWrite},
+	}
+}
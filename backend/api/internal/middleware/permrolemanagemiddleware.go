@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/model"
+
+// PermRoleManageMiddleware 要求登录用户拥有role:manage权限（管理用户角色）
+type PermRoleManageMiddleware struct {
+	permissionMiddleware
+}
+
+func NewPermRoleManageMiddleware() *PermRoleManageMiddleware {
+	return &PermRoleManageMiddleware{
+		permissionMiddleware{permission: model.PermRoleManage},
+	}
+}
@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/model"
+
+// PermUserReadMiddleware 要求登录用户拥有user:read权限（查看任意用户）
+type PermUserReadMiddleware struct {
+	permissionMiddleware
+}
+
+func NewPermUserReadMiddleware() *PermUserReadMiddleware {
+	return &PermUserReadMiddleware{
+		permissionMiddleware{permission: model.PermUserRead},
+	}
+}
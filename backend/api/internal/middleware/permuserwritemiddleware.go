@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/model"
+
+// PermUserWriteMiddleware 要求登录用户拥有user:write权限（修改、删除任意用户）
+type PermUserWriteMiddleware struct {
+	permissionMiddleware
+}
+
+func NewPermUserWriteMiddleware() *PermUserWriteMiddleware {
+	return &PermUserWriteMiddleware{
+		permissionMiddleware{permission: model.PermUserWrite},
+	}
+}
@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/api/internal/ratelimit"
+
+// RateLimitAIMiddleware ai路由组的限流
+type RateLimitAIMiddleware struct {
+	rateLimitMiddleware
+}
+
+func NewRateLimitAIMiddleware(limiter ratelimit.Limiter, config ratelimit.Config) *RateLimitAIMiddleware {
+	return &RateLimitAIMiddleware{
+		rateLimitMiddleware{group: "ai", limiter: limiter, config: config},
+	}
+}
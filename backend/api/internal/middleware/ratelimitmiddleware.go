@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/ratelimit"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// rateLimitMiddleware 按路由组限流的中间件，规则见 ratelimit.Config.Groups
+// 已登录的请求按用户ID计数（需在UserAuth之后执行），未登录的请求按客户端IP计数
+type rateLimitMiddleware struct {
+	group   string
+	limiter ratelimit.Limiter
+	config  ratelimit.Config
+}
+
+func (m *rateLimitMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	rule, ok := m.config.Groups[m.group]
+	if !ok {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		allowed, retryAfter, err := m.limiter.Allow(r.Context(), m.key(r), rule)
+		if err != nil {
+			// 限流存储不可用时放行，避免影响正常请求
+			logx.WithContext(r.Context()).Errorf("限流检查失败: %v", err)
+			next(w, r)
+			return
+		}
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(max(seconds, 1)))
+			writeAuthError(w, r, http.StatusTooManyRequests, "请求过于频繁，请稍后重试")
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// key 限流计数的key：路由组 + 登录用户ID或客户端IP
+func (m *rateLimitMiddleware) key(r *http.Request) string {
+	if user, ok := common.AuthUserFromContext(r.Context()); ok {
+		return m.group + ":user:" + user.UserID
+	}
+	return m.group + ":ip:" + common.ClientIP(r, m.config.ProxyHops())
+}
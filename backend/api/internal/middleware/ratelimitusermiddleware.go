@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/api/internal/ratelimit"
+
+// RateLimitUserMiddleware user路由组的限流
+type RateLimitUserMiddleware struct {
+	rateLimitMiddleware
+}
+
+func NewRateLimitUserMiddleware(limiter ratelimit.Limiter, config ratelimit.Config) *RateLimitUserMiddleware {
+	return &RateLimitUserMiddleware{
+		rateLimitMiddleware{group: "user", limiter: limiter, config: config},
+	}
+}
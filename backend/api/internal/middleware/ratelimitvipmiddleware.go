@@ -0,0 +1,14 @@
+package middleware
+
+import "backend/api/internal/ratelimit"
+
+// RateLimitVipMiddleware vip路由组的限流
+type RateLimitVipMiddleware struct {
+	rateLimitMiddleware
+}
+
+func NewRateLimitVipMiddleware(limiter ratelimit.Limiter, config ratelimit.Config) *RateLimitVipMiddleware {
+	return &RateLimitVipMiddleware{
+		rateLimitMiddleware{group: "vip", limiter: limiter, config: config},
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/collection"
+)
+
+// TokenRevocation 访问Token黑名单查询
+// 查询结果在网关本地缓存 ttl 时间以减少RPC调用，多实例部署时其他实例最多延迟 ttl 生效
+type TokenRevocation struct {
+	client super.SuperClient
+	cache  *collection.Cache
+}
+
+func NewTokenRevocation(client super.SuperClient, ttl time.Duration) *TokenRevocation {
+	cache, err := collection.NewCache(ttl, collection.WithName("token-revocation"))
+	if err != nil {
+		panic(err)
+	}
+
+	return &TokenRevocation{
+		client: client,
+		cache:  cache,
+	}
+}
+
+// IsRevoked 查询Token是否已被吊销
+func (t *TokenRevocation) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	val, err := t.cache.Take(tokenID, func() (any, error) {
+		resp, err := t.client.CheckTokenRevoked(ctx, &super.CheckTokenRevokedReq{
+			TokenId: tokenID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Revoked, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return val.(bool), nil
+}
+
+// MarkRevoked 在本地缓存中标记Token已吊销，登出后立即生效
+func (t *TokenRevocation) MarkRevoked(tokenID string) {
+	t.cache.Set(tokenID, true)
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend/api/internal/common"
+	"backend/api/internal/types"
+	"backend/model"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+)
+
+// UserAuthMiddleware 用户认证中间件
+// 使用 utils.ParseToken 校验Token（支持密钥环和非对称签名，go-zero自带的jwt只支持HMAC），
+// 将Token中的用户信息写入context，并校验路径中的 :user_id 与登录用户一致，
+// 访问其他用户的数据需要 user:read（查询）或 user:write（修改）权限
+type UserAuthMiddleware struct {
+	revocation *TokenRevocation
+}
+
+func NewUserAuthMiddleware(revocation *TokenRevocation) *UserAuthMiddleware {
+	return &UserAuthMiddleware{
+		revocation: revocation,
+	}
+}
+
+func (m *UserAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// 1. 解析Token
+		claims, err := utils.ParseToken(bearerToken(r))
+		if err != nil || claims.UserID == 0 || claims.ID == "" || claims.ExpiresAt == nil {
+			writeAuthError(w, r, http.StatusUnauthorized, "未登录或登录已过期")
+			return
+		}
+
+		// 2. 检查Token是否已登出
+		revoked, err := m.revocation.IsRevoked(r.Context(), claims.ID)
+		if err != nil {
+			logx.WithContext(r.Context()).Errorf("查询Token黑名单失败: %v", err)
+			writeAuthError(w, r, http.StatusServiceUnavailable, "认证服务暂不可用，请稍后重试")
+			return
+		}
+		if revoked {
+			writeAuthError(w, r, http.StatusUnauthorized, "登录已失效，请重新登录")
+			return
+		}
+
+		userID := strconv.FormatUint(uint64(claims.UserID), 10)
+		role := claims.Role
+		if role == "" {
+			role = model.RoleUser
+		}
+
+		// 3. 路径中的用户ID必须与Token一致，有权限的角色可以操作任意用户
+		if pathUserID, ok := pathvar.Vars(r)["user_id"]; ok && pathUserID != userID &&
+			!model.HasPermission(role, crossUserPermission(r.Method)) {
+			writeAuthError(w, r, http.StatusForbidden, "无权访问其他用户的数据")
+			return
+		}
+
+		// 4. 将登录用户写入context
+		ctx := common.WithAuthUser(r.Context(), &common.AuthUser{
+			UserID:         userID,
+			Username:       claims.Username,
+			Role:           role,
+			TokenID:        claims.ID,
+			TokenExpiresAt: claims.ExpiresAt.Unix(),
+		})
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// crossUserPermission 访问其他用户数据所需的权限
+func crossUserPermission(method string) string {
+	if method == http.MethodGet || method == http.MethodHead {
+		return model.PermUserRead
+	}
+	return model.PermUserWrite
+}
+
+// bearerToken 从 Authorization 请求头中获取Token
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "Bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// writeAuthError 返回认证失败响应
+func writeAuthError(w http.ResponseWriter, r *http.Request, code int, message string) {
+	httpx.WriteJsonCtx(r.Context(), w, code, types.BaseResp{
+		Code:    code,
+		Message: message,
+		Success: false,
+	})
+}
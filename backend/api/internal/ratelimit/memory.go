@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepThreshold 令牌桶数量超过该值时清理已回满的桶
+const sweepThreshold = 10000
+
+// bucket 令牌桶
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Time // 桶回满的时间，之后可以删除
+}
+
+// MemoryLimiter 进程内令牌桶限流器
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Allow 从key对应的令牌桶中取一个令牌
+func (l *MemoryLimiter) Allow(_ context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	now := time.Now()
+	burst := float64(rule.Burst)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.buckets) >= sweepThreshold {
+		l.sweep(now)
+	}
+
+	// 1. 按经过的时间补充令牌，不超过桶容量
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rule.Rate)
+	b.last = now
+
+	// 2. 令牌不足时计算补足一个令牌需要的时间
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / rule.Rate * float64(time.Second))
+		return false, wait, nil
+	}
+	b.tokens--
+	b.full = now.Add(time.Duration((burst - b.tokens) / rule.Rate * float64(time.Second)))
+
+	return true, 0, nil
+}
+
+// sweep 删除已回满的令牌桶，回满的桶与新建的桶等价
+func (l *MemoryLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.After(b.full) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 限流存储类型
+const (
+	StoreMemory = "memory" // 进程内存储，每个网关实例单独计数
+	StoreRedis  = "redis"  // Redis（或兼容Redis协议的存储）共享计数，多实例部署时使用
+)
+
+// Rule 令牌桶限流规则
+type Rule struct {
+	Rate  float64 // 每秒补充的令牌数，即长期平均每秒允许的请求数
+	Burst int     // 桶容量，即允许的突发请求数
+}
+
+// Config 网关限流配置
+type Config struct {
+	Store string          `json:",default=memory,options=memory|redis"`
+	Redis redis.RedisConf `json:",optional"`
+	// 各路由组的限流规则，key为 super.api 中的group名称（user, vip, ai），未配置的组不限流
+	Groups map[string]Rule `json:",optional"`
+	// 是否信任 X-Forwarded-For 请求头中的客户端IP，只有网关部署在可信的反向代理之后时才能开启
+	TrustForwardedFor bool `json:",default=false"`
+	// 网关前可信反向代理的层数，客户端IP取 X-Forwarded-For 中从右往左数第该层数个地址
+	TrustedProxies int `json:",default=1"`
+}
+
+// ProxyHops 从 X-Forwarded-For 获取客户端IP时跳过的可信代理层数，未开启信任时为0
+func (c Config) ProxyHops() int {
+	if !c.TrustForwardedFor {
+		return 0
+	}
+	return c.TrustedProxies
+}
+
+// Limiter 令牌桶限流器
+type Limiter interface {
+	// Allow 从key对应的令牌桶中取一个令牌，取不到时返回需要等待的时间
+	Allow(ctx context.Context, key string, rule Rule) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// New 根据配置创建限流器
+func New(c Config) (Limiter, error) {
+	if c.TrustForwardedFor && c.TrustedProxies <= 0 {
+		return nil, fmt.Errorf("开启TrustForwardedFor时TrustedProxies必须大于0")
+	}
+	for group, rule := range c.Groups {
+		if rule.Rate <= 0 || rule.Burst <= 0 {
+			return nil, fmt.Errorf("路由组%s的限流规则无效：Rate和Burst必须大于0", group)
+		}
+	}
+
+	switch c.Store {
+	case StoreRedis:
+		store, err := redis.NewRedis(c.Redis)
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisLimiter(store), nil
+	default:
+		return NewMemoryLimiter(), nil
+	}
+}
@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis/redistest"
+)
+
+// limiters 两种存储的限流器，行为应一致
+func limiters(t *testing.T) map[string]Limiter {
+	return map[string]Limiter{
+		StoreMemory: NewMemoryLimiter(),
+		StoreRedis:  NewRedisLimiter(redistest.CreateRedis(t)),
+	}
+}
+
+// 桶容量内的突发请求全部放行，用完后拒绝并返回补足一个令牌的等待时间
+func TestAllowRejectsAfterBurst(t *testing.T) {
+	rule := Rule{Rate: 0.1, Burst: 3}
+
+	for name, l := range limiters(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for i := 0; i < rule.Burst; i++ {
+				allowed, _, err := l.Allow(ctx, "burst", rule)
+				if err != nil || !allowed {
+					t.Fatalf("第%d个请求: allowed=%v err=%v", i+1, allowed, err)
+				}
+			}
+
+			allowed, retryAfter, err := l.Allow(ctx, "burst", rule)
+			if err != nil {
+				t.Fatalf("限流失败: %v", err)
+			}
+			if allowed {
+				t.Fatal("超过桶容量的请求应被拒绝")
+			}
+			// 每10秒补充一个令牌
+			if retryAfter <= 9*time.Second || retryAfter > 10*time.Second {
+				t.Fatalf("等待时间 = %v，期望接近10秒", retryAfter)
+			}
+		})
+	}
+}
+
+// 不同key使用独立的令牌桶
+func TestAllowSeparatesKeys(t *testing.T) {
+	rule := Rule{Rate: 0.1, Burst: 1}
+
+	for name, l := range limiters(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if allowed, _, _ := l.Allow(ctx, "user:1", rule); !allowed {
+				t.Fatal("第一个请求应放行")
+			}
+			if allowed, _, _ := l.Allow(ctx, "user:1", rule); allowed {
+				t.Fatal("同一key超过桶容量应被拒绝")
+			}
+			if allowed, _, _ := l.Allow(ctx, "user:2", rule); !allowed {
+				t.Fatal("其他key不应受影响")
+			}
+		})
+	}
+}
+
+// 令牌按经过的时间补充
+func TestAllowRefillsOverTime(t *testing.T) {
+	rule := Rule{Rate: 100, Burst: 1}
+
+	for name, l := range limiters(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if allowed, _, _ := l.Allow(ctx, "refill", rule); !allowed {
+				t.Fatal("第一个请求应放行")
+			}
+			if allowed, _, _ := l.Allow(ctx, "refill", rule); allowed {
+				t.Fatal("令牌用完后应被拒绝")
+			}
+
+			time.Sleep(30 * time.Millisecond)
+			if allowed, _, err := l.Allow(ctx, "refill", rule); err != nil || !allowed {
+				t.Fatalf("补充令牌后应放行: allowed=%v err=%v", allowed, err)
+			}
+		})
+	}
+}
+
+// Redis中的令牌桶在回满后过期，不会无限占用内存
+func TestRedisBucketExpiresWhenFull(t *testing.T) {
+	store := redistest.CreateRedis(t)
+	l := NewRedisLimiter(store)
+	rule := Rule{Rate: 1, Burst: 5}
+
+	if _, _, err := l.Allow(context.Background(), "ttl", rule); err != nil {
+		t.Fatalf("限流失败: %v", err)
+	}
+
+	// 用掉一个令牌，1秒后回满，再加1秒余量
+	ttl, err := store.Ttl(keyPrefix + "ttl")
+	if err != nil {
+		t.Fatalf("读取过期时间失败: %v", err)
+	}
+	if ttl < 1 || ttl > 2 {
+		t.Fatalf("令牌桶过期时间 = %d秒，期望为1到2秒", ttl)
+	}
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Config
+	}{
+		{"信任代理但层数为0", Config{TrustForwardedFor: true, TrustedProxies: 0}},
+		{"Rate为0", Config{Groups: map[string]Rule{"user": {Rate: 0, Burst: 1}}}},
+		{"Burst为0", Config{Groups: map[string]Rule{"user": {Rate: 1, Burst: 0}}}},
+	}
+
+	for _, tt := range tests {
+		if _, err := New(tt.c); err == nil {
+			t.Errorf("%s: 期望返回错误", tt.name)
+		}
+	}
+}
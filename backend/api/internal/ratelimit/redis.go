@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// keyPrefix Redis中令牌桶的key前缀
+const keyPrefix = "super:ratelimit:"
+
+// tokenBucketScript 令牌桶脚本，读取、补充和扣减在Redis中原子执行
+// KEYS[1]: 令牌桶key
+// ARGV[1]: 每秒补充的令牌数, ARGV[2]: 桶容量, ARGV[3]: 当前时间（毫秒）
+// 返回 {是否允许, 需要等待的毫秒数}
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil((burst - tokens) * 1000 / rate) + 1000)
+return {allowed, wait}
+`)
+
+// RedisLimiter 基于Redis的令牌桶限流器，多个网关实例共享计数
+type RedisLimiter struct {
+	store *redis.Redis
+}
+
+func NewRedisLimiter(store *redis.Redis) *RedisLimiter {
+	return &RedisLimiter{store: store}
+}
+
+// Allow 从key对应的令牌桶中取一个令牌
+func (l *RedisLimiter) Allow(ctx context.Context, key string, rule Rule) (bool, time.Duration, error) {
+	result, err := l.store.ScriptRunCtx(ctx, tokenBucketScript, []string{keyPrefix + key},
+		strconv.FormatFloat(rule.Rate, 'f', -1, 64),
+		strconv.Itoa(rule.Burst),
+		strconv.FormatInt(time.Now().UnixMilli(), 10),
+	)
+	if err != nil {
+		return false, 0, err
+	}
+
+	values, ok := result.([]any)
+	if !ok || len(values) != 2 {
+		return false, 0, errors.New("限流脚本返回格式错误")
+	}
+	allowed, _ := values[0].(int64)
+	wait, _ := values[1].(int64)
+
+	return allowed == 1, time.Duration(wait) * time.Millisecond, nil
+}
@@ -1,23 +1,61 @@
 package svc
 
 import (
+	"time"
+
 	"backend/api/internal/config"
+	"backend/api/internal/middleware"
+	"backend/api/internal/ratelimit"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
+	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
 	Config         config.Config
 	SuperRpcClient super.SuperClient
+	UserAuth       rest.Middleware
+	PermUserRead   rest.Middleware
+	PermUserWrite  rest.Middleware
+	PermPlanWrite  rest.Middleware
+	PermRoleManage rest.Middleware
+	RateLimitUser  rest.Middleware
+	RateLimitVip   rest.Middleware
+	RateLimitAI    rest.Middleware
+
+	TokenRevocation *middleware.TokenRevocation // 访问Token黑名单
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	// 初始化JWT密钥，用于校验请求中的Token
+	utils.MustInitJWT(c.JWT)
+
 	// 创建RPC客户端
 	rpcClient := zrpc.MustNewClient(c.SuperRpc)
+	superRpcClient := super.NewSuperClient(rpcClient.Conn())
+
+	// 访问Token黑名单，由UserAuth中间件查询，登出时更新
+	tokenRevocation := middleware.NewTokenRevocation(superRpcClient, time.Duration(c.TokenRevocationCacheSeconds)*time.Second)
+
+	// 限流器，多实例部署时使用Redis共享计数
+	limiter, err := ratelimit.New(c.RateLimit)
+	if err != nil {
+		panic(err)
+	}
 
 	return &ServiceContext{
-		Config:         c,
-		SuperRpcClient: super.NewSuperClient(rpcClient.Conn()),
+		Config:          c,
+		SuperRpcClient:  superRpcClient,
+		UserAuth:        middleware.NewUserAuthMiddleware(tokenRevocation).Handle,
+		PermUserRead:    middleware.NewPermUserReadMiddleware().Handle,
+		PermUserWrite:   middleware.NewPermUserWriteMiddleware().Handle,
+		PermPlanWrite:   middleware.NewPermPlanWriteMiddleware().Handle,
+		PermRoleManage:  middleware.NewPermRoleManageMiddleware().Handle,
+		RateLimitUser:   middleware.NewRateLimitUserMiddleware(limiter, c.RateLimit).Handle,
+		RateLimitVip:    middleware.NewRateLimitVipMiddleware(limiter, c.RateLimit).Handle,
+		RateLimitAI:     middleware.NewRateLimitAIMiddleware(limiter, c.RateLimit).Handle,
+		TokenRevocation: tokenRevocation,
 	}
 }
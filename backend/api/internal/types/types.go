@@ -3,8 +3,35 @@
 
 package types
 
+type AICreditGrant struct {
+	Id        string `json:"id"`
+	PackId    string `json:"pack_id"`
+	OrderId   string `json:"order_id"`
+	Credits   int    `json:"credits"`   // 发放的次数
+	Remaining int    `json:"remaining"` // 剩余次数
+	ExpiresAt string `json:"expires_at"`
+	CreatedAt string `json:"created_at"`
+}
+
+type AICreditLedgerEntry struct {
+	Id        string `json:"id"`
+	GrantId   string `json:"grant_id"`
+	Type      string `json:"type"`    // grant, spend
+	Amount    int    `json:"amount"`  // 变动次数，发放为正数，消耗为负数
+	Feature   string `json:"feature"` // 消耗的AI功能，发放时为空
+	OrderId   string `json:"order_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type AICreditsData struct {
+	Balance int64           `json:"balance"` // 可用额度
+	Grants  []AICreditGrant `json:"grants"`  // 未到期且有剩余的额度，先到期的在前
+}
+
 type AIRequestReq struct {
-	Prompt string `json:"prompt"`
+	Prompt    string `json:"prompt"`
+	UsageType string `json:"usage_type,optional"` // chat, content, analysis，默认chat
+	ModelTier string `json:"model_tier,optional"` // basic, advanced, premium，默认使用套餐可用的最高等级
 }
 
 type AIRequestResp struct {
@@ -14,9 +41,19 @@ type AIRequestResp struct {
 
 type AIResponseData struct {
 	Response string      `json:"response"`
+	Model    string      `json:"model"`
 	Usage    AIUsageData `json:"usage"`
 }
 
+type AIStreamChunk struct {
+	Delta   string       `json:"delta"`
+	Done    bool         `json:"done"`
+	Model   string       `json:"model,omitempty"`
+	Usage   *AIUsageData `json:"usage,omitempty"`
+	Code    int          `json:"code,omitempty"`    // 出错时的状态码
+	Message string       `json:"message,omitempty"` // 出错时的错误信息
+}
+
 type AIUsageData struct {
 	IsVip           bool   `json:"is_vip"`
 	AIChatCount     int    `json:"ai_chat_count"`
@@ -26,6 +63,46 @@ type AIUsageData struct {
 	AIAnalysisCount int    `json:"ai_analysis_count"`
 	AIAnalysisLimit int    `json:"ai_analysis_limit"`
 	AILastResetAt   string `json:"ai_last_reset_at"`
+	TokenUsed       int64  `json:"token_used"`     // 本周期已使用的token数
+	TokenBudget     int64  `json:"token_budget"`   // 每个周期的token预算，0表示不限制
+	NextResetAt     string `json:"next_reset_at"`  // 下次重置时间（RFC3339，使用用户时区）
+	ResetPolicy     string `json:"reset_policy"`   // 额度重置策略
+	CreditBalance   int64  `json:"credit_balance"` // 可用的加油包额度，每月额度用完后抵扣
+}
+
+type AIUsageLedgerEntry struct {
+	Id               string `json:"id"`
+	Feature          string `json:"feature"`
+	Model            string `json:"model"`
+	ModelTier        string `json:"model_tier"`
+	PromptTokens     int    `json:"prompt_tokens"`
+	CompletionTokens int    `json:"completion_tokens"`
+	TotalTokens      int    `json:"total_tokens"`
+	LatencyMs        int64  `json:"latency_ms"`
+	Status           string `json:"status"` // success, failed, cancelled
+	PlanId           string `json:"plan_id"`
+	OrderId          string `json:"order_id"`
+	CreatedAt        string `json:"created_at"`
+}
+
+type AccountDeletionData struct {
+	Scheduled   bool   `json:"scheduled"`    // 是否已申请注销
+	RequestedAt string `json:"requested_at"` // 申请时间
+	PurgeAt     string `json:"purge_at"`     // 冷静期结束、数据将被清除的时间
+}
+
+type AccountDeletionResp struct {
+	BaseResp
+	Data AccountDeletionData `json:"data"`
+}
+
+type AuthorizeOAuthData struct {
+	AuthorizationUrl string `json:"authorization_url"` // 第三方授权页地址，前端跳转到该地址
+}
+
+type AuthorizeOAuthResp struct {
+	BaseResp
+	Data AuthorizeOAuthData `json:"data"`
 }
 
 type BaseResp struct {
@@ -34,6 +111,16 @@ type BaseResp struct {
 	Success bool   `json:"success"`
 }
 
+type CancelVipOrderReq struct {
+	UserId  string `path:"user_id"`
+	OrderNo string `path:"order_no"`
+}
+
+type CancelVipOrderResp struct {
+	BaseResp
+	Data VipOrder `json:"data"`
+}
+
 type CheckUserVipReq struct {
 	UserId string `path:"user_id"`
 }
@@ -43,9 +130,25 @@ type CheckUserVipResp struct {
 	Data bool `json:"data"`
 }
 
+type CreateCreditPackReq struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,optional"`
+	Price       float64 `json:"price"`
+	Credits     int     `json:"credits"`
+	ValidDays   int     `json:"valid_days"`
+}
+
+type CreateCreditPackResp struct {
+	BaseResp
+	Data CreditPack `json:"data"`
+}
+
 type CreateVipOrderReq struct {
-	UserId string `path:"user_id"`
-	PlanId string `json:"plan_id"`
+	UserId         string `path:"user_id"`
+	PlanId         string `json:"plan_id,optional"`           // 购买VIP套餐时必填
+	ProductType    string `json:"product_type,optional"`      // 商品类型：vip_plan（默认）, credit_pack
+	CreditPackId   string `json:"credit_pack_id,optional"`    // 购买加油包时必填
+	IdempotencyKey string `header:"Idempotency-Key,optional"` // 客户端重试时携带相同的值，返回首次创建的订单
 }
 
 type CreateVipOrderResp struct {
@@ -54,10 +157,11 @@ type CreateVipOrderResp struct {
 }
 
 type CreateVipPlanReq struct {
-	Name         string  `json:"name"`
-	Description  string  `json:"description"`
-	Price        float64 `json:"price"`
-	DurationDays int     `json:"duration_days"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Price        float64      `json:"price"`
+	DurationDays int          `json:"duration_days"`
+	Features     PlanFeatures `json:"features"`
 }
 
 type CreateVipPlanResp struct {
@@ -65,12 +169,64 @@ type CreateVipPlanResp struct {
 	Data VipPlan `json:"data"`
 }
 
+type CreditPack struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Price       float64 `json:"price"`
+	Credits     int     `json:"credits"`    // 包含的AI调用次数
+	ValidDays   int     `json:"valid_days"` // 购买后的有效期（天数）
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type DataExportData struct {
+	Id                string `json:"id"`
+	Status            string `json:"status"` // 状态：pending 等待生成, running 生成中, ready 可下载, failed 生成失败, expired 已过期
+	Size              int64  `json:"size"`   // 导出文件大小（字节）
+	CreatedAt         string `json:"created_at"`
+	CompletedAt       string `json:"completed_at"`        // 生成完成时间
+	ExpiresAt         string `json:"expires_at"`          // 导出文件过期时间
+	DownloadUrl       string `json:"download_url"`        // 带签名的下载链接，仅在ready时返回
+	DownloadExpiresAt string `json:"download_expires_at"` // 下载链接过期时间，过期后重新查询获取新链接
+}
+
+type DataExportResp struct {
+	BaseResp
+	Data DataExportData `json:"data"`
+}
+
+type DeleteUserData struct {
+	PurgeAt string `json:"purge_at"` // 计划彻底清除的时间，在此之前可恢复；用户只存在于认证服务时为空
+}
+
 type DeleteUserReq struct {
 	UserId string `path:"user_id"`
 }
 
 type DeleteUserResp struct {
 	BaseResp
+	Data DeleteUserData `json:"data"`
+}
+
+type DeletedUser struct {
+	Id        string `json:"id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	DeletedAt string `json:"deleted_at"`
+	Source    string `json:"source"`   // 删除来源：admin, reconcile
+	PurgeAt   string `json:"purge_at"` // 计划彻底清除的时间
+}
+
+type DisableTwoFactorReq struct {
+	UserId string `path:"user_id"`
+	Code   string `json:"code"` // 验证码或恢复码
+}
+
+type DownloadDataExportReq struct {
+	Id        string `form:"id"`
+	Expires   int64  `form:"expires"`
+	Signature string `form:"signature"`
 }
 
 type EmptyReq struct {
@@ -79,11 +235,80 @@ type EmptyReq struct {
 type EmptyResp struct {
 }
 
+type EnableTwoFactorData struct {
+	RecoveryCodes []string `json:"recovery_codes"` // 一次性恢复码，只在启用时返回一次
+}
+
+type EnableTwoFactorReq struct {
+	UserId string `path:"user_id"`
+	Code   string `json:"code"`
+}
+
+type EnableTwoFactorResp struct {
+	BaseResp
+	Data EnableTwoFactorData `json:"data"`
+}
+
+type ForgotPasswordReq struct {
+	Email string `json:"email"`
+}
+
+type GetAICreditLedgerReq struct {
+	Page     int `form:"page,default=1"`
+	PageSize int `form:"page_size,default=20"`
+}
+
+type GetAICreditLedgerResp struct {
+	BaseResp
+	Data  []AICreditLedgerEntry `json:"data"`
+	Total int                   `json:"total"`
+}
+
+type GetAICreditsResp struct {
+	BaseResp
+	Data AICreditsData `json:"data"`
+}
+
+type GetAIUsageHistoryReq struct {
+	UserId    string `path:"user_id"`
+	StartDate string `form:"start_date,optional"` // 开始日期（含），格式2006-01-02
+	EndDate   string `form:"end_date,optional"`   // 结束日期（含），格式2006-01-02
+	Page      int    `form:"page,default=1"`
+	PageSize  int    `form:"page_size,default=20"`
+}
+
+type GetAIUsageHistoryResp struct {
+	BaseResp
+	Data  []AIUsageLedgerEntry `json:"data"`
+	Total int                  `json:"total"`
+}
+
 type GetAIUsageResp struct {
 	BaseResp
 	Data AIUsageData `json:"data"`
 }
 
+type GetCreditPacksResp struct {
+	BaseResp
+	Data []CreditPack `json:"data"`
+}
+
+type GetDataExportReq struct {
+	UserId   string `path:"user_id"`
+	ExportId string `path:"export_id"`
+}
+
+type GetDeletedUsersResp struct {
+	BaseResp
+	Data  []DeletedUser `json:"data"`
+	Total int           `json:"total"`
+}
+
+type GetOAuthProvidersResp struct {
+	BaseResp
+	Data []OAuthProvider `json:"data"`
+}
+
 type GetUserActiveVipRecordReq struct {
 	UserId string `path:"user_id"`
 }
@@ -98,6 +323,11 @@ type GetUserCountResp struct {
 	Data int `json:"data"`
 }
 
+type GetUserIdentitiesResp struct {
+	BaseResp
+	Data []UserIdentity `json:"data"`
+}
+
 type GetUserInfoReq struct {
 	UserId string `path:"user_id"`
 }
@@ -170,9 +400,31 @@ type GetVipPlansResp struct {
 	Data []VipPlan `json:"data"`
 }
 
+type GrantUserRoleReq struct {
+	UserId string `path:"user_id"`
+	Role   string `json:"role,options=user|operator|admin"`
+}
+
+type LinkUserIdentityReq struct {
+	UserId   string `path:"user_id"`
+	Provider string `path:"provider"`
+	Code     string `json:"code"`
+	State    string `json:"state"`
+}
+
+type LinkUserIdentityResp struct {
+	BaseResp
+	Data UserIdentity `json:"data"`
+}
+
 type LoginData struct {
-	User  User   `json:"user"`
-	Token string `json:"token"`
+	User               User   `json:"user"`
+	Token              string `json:"token"`
+	RefreshToken       string `json:"refresh_token"`
+	ExpiresIn          int64  `json:"expires_in"`
+	TwoFactorRequired  bool   `json:"two_factor_required"`  // 需要两步验证，此时不返回Token，需携带challenge_token调用/api/user/login/2fa
+	ChallengeToken     string `json:"challenge_token"`      // 两步验证挑战令牌
+	ChallengeExpiresIn int64  `json:"challenge_expires_in"` // 挑战令牌有效期（秒）
 }
 
 type LoginReq struct {
@@ -186,6 +438,86 @@ type LoginResp struct {
 	Data LoginData `json:"data"`
 }
 
+type LogoutReq struct {
+	RefreshToken string `json:"refresh_token,optional"`
+}
+
+type OAuthLoginReq struct {
+	Provider string `path:"provider"`
+	Code     string `json:"code"`  // 第三方回调地址中的code
+	State    string `json:"state"` // 第三方回调地址中的state
+}
+
+type OAuthProvider struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type OAuthProviderReq struct {
+	Provider string `path:"provider"`
+}
+
+type PayVipOrderData struct {
+	OrderNo  string `json:"order_no"`
+	Provider string `json:"provider"`
+	PayUrl   string `json:"pay_url,omitempty"`
+	QrCode   string `json:"qr_code,omitempty"`
+}
+
+type PayVipOrderReq struct {
+	UserId    string `path:"user_id"`
+	OrderNo   string `path:"order_no"`
+	PayMethod string `json:"pay_method,optional"` // wechat, alipay
+}
+
+type PayVipOrderResp struct {
+	BaseResp
+	Data PayVipOrderData `json:"data"`
+}
+
+type PaymentNotifyReq struct {
+	Provider string `path:"provider"`
+}
+
+type PlanFeatures struct {
+	Quotas      map[string]int `json:"quotas"`                // 每个周期各AI功能的使用次数，key为chat, content, analysis
+	ModelTiers  []string       `json:"model_tiers"`           // 可使用的模型等级：basic, advanced, premium
+	MaxTokens   int            `json:"max_tokens"`            // 单次请求的最大输出token数
+	TokenBudget int            `json:"token_budget,optional"` // 每个周期的token预算，0表示不限制
+	ResetPolicy string         `json:"reset_policy,optional"` // 额度重置策略：calendar_month, billing_anchor, fixed_30d
+}
+
+type ReconcileUsersData struct {
+	RemoteUsers int32       `json:"remote_users"` // 认证服务用户数
+	LocalUsers  int32       `json:"local_users"`  // 本地用户数（含已删除）
+	Skipped     int32       `json:"skipped"`      // 有未投递的同步操作而跳过的用户数
+	Drifts      []UserDrift `json:"drifts"`
+}
+
+type ReconcileUsersReq struct {
+	DryRun bool `json:"dry_run,optional"` // 只报告差异，不修复
+}
+
+type ReconcileUsersResp struct {
+	BaseResp
+	Data ReconcileUsersData `json:"data"`
+}
+
+type RefreshTokenData struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type RefreshTokenResp struct {
+	BaseResp
+	Data RefreshTokenData `json:"data"`
+}
+
 type RegisterReq struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
@@ -197,6 +529,50 @@ type RegisterResp struct {
 	Data User `json:"data"`
 }
 
+type RequestAccountDeletionReq struct {
+	UserId   string `path:"user_id"`
+	Password string `json:"password"` // 当前密码
+}
+
+type ResetPasswordReq struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+type RestoreUserData struct {
+	UserId     string `json:"user_id"`
+	WasDeleted bool   `json:"was_deleted"` // 恢复前是否处于删除状态，为false表示只撤销了用户的注销申请
+}
+
+type RestoreUserResp struct {
+	BaseResp
+	Data RestoreUserData `json:"data"`
+}
+
+type RevokeUserRoleReq struct {
+	UserId string `path:"user_id"`
+}
+
+type SetUserRoleResp struct {
+	BaseResp
+	Data UserRoleData `json:"data"`
+}
+
+type SetupTwoFactorData struct {
+	Secret     string `json:"secret"`      // Base32密钥，无法扫码时手动输入
+	OtpauthUri string `json:"otpauth_uri"` // otpauth://地址，用于生成二维码
+}
+
+type SetupTwoFactorResp struct {
+	BaseResp
+	Data SetupTwoFactorData `json:"data"`
+}
+
+type StartDataExportReq struct {
+	UserId string `path:"user_id"`
+	Fresh  bool   `form:"fresh,optional"` // 已有未过期的导出文件时仍重新生成
+}
+
 type SyncUserVipStatusData struct {
 	IsVip     bool   `json:"is_vip"`
 	ExpiresAt string `json:"expires_at"`
@@ -211,6 +587,30 @@ type SyncUserVipStatusResp struct {
 	Data SyncUserVipStatusData `json:"data"`
 }
 
+type TwoFactorStatusData struct {
+	Enabled                bool  `json:"enabled"`
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining"` // 剩余可用的恢复码数量
+}
+
+type TwoFactorStatusResp struct {
+	BaseResp
+	Data TwoFactorStatusData `json:"data"`
+}
+
+type UnlockUserData struct {
+	UserId    string `json:"user_id"`
+	WasLocked bool   `json:"was_locked"` // 解除前是否处于锁定中
+}
+
+type UnlockUserReq struct {
+	UserId string `path:"user_id"`
+}
+
+type UnlockUserResp struct {
+	BaseResp
+	Data UnlockUserData `json:"data"`
+}
+
 type UpdateAIUsageReq struct {
 	UserId    string `path:"user_id"`
 	UsageType string `json:"usage_type"` // chat, content, analysis
@@ -231,6 +631,7 @@ type UpdateUserInfoReq struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
 	Avatar   string `json:"avatar"`
+	Timezone string `json:"timezone,optional"` // IANA时区，如Asia/Shanghai，为空时不修改
 }
 
 type UpdateUserInfoResp struct {
@@ -249,9 +650,9 @@ type UpdateUserPasswordResp struct {
 }
 
 type UpdateUserVipReq struct {
-	UserId     string `path:"user_id"`
-	IsVip      bool   `json:"is_vip"`
-	VipExpires string `json:"vip_expires"`
+	UserId string `path:"user_id"`
+	IsVip  bool   `json:"is_vip"`
+	PlanId string `json:"plan_id,optional"` // 开通VIP时使用的套餐，有效期按套餐时长计算
 }
 
 type UpdateUserVipResp struct {
@@ -269,6 +670,32 @@ type User struct {
 	IsVip        bool   `json:"is_vip"`
 	VipExpiresAt string `json:"vip_expires_at"`
 	AutoRenew    bool   `json:"auto_renew"`
+	Role         string `json:"role,omitempty"`
+}
+
+type UserDrift struct {
+	UserId   string `json:"user_id"`
+	Kind     string `json:"kind"`     // 差异类型：missing_local, missing_remote, remote_not_deleted, profile_mismatch, vip_mismatch
+	Detail   string `json:"detail"`   // 差异说明
+	Repaired bool   `json:"repaired"` // 是否已修复
+	Error    string `json:"error"`    // 修复失败的原因
+}
+
+type UserIdentity struct {
+	Provider    string `json:"provider"`
+	Email       string `json:"email"`
+	CreatedAt   string `json:"created_at"`
+	LastLoginAt string `json:"last_login_at"`
+}
+
+type UserIdentityReq struct {
+	UserId   string `path:"user_id"`
+	Provider string `path:"provider"`
+}
+
+type UserRoleData struct {
+	UserId string `json:"user_id"`
+	Role   string `json:"role"`
 }
 
 type UserVipStatusData struct {
@@ -277,25 +704,48 @@ type UserVipStatusData struct {
 	AutoRenew bool   `json:"auto_renew"`
 }
 
-type VipOrder struct {
-	Id        string  `json:"id"`
-	UserId    string  `json:"user_id"`
-	PlanId    string  `json:"plan_id"`
-	PlanName  string  `json:"plan_name"`
-	Amount    float64 `json:"amount"`
-	Status    string  `json:"status"`
-	CreatedAt string  `json:"created_at"`
-	PaidAt    string  `json:"paid_at"`
+type VerifyEmailData struct {
+	UserId string `json:"user_id"`
+	Email  string `json:"email"`
 }
 
-type VipPlan struct {
+type VerifyEmailReq struct {
+	Token string `json:"token"`
+}
+
+type VerifyEmailResp struct {
+	BaseResp
+	Data VerifyEmailData `json:"data"`
+}
+
+type VerifyTwoFactorLoginReq struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"` // 验证器应用中的6位验证码或一次性恢复码
+}
+
+type VipOrder struct {
 	Id           string  `json:"id"`
-	Name         string  `json:"name"`
-	Description  string  `json:"description"`
-	Price        float64 `json:"price"`
-	DurationDays int     `json:"duration_days"`
+	OrderNo      string  `json:"order_no"`
+	UserId       string  `json:"user_id"`
+	PlanId       string  `json:"plan_id"`
+	PlanName     string  `json:"plan_name"`
+	Amount       float64 `json:"amount"`
+	Status       string  `json:"status"`
 	CreatedAt    string  `json:"created_at"`
-	UpdatedAt    string  `json:"updated_at"`
+	PaidAt       string  `json:"paid_at"`
+	ProductType  string  `json:"product_type"`   // 商品类型：vip_plan, credit_pack
+	CreditPackId string  `json:"credit_pack_id"` // 加油包ID，购买加油包时有值
+}
+
+type VipPlan struct {
+	Id           string       `json:"id"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Price        float64      `json:"price"`
+	DurationDays int          `json:"duration_days"`
+	Features     PlanFeatures `json:"features"`
+	CreatedAt    string       `json:"created_at"`
+	UpdatedAt    string       `json:"updated_at"`
 }
 
 type VipRecord struct {
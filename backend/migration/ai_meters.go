@@ -0,0 +1,91 @@
+package migration
+
+import (
+	"time"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// aiMeterBatchSize 每批迁移的用户数
+const aiMeterBatchSize = 100
+
+// foldAIUsageIntoMeters 将旧的两套AI用量数据合并到ai_meters
+// 旧数据来源：users表的ai_chat_count/ai_content_count/ai_analysis_count，以及ai_usages表的单一计数。
+// 只迁移当前周期内的用量；ai_usages统计的是所有AI调用，对应聊天功能，与users表的聊天次数取较大值，
+// 既不重复计数，也不会因合并多给额度
+func foldAIUsageIntoMeters(tx *gorm.DB) error {
+	now := time.Now()
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	resetAt := periodStart.AddDate(0, 1, 0)
+
+	var lastID uint
+	for {
+		var users []model.User
+		if err := tx.Where("id > ?", lastID).Order("id").Limit(aiMeterBatchSize).Find(&users).Error; err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			return nil
+		}
+
+		userIDs := make([]uint, 0, len(users))
+		for _, u := range users {
+			userIDs = append(userIDs, u.ID)
+		}
+
+		// 1. 加载旧ai_usages表中仍在当前周期内的记录
+		var usages []model.AIUsage
+		if err := tx.Where("user_id IN ? AND reset_at > ?", userIDs, now).Find(&usages).Error; err != nil {
+			return err
+		}
+		legacy := make(map[uint]model.AIUsage, len(usages))
+		for _, u := range usages {
+			if prev, ok := legacy[u.UserID]; !ok || u.UsedCount > prev.UsedCount {
+				legacy[u.UserID] = u
+			}
+		}
+
+		// 2. 合并两处的用量
+		meters := make([]model.AIMeter, 0, len(users)*len(model.AIFeatures))
+		for _, u := range users {
+			used := map[string]int{}
+			if u.AILastResetAt != nil && !u.AILastResetAt.Before(periodStart) {
+				used[model.AIFeatureChat] = u.AIChatCount
+				used[model.AIFeatureContent] = u.AIContentCount
+				used[model.AIFeatureAnalysis] = u.AIAnalysisCount
+			}
+
+			var lastUsedAt *time.Time
+			if l, ok := legacy[u.ID]; ok {
+				if l.UsedCount > used[model.AIFeatureChat] {
+					used[model.AIFeatureChat] = l.UsedCount
+				}
+				lastUsedAt = l.LastUsedAt
+			}
+
+			for _, feature := range model.AIFeatures {
+				meter := model.AIMeter{
+					UserID:      u.ID,
+					Feature:     feature,
+					Used:        used[feature],
+					PeriodStart: periodStart,
+					ResetAt:     resetAt,
+				}
+				if feature == model.AIFeatureChat {
+					meter.LastUsedAt = lastUsedAt
+				}
+				meters = append(meters, meter)
+			}
+		}
+
+		// 3. 写入计量表，已存在的计量行以新表为准
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&meters).Error; err != nil {
+			return err
+		}
+
+		lastID = users[len(users)-1].ID
+	}
+}
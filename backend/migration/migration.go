@@ -0,0 +1,51 @@
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Migration 数据迁移，表结构由AutoMigrate维护，这里只处理需要转换的数据
+type Migration struct {
+	ID string               // 迁移ID，按执行顺序命名
+	Up func(*gorm.DB) error // 迁移逻辑，在事务中执行
+}
+
+// migrations 按顺序执行的迁移列表，已发布的迁移不能修改或删除
+var migrations = []Migration{
+	{ID: "20261018_fold_ai_usage_into_ai_meters", Up: foldAIUsageIntoMeters},
+	{ID: "20261018_convert_vip_plan_features", Up: convertVipPlanFeatures},
+	{ID: "20261018_detach_purged_orders", Up: detachPurgedOrders},
+	{ID: "20261018_rename_rolling_reset_policy", Up: renameRollingResetPolicy},
+}
+
+// Run 执行所有未执行的迁移
+// 每个迁移和它的执行记录在同一事务中提交，多个实例同时启动时只有一个会执行
+func Run(db *gorm.DB) error {
+	for _, m := range migrations {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.SchemaMigration{
+				ID:        m.ID,
+				AppliedAt: time.Now(),
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				// 已执行过
+				return nil
+			}
+			return m.Up(tx)
+		})
+		if err != nil {
+			return fmt.Errorf("执行数据迁移%s失败: %w", m.ID, err)
+		}
+	}
+
+	return nil
+}
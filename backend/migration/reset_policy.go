@@ -0,0 +1,54 @@
+package migration
+
+import (
+	"encoding/json"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+// legacyResetPolicyRolling30d 固定30天策略改名前的名称
+const legacyResetPolicyRolling30d = "rolling_30d"
+
+// renameRollingResetPolicy 将套餐权益中的rolling_30d改为fixed_30d
+// 该策略的周期在首次使用时开始、固定30天，并不按最近30天滑动统计，旧名称容易误解
+func renameRollingResetPolicy(tx *gorm.DB) error {
+	type planFeatures struct {
+		ID       uint
+		Features string
+	}
+
+	var plans []planFeatures
+	if err := tx.Table("vip_plans").Select("id, features").Find(&plans).Error; err != nil {
+		return err
+	}
+
+	for _, p := range plans {
+		// 按原始JSON修改，只替换reset_policy，其他字段保持不变
+		var features map[string]json.RawMessage
+		if json.Unmarshal([]byte(p.Features), &features) != nil {
+			continue
+		}
+		var policy string
+		if json.Unmarshal(features["reset_policy"], &policy) != nil || policy != legacyResetPolicyRolling30d {
+			continue
+		}
+
+		renamed, err := json.Marshal(model.ResetPolicyFixed30d)
+		if err != nil {
+			return err
+		}
+		features["reset_policy"] = renamed
+		data, err := json.Marshal(features)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Table("vip_plans").Where("id = ?", p.ID).Update("features", string(data)).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
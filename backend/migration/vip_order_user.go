@@ -0,0 +1,27 @@
+package migration
+
+import (
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+// detachPurgedOrders 订单的用户ID改为可空：彻底清除用户时保留的订单将用户ID置空，而不是置为0
+// 旧版本清除用户时将订单的用户ID置为0，改为空；外键重建为删除用户时置空，已存在的外键不会被AutoMigrate修改
+func detachPurgedOrders(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if err := migrator.AlterColumn(&model.VipOrder{}, "UserID"); err != nil {
+		return err
+	}
+
+	if err := tx.Table("vip_orders").Where("user_id = ?", 0).Update("user_id", nil).Error; err != nil {
+		return err
+	}
+
+	if migrator.HasConstraint(&model.VipOrder{}, "User") {
+		if err := migrator.DropConstraint(&model.VipOrder{}, "User"); err != nil {
+			return err
+		}
+	}
+	return migrator.CreateConstraint(&model.VipOrder{}, "User")
+}
@@ -0,0 +1,69 @@
+package migration
+
+import (
+	"encoding/json"
+	"strings"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+// legacyVipFeatures 套餐改为按权益计算额度前，所有VIP用户统一享有的AI权益
+var legacyVipFeatures = model.PlanFeatures{
+	Quotas: map[string]int{
+		model.AIFeatureChat:     100,
+		model.AIFeatureContent:  50,
+		model.AIFeatureAnalysis: 20,
+	},
+	ModelTiers: []string{model.ModelTierBasic},
+	MaxTokens:  4096,
+}
+
+// convertVipPlanFeatures 将vip_plans.features从自由文本转换为结构化的套餐权益
+// 旧数据中features保存的是套餐介绍（纯文本或字符串数组），移到description；
+// 旧套餐的权益使用原先统一的VIP额度，保证已购买的用户额度不变
+func convertVipPlanFeatures(tx *gorm.DB) error {
+	type legacyPlan struct {
+		ID          uint
+		Description string
+		Features    string
+	}
+
+	var plans []legacyPlan
+	if err := tx.Table("vip_plans").Select("id, description, features").Find(&plans).Error; err != nil {
+		return err
+	}
+
+	features, err := json.Marshal(legacyVipFeatures)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range plans {
+		var parsed model.PlanFeatures
+		if json.Unmarshal([]byte(p.Features), &parsed) == nil && parsed.Validate() == nil {
+			// 已经是结构化的权益
+			continue
+		}
+
+		description := p.Description
+		if description == "" {
+			var lines []string
+			if json.Unmarshal([]byte(p.Features), &lines) == nil {
+				description = strings.Join(lines, "\n")
+			} else {
+				description = p.Features
+			}
+		}
+
+		if err := tx.Table("vip_plans").Where("id = ?", p.ID).Updates(map[string]interface{}{
+			"description": description,
+			"features":    string(features),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+)
+
+// AI额度流水类型
+const (
+	AICreditEntryGrant = "grant" // 购买加油包发放
+	AICreditEntrySpend = "spend" // AI调用消耗
+)
+
+// AICreditGrant 一笔AI额度，每次购买加油包发放一笔，各自有到期时间，先到期的先使用
+type AICreditGrant struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UserID    uint      `gorm:"not null;index:idx_ai_credit_grants_user_expires,priority:1" json:"user_id"`    // 用户ID
+	PackID    uint      `gorm:"not null" json:"pack_id"`                                                       // 加油包ID
+	OrderID   *uint     `gorm:"uniqueIndex" json:"order_id,omitempty"`                                         // 来源订单ID，同一订单只发放一次
+	Credits   int       `gorm:"not null" json:"credits"`                                                       // 发放的次数
+	Remaining int       `gorm:"not null" json:"remaining"`                                                     // 剩余次数
+	ExpiresAt time.Time `gorm:"not null;index:idx_ai_credit_grants_user_expires,priority:2" json:"expires_at"` // 到期时间
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AICreditLedger AI额度流水，只追加不修改，记录每次发放和消耗
+type AICreditLedger struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UserID    uint      `gorm:"not null;index:idx_ai_credit_ledgers_user_created,priority:1" json:"user_id"` // 用户ID
+	GrantID   uint      `gorm:"not null;index" json:"grant_id"`                                              // 额度ID
+	Type      string    `gorm:"size:20;not null" json:"type"`                                                // 类型：grant, spend
+	Amount    int       `gorm:"not null" json:"amount"`                                                      // 变动次数，发放为正数，消耗为负数
+	Feature   string    `gorm:"size:20" json:"feature"`                                                      // 消耗的AI功能，发放时为空
+	OrderID   *uint     `json:"order_id,omitempty"`                                                          // 发放的来源订单ID
+	CreatedAt time.Time `gorm:"index:idx_ai_credit_ledgers_user_created,priority:2" json:"created_at"`
+}
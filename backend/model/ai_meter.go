@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+)
+
+// AI功能计量项
+const (
+	AIFeatureChat     = "chat"     // AI聊天
+	AIFeatureContent  = "content"  // AI内容生成
+	AIFeatureAnalysis = "analysis" // AI数据分析
+)
+
+// AIFeatures 所有AI功能计量项
+var AIFeatures = []string{AIFeatureChat, AIFeatureContent, AIFeatureAnalysis}
+
+// IsValidAIFeature 是否为有效的AI功能计量项
+func IsValidAIFeature(feature string) bool {
+	for _, f := range AIFeatures {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// AIMeter AI功能用量计量表，每个用户每个功能一行，记录当前周期的使用次数
+type AIMeter struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	UserID      uint       `gorm:"not null;uniqueIndex:idx_ai_meters_user_feature" json:"user_id"`         // 用户ID
+	Feature     string     `gorm:"size:20;not null;uniqueIndex:idx_ai_meters_user_feature" json:"feature"` // 功能：chat, content, analysis
+	Used        int        `gorm:"not null;default:0" json:"used"`                                         // 当前周期已使用次数
+	PeriodStart time.Time  `gorm:"not null" json:"period_start"`                                           // 当前周期开始时间
+	ResetAt     time.Time  `gorm:"not null" json:"reset_at"`                                               // 下次重置时间
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`                                                 // 最后使用时间
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (AIMeter) TableName() string {
+	return "ai_meters"
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// AI调用状态
+const (
+	AIUsageStatusSuccess   = "success"   // 调用成功
+	AIUsageStatusFailed    = "failed"    // 模型调用失败
+	AIUsageStatusCancelled = "cancelled" // 流式请求中途被客户端取消
+)
+
+// AIUsageLedger AI调用流水，只追加不修改，每次模型调用一行
+type AIUsageLedger struct {
+	ID               uint      `gorm:"primarykey" json:"id"`
+	UserID           uint      `gorm:"not null;index:idx_ai_usage_ledgers_user_created,priority:1" json:"user_id"` // 用户ID
+	Feature          string    `gorm:"size:20;not null" json:"feature"`                                            // 功能：chat, content, analysis
+	Model            string    `gorm:"size:100" json:"model"`                                                      // 实际使用的模型
+	ModelTier        string    `gorm:"size:20" json:"model_tier"`                                                  // 模型等级
+	PromptTokens     int       `gorm:"not null;default:0" json:"prompt_tokens"`                                    // 输入token数
+	CompletionTokens int       `gorm:"not null;default:0" json:"completion_tokens"`                                // 输出token数
+	TotalTokens      int       `gorm:"not null;default:0" json:"total_tokens"`                                     // token总数
+	LatencyMs        int64     `gorm:"not null;default:0" json:"latency_ms"`                                       // 调用耗时（毫秒）
+	Status           string    `gorm:"size:20;not null" json:"status"`                                             // 状态：success, failed, cancelled
+	PlanID           *uint     `json:"plan_id,omitempty"`                                                          // 调用时生效的套餐ID，免费用户为空
+	OrderID          *uint     `json:"order_id,omitempty"`                                                         // 调用时生效的VIP记录的来源订单ID
+	CreatedAt        time.Time `gorm:"index:idx_ai_usage_ledgers_user_created,priority:2" json:"created_at"`
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+)
+
+// 审计事件
+const (
+	AuditAccountLocked     = "account.locked"         // 登录失败次数过多，账号被临时锁定
+	AuditAccountUnlocked   = "account.unlocked"       // 管理员解除账号锁定
+	AuditPasswordReset     = "password.reset"         // 通过邮件重置密码
+	AuditTwoFactorEnabled  = "2fa.enabled"            // 启用两步验证
+	AuditTwoFactorDisabled = "2fa.disabled"           // 用户关闭两步验证
+	AuditTwoFactorReset    = "2fa.reset"              // 管理员重置两步验证
+	AuditRecoveryCodeUsed  = "2fa.recovery_code_used" // 使用恢复码登录
+	AuditIdentityLinked    = "identity.linked"        // 绑定第三方账号
+	AuditIdentityUnlinked  = "identity.unlinked"      // 解绑第三方账号
+	AuditUsersReconciled   = "users.reconciled"       // 管理员对账本地用户表与认证服务
+	AuditUserDeleted       = "user.deleted"           // 管理员删除用户，保留期内可恢复
+	AuditUserRestored      = "user.restored"          // 管理员恢复已删除的用户或撤销待清除的注销
+	AuditDeletionRequested = "deletion.requested"     // 用户申请注销账号
+	AuditDeletionCancelled = "deletion.cancelled"     // 用户撤销注销申请
+	AuditUserPurged        = "user.purged"            // 注销到期，用户数据已彻底清除
+	AuditDataExported      = "data.exported"          // 用户申请导出个人数据
+	AuditDataDownloaded    = "data.downloaded"        // 用户下载个人数据导出文件
+	AuditRoleChanged       = "role.changed"           // 管理员授予或撤销用户角色
+)
+
+// AuditLog 审计日志，记录安全相关事件，只追加不修改
+type AuditLog struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	Action    string    `gorm:"size:64;not null;index" json:"action"` // 事件，见Audit*常量
+	UserID    *uint     `gorm:"index" json:"user_id,omitempty"`       // 事件涉及的用户
+	ActorID   *uint     `gorm:"index" json:"actor_id,omitempty"`      // 操作人，系统触发时为空
+	IP        string    `gorm:"size:64" json:"ip"`                    // 来源IP
+	Detail    string    `gorm:"type:text" json:"detail"`              // 事件详情（JSON）
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+}
+
+// TableName 设置表名
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
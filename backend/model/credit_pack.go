@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreditPack AI额度加油包，一次性购买，每月额度用完后继续抵扣AI调用
+type CreditPack struct {
+	ID          uint           `gorm:"primarykey" json:"id"`
+	Name        string         `gorm:"size:50;not null" json:"name"` // 加油包名称
+	Description string         `gorm:"type:text" json:"description"` // 加油包介绍
+	Price       float64        `gorm:"not null" json:"price"`        // 价格
+	Credits     int            `gorm:"not null" json:"credits"`      // 包含的AI调用次数
+	ValidDays   int            `gorm:"not null" json:"valid_days"`   // 购买后的有效期（天数）
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// 个人数据导出状态
+const (
+	DataExportPending = "pending" // 等待生成或等待重试
+	DataExportRunning = "running" // 生成中
+	DataExportReady   = "ready"   // 已生成，可下载
+	DataExportFailed  = "failed"  // 超过重试次数仍生成失败
+	DataExportExpired = "expired" // 已过期，导出文件已删除
+)
+
+// DataExport 用户个人数据导出任务，由后台任务生成ZIP归档，过期后删除文件
+type DataExport struct {
+	ID            uint       `gorm:"primarykey" json:"id"`
+	UserID        uint       `gorm:"not null;index" json:"user_id"`                                                 // 用户ID
+	Status        string     `gorm:"size:16;not null;index:idx_data_exports_status_next,priority:1" json:"status"`  // 状态，见DataExport*常量
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`                                            // 已尝试生成的次数
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_data_exports_status_next,priority:2" json:"next_attempt_at"` // 下次生成时间，生成中时为租约到期时间
+	FileName      string     `gorm:"size:128" json:"-"`                                                             // 导出文件在存储目录中的文件名
+	Size          int64      `gorm:"not null;default:0" json:"size"`                                                // 导出文件大小（字节）
+	LastError     string     `gorm:"size:500" json:"last_error"`                                                    // 最近一次失败原因
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`                                                        // 生成完成时间
+	ExpiresAt     *time.Time `gorm:"index" json:"expires_at,omitempty"`                                             // 导出文件过期时间
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (DataExport) TableName() string {
+	return "data_exports"
+}
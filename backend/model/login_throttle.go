@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+)
+
+// 登录失败计数的维度
+const (
+	LoginThrottleAccount = "account" // 按账号计数，达到阈值后临时锁定账号
+	LoginThrottleIP      = "ip"      // 按来源IP计数，只做退避不锁定
+)
+
+// LoginThrottle 登录失败计数，每个账号、每个来源IP一行
+// 连续失败会按指数退避拒绝后续尝试，账号失败次数达到阈值后锁定到LockedUntil
+type LoginThrottle struct {
+	ID           uint       `gorm:"primarykey" json:"id"`
+	Scope        string     `gorm:"size:16;not null;uniqueIndex:idx_login_throttles_scope_identifier" json:"scope"`       // 维度：account, ip
+	Identifier   string     `gorm:"size:191;not null;uniqueIndex:idx_login_throttles_scope_identifier" json:"identifier"` // 账号（用户ID或登录名）或IP
+	UserID       *uint      `gorm:"index" json:"user_id,omitempty"`                                                       // 账号对应的用户ID，登录名不存在时为空
+	Failures     int        `gorm:"not null;default:0" json:"failures"`                                                   // 连续失败次数
+	LastFailedAt *time.Time `json:"last_failed_at,omitempty"`                                                             // 最后一次失败时间
+	LockedUntil  *time.Time `json:"locked_until,omitempty"`                                                               // 账号锁定截止时间
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (LoginThrottle) TableName() string {
+	return "login_throttles"
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+)
+
+// 跨服务操作的类型
+const (
+	OutboxAuthSyncVip    = "auth.sync_vip"    // 将本地VIP状态同步到认证服务
+	OutboxAuthDeleteUser = "auth.delete_user" // 在认证服务中删除已在本地删除的用户
+)
+
+// 跨服务操作的状态
+const (
+	OutboxStatusPending   = "pending"   // 待投递或等待重试
+	OutboxStatusDelivered = "delivered" // 已投递
+	OutboxStatusFailed    = "failed"    // 超过重试次数，等待对账修复
+)
+
+// OutboxEvent 待执行的跨服务操作，与本地数据变更在同一事务中写入，由后台任务投递并按退避重试
+// 只记录用户ID，投递时读取本地最新状态，重复或乱序投递的结果相同
+type OutboxEvent struct {
+	ID            uint       `gorm:"primarykey" json:"id"`
+	Kind          string     `gorm:"size:32;not null" json:"kind"`                                                   // 操作类型，见Outbox*常量
+	UserID        uint       `gorm:"not null;index" json:"user_id"`                                                  // 操作涉及的用户
+	Status        string     `gorm:"size:16;not null;index:idx_outbox_events_status_next,priority:1" json:"status"`  // 状态，见OutboxStatus*常量
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`                                             // 已尝试次数
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_outbox_events_status_next,priority:2" json:"next_attempt_at"` // 下次投递时间，投递中时为租约到期时间
+	LastError     string     `gorm:"size:500" json:"last_error"`                                                     // 最近一次失败原因
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`                                                         // 投递成功时间
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// RefreshToken 刷新令牌模型
+// 每次刷新都会签发新令牌并将旧令牌标记为已使用，同一次登录产生的令牌属于同一个家族（FamilyID），
+// 已使用或已吊销的令牌再次出现时视为泄露，吊销整个家族
+type RefreshToken struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`           // 用户ID
+	TokenHash string     `gorm:"size:64;uniqueIndex;not null" json:"-"`   // 令牌SHA-256摘要
+	FamilyID  string     `gorm:"size:64;index;not null" json:"family_id"` // 令牌家族ID
+	ParentID  *uint      `json:"parent_id,omitempty"`                     // 上一个令牌ID，首次登录时为空
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`              // 过期时间
+	UsedAt    *time.Time `json:"used_at,omitempty"`                       // 已用于刷新的时间
+	RevokedAt *time.Time `gorm:"index" json:"revoked_at,omitempty"`       // 吊销时间
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// RevokedToken 访问Token黑名单，登出后在Token过期前拒绝使用
+type RevokedToken struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	TokenID   string    `gorm:"size:64;uniqueIndex;not null" json:"token_id"` // Token的jti
+	UserID    uint      `gorm:"not null;index" json:"user_id"`                // 用户ID
+	ExpiresAt time.Time `gorm:"not null;index" json:"expires_at"`             // Token过期时间，过期后可清理
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,39 @@
+package model
+
+// 用户角色
+const (
+	RoleUser     = "user"     // 普通用户
+	RoleOperator = "operator" // 运营：查看用户、管理VIP套餐
+	RoleAdmin    = "admin"    // 管理员：全部权限
+)
+
+// 权限
+const (
+	PermUserRead   = "user:read"   // 查看任意用户的数据
+	PermUserWrite  = "user:write"  // 修改、删除任意用户，设置用户VIP
+	PermPlanWrite  = "plan:write"  // 管理VIP套餐
+	PermRoleManage = "role:manage" // 授予、撤销角色
+)
+
+// rolePermissions 角色拥有的权限
+var rolePermissions = map[string][]string{
+	RoleUser:     {},
+	RoleOperator: {PermUserRead, PermPlanWrite},
+	RoleAdmin:    {PermUserRead, PermUserWrite, PermPlanWrite, PermRoleManage},
+}
+
+// IsValidRole 检查角色是否有效
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// HasPermission 检查角色是否拥有指定权限
+func HasPermission(role, permission string) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
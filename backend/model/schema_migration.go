@@ -0,0 +1,11 @@
+package model
+
+import (
+	"time"
+)
+
+// SchemaMigration 已执行的数据迁移，每个迁移只执行一次
+type SchemaMigration struct {
+	ID        string    `gorm:"primarykey;size:100" json:"id"` // 迁移ID
+	AppliedAt time.Time `gorm:"not null" json:"applied_at"`    // 执行时间
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+)
+
+// UserTOTP 用户的TOTP两步验证配置，每个用户一行
+// 绑定时先生成密钥（EnabledAt为空），用户输入验证器应用中的验证码确认后启用
+type UserTOTP struct {
+	ID           uint       `gorm:"primarykey" json:"id"`
+	UserID       uint       `gorm:"not null;uniqueIndex" json:"user_id"` // 用户ID
+	Secret       string     `gorm:"size:255;not null" json:"-"`          // 加密后的TOTP密钥
+	EnabledAt    *time.Time `json:"enabled_at,omitempty"`                // 启用时间，为空表示尚未确认绑定
+	LastUsedStep int64      `gorm:"not null;default:0" json:"-"`         // 最后一次通过验证的时间步，防止验证码被重放
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (UserTOTP) TableName() string {
+	return "user_totps"
+}
+
+// RecoveryCode 两步验证恢复码，丢失验证器时代替验证码登录，每个只能使用一次
+type RecoveryCode struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`         // 用户ID
+	CodeHash  string     `gorm:"size:64;uniqueIndex;not null" json:"-"` // 恢复码SHA-256摘要
+	UsedAt    *time.Time `json:"used_at,omitempty"`                     // 使用时间
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TwoFactorChallenge 密码验证通过后等待两步验证的登录，凭挑战令牌提交验证码完成登录
+type TwoFactorChallenge struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`         // 用户ID
+	TokenHash string     `gorm:"size:64;uniqueIndex;not null" json:"-"` // 挑战令牌SHA-256摘要
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"`    // 验证码错误次数
+	ExpiresAt time.Time  `gorm:"not null;index" json:"expires_at"`      // 过期时间
+	UsedAt    *time.Time `json:"used_at,omitempty"`                     // 完成验证或错误次数过多作废的时间
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -13,11 +13,16 @@ type User struct {
 	Username            string         `gorm:"uniqueIndex;size:50;not null" json:"username"`
 	Password            string         `gorm:"size:100;not null" json:"-"`
 	Email               string         `gorm:"uniqueIndex;size:100;not null" json:"email"`
+	Role                string         `gorm:"size:20;not null;default:user" json:"role"`      // 角色：user, operator, admin
 	IsVip               bool           `gorm:"default:false" json:"is_vip"`
 	VipStartAt          *time.Time     `json:"vip_start_at,omitempty"`
 	VipEndAt            *time.Time     `json:"vip_end_at,omitempty"`
+	Avatar              string         `gorm:"size:255" json:"avatar"`                         // 头像地址
+	Timezone            string         `gorm:"size:64" json:"timezone"`                        // IANA时区，如Asia/Shanghai，为空时使用服务默认时区
+	EmailVerifiedAt     *time.Time     `json:"email_verified_at,omitempty"`                    // 邮箱验证时间，未验证时为空
 	
 	// AI使用次数
+	// Deprecated: AI用量已统一由AIMeter计量，这些字段只作为数据迁移的来源保留
 	AIChatCount         int            `gorm:"default:0" json:"ai_chat_count"`         // AI聊天次数
 	AIContentCount      int            `gorm:"default:0" json:"ai_content_count"`      // AI内容生成次数
 	AIAnalysisCount     int            `gorm:"default:0" json:"ai_analysis_count"`     // AI数据分析次数
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+)
+
+// 用户注销来源
+const (
+	UserDeletionSelf      = "self"      // 用户申请注销，冷静期内账号仍可使用，可随时撤销
+	UserDeletionAdmin     = "admin"     // 管理员删除，账号立即停用，保留期内可恢复
+	UserDeletionReconcile = "reconcile" // 对账发现认证服务中已没有该用户，本地账号停用
+)
+
+// 用户注销状态
+const (
+	UserDeletionPending   = "pending"   // 等待清除
+	UserDeletionCancelled = "cancelled" // 用户撤销或管理员恢复
+	UserDeletionPurged    = "purged"    // 已彻底清除
+)
+
+// UserDeletion 用户注销记录，到期后由后台任务彻底清除用户数据
+// 清除后保留该记录作为用户已注销的凭据，记录中不含个人信息
+type UserDeletion struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	UserID      uint       `gorm:"not null;index" json:"user_id"`                                                      // 用户ID
+	Source      string     `gorm:"size:16;not null" json:"source"`                                                     // 来源：self, admin, reconcile
+	Status      string     `gorm:"size:16;not null;index:idx_user_deletions_status_purge_at,priority:1" json:"status"` // 状态：pending, cancelled, purged
+	ActorID     *uint      `json:"actor_id,omitempty"`                                                                 // 操作人，用户本人申请时为空
+	PurgeAt     time.Time  `gorm:"not null;index:idx_user_deletions_status_purge_at,priority:2" json:"purge_at"`       // 计划清除时间
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`                                                             // 撤销或恢复时间
+	PurgedAt    *time.Time `json:"purged_at,omitempty"`                                                                // 清除时间
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (UserDeletion) TableName() string {
+	return "user_deletions"
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+)
+
+// UserIdentity 用户绑定的第三方账号，每个提供方的每个账号只能绑定一个用户，每个用户在同一提供方只能绑定一个账号
+type UserIdentity struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	UserID      uint       `gorm:"not null;uniqueIndex:idx_user_identities_user_provider" json:"user_id"`                                                           // 用户ID
+	Provider    string     `gorm:"size:64;not null;uniqueIndex:idx_user_identities_provider_subject;uniqueIndex:idx_user_identities_user_provider" json:"provider"` // 提供方标识
+	Subject     string     `gorm:"size:191;not null;uniqueIndex:idx_user_identities_provider_subject" json:"subject"`                                               // 用户在提供方的唯一ID
+	Email       string     `gorm:"size:255" json:"email"`                                                                                                           // 绑定时提供方返回的邮箱
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`                                                                                                         // 最后一次通过该账号登录的时间
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName 设置表名
+func (UserIdentity) TableName() string {
+	return "user_identities"
+}
+
+// OAuthState 跳转到第三方授权页时保存的授权状态，回调时核销，只能使用一次
+type OAuthState struct {
+	ID           uint       `gorm:"primarykey" json:"id"`
+	StateHash    string     `gorm:"size:64;uniqueIndex;not null" json:"-"` // state参数SHA-256摘要
+	Provider     string     `gorm:"size:64;not null" json:"provider"`      // 提供方标识
+	Nonce        string     `gorm:"size:64;not null" json:"-"`             // 写入ID Token的nonce
+	CodeVerifier string     `gorm:"size:128;not null" json:"-"`            // PKCE校验码
+	LinkUserID   *uint      `json:"link_user_id,omitempty"`                // 已登录用户绑定第三方账号时为该用户ID，第三方登录时为空
+	ExpiresAt    time.Time  `gorm:"not null;index" json:"expires_at"`      // 过期时间
+	UsedAt       *time.Time `json:"used_at,omitempty"`                     // 核销时间
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TableName 设置表名
+func (OAuthState) TableName() string {
+	return "oauth_states"
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+// 用户令牌用途
+const (
+	UserTokenVerifyEmail   = "verify_email"   // 验证邮箱
+	UserTokenPasswordReset = "password_reset" // 重置密码
+)
+
+// UserToken 通过邮件发送的一次性令牌，数据库中只保存摘要
+// 同一用户同一用途只有最新签发的令牌有效
+type UserToken struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index:idx_user_tokens_user_purpose" json:"user_id"`         // 用户ID
+	Purpose   string     `gorm:"size:32;not null;index:idx_user_tokens_user_purpose" json:"purpose"` // 用途：verify_email, password_reset
+	TokenHash string     `gorm:"size:64;uniqueIndex;not null" json:"-"`                              // 令牌SHA-256摘要
+	Email     string     `gorm:"size:100;not null" json:"email"`                                     // 签发时的邮箱，验证邮箱时必须与用户当前邮箱一致
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`                                         // 过期时间
+	UsedAt    *time.Time `json:"used_at,omitempty"`                                                  // 使用时间，被新令牌取代时也会设置
+	CreatedAt time.Time  `json:"created_at"`
+}
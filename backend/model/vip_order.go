@@ -6,20 +6,50 @@ import (
 	"gorm.io/gorm"
 )
 
-// VipOrder VIP订单模型
+// VIP订单状态，状态流转见 rpc/internal/orderstate
+const (
+	VipOrderStatusPending   = "pending"   // 待支付
+	VipOrderStatusPaid      = "paid"      // 已支付
+	VipOrderStatusCancelled = "cancelled" // 已取消
+	VipOrderStatusExpired   = "expired"   // 超时未支付
+)
+
+// 订单商品类型
+const (
+	OrderProductVipPlan    = "vip_plan"    // VIP套餐
+	OrderProductCreditPack = "credit_pack" // AI额度加油包
+)
+
+// VipOrder VIP订单模型，同时用于购买VIP套餐和AI额度加油包
 type VipOrder struct {
 	ID         uint           `gorm:"primarykey" json:"id"`
-	UserID     uint           `gorm:"not null;index" json:"user_id"`        // 用户ID
-	PlanID     uint           `gorm:"not null;index" json:"plan_id"`        // 套餐ID
+	UserID     *uint          `gorm:"index;uniqueIndex:idx_vip_orders_user_idempotency_key,priority:1" json:"user_id"` // 用户ID，用户被彻底清除后为空
+	ProductType string        `gorm:"size:20;not null;default:vip_plan" json:"product_type"` // 商品类型：vip_plan, credit_pack
+	PlanID     *uint          `gorm:"index" json:"plan_id,omitempty"`       // 套餐ID，购买VIP套餐时有值
+	CreditPackID *uint        `gorm:"index" json:"credit_pack_id,omitempty"` // 加油包ID，购买加油包时有值
 	OrderNo    string         `gorm:"size:50;uniqueIndex;not null" json:"order_no"` // 订单号
 	Amount     float64        `gorm:"not null" json:"amount"`               // 订单金额
-	Status     string         `gorm:"size:20;not null" json:"status"`        // 订单状态：pending, paid, cancelled
+	Status     string         `gorm:"size:20;not null;index" json:"status"`  // 订单状态：pending, paid, cancelled, expired
+	IdempotencyKey *string    `gorm:"size:64;uniqueIndex:idx_vip_orders_user_idempotency_key,priority:2" json:"-"` // 客户端幂等键，同一用户内唯一
+	Version    int            `gorm:"not null;default:0" json:"-"`          // 乐观锁版本号，每次状态变更加1
 	PayMethod  string         `gorm:"size:20" json:"pay_method"`            // 支付方式：wechat, alipay, etc.
+	PayProvider string        `gorm:"size:20" json:"pay_provider"`          // 支付提供方
+	TradeNo    string         `gorm:"size:64;index" json:"trade_no"`        // 支付平台交易号
+	PaidAt     *time.Time     `json:"paid_at,omitempty"`                    // 支付时间
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
 	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
 
 	// 关联关系
-	User       User       `gorm:"foreignKey:UserID" json:"-"`               // 用户关联
+	User       User       `gorm:"foreignKey:UserID;constraint:OnDelete:SET NULL" json:"-"` // 用户关联，订单作为财务记录在用户删除后保留
 	Plan       VipPlan    `gorm:"foreignKey:PlanID" json:"plan"`            // 套餐关联
+	CreditPack CreditPack `gorm:"foreignKey:CreditPackID" json:"credit_pack"` // 加油包关联
+}
+
+// ProductName 订单商品名称，需预加载Plan和CreditPack
+func (o *VipOrder) ProductName() string {
+	if o.ProductType == OrderProductCreditPack {
+		return o.CreditPack.Name
+	}
+	return o.Plan.Name
 }
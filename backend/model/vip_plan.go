@@ -1,19 +1,139 @@
 package model
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// 模型等级，由低到高
+const (
+	ModelTierBasic    = "basic"    // 基础模型
+	ModelTierAdvanced = "advanced" // 高级模型
+	ModelTierPremium  = "premium"  // 旗舰模型
+)
+
+// ModelTiers 所有模型等级，由低到高
+var ModelTiers = []string{ModelTierBasic, ModelTierAdvanced, ModelTierPremium}
+
+// IsValidModelTier 是否为有效的模型等级
+func IsValidModelTier(tier string) bool {
+	for _, t := range ModelTiers {
+		if t == tier {
+			return true
+		}
+	}
+	return false
+}
+
+// 额度重置策略
+const (
+	ResetPolicyCalendarMonth = "calendar_month" // 按用户时区的自然月重置
+	ResetPolicyBillingAnchor = "billing_anchor" // 从VIP生效时间起每满一个月重置
+	ResetPolicyFixed30d      = "fixed_30d"      // 上个周期结束后首次使用时开始，固定30天为一个周期
+)
+
+// ResetPolicies 所有额度重置策略
+var ResetPolicies = []string{ResetPolicyCalendarMonth, ResetPolicyBillingAnchor, ResetPolicyFixed30d}
+
+// IsValidResetPolicy 是否为有效的额度重置策略
+func IsValidResetPolicy(policy string) bool {
+	for _, p := range ResetPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanFeatures 套餐的AI权益
+type PlanFeatures struct {
+	Quotas      map[string]int `json:"quotas"`       // 每个周期各AI功能的使用次数，key为chat, content, analysis
+	ModelTiers  []string       `json:"model_tiers"`  // 可使用的模型等级
+	MaxTokens   int            `json:"max_tokens"`   // 单次请求的最大输出token数
+	TokenBudget int            `json:"token_budget"` // 每个周期的token预算，0表示不限制
+	ResetPolicy string         `json:"reset_policy"` // 额度重置策略，为空时按自然月重置
+}
+
+// Validate 校验权益配置，每个AI功能都必须配置额度
+func (f *PlanFeatures) Validate() error {
+	for _, feature := range AIFeatures {
+		quota, ok := f.Quotas[feature]
+		if !ok {
+			return fmt.Errorf("缺少%s的使用额度", feature)
+		}
+		if quota < 0 {
+			return fmt.Errorf("%s的使用额度不能为负数", feature)
+		}
+	}
+	for feature := range f.Quotas {
+		if !IsValidAIFeature(feature) {
+			return fmt.Errorf("无效的AI功能: %s", feature)
+		}
+	}
+
+	if len(f.ModelTiers) == 0 {
+		return errors.New("至少需要一个模型等级")
+	}
+	for _, tier := range f.ModelTiers {
+		if !IsValidModelTier(tier) {
+			return fmt.Errorf("无效的模型等级: %s", tier)
+		}
+	}
+
+	if f.MaxTokens <= 0 {
+		return errors.New("最大token数必须大于0")
+	}
+	if f.TokenBudget < 0 {
+		return errors.New("token预算不能为负数")
+	}
+	if f.ResetPolicy != "" && !IsValidResetPolicy(f.ResetPolicy) {
+		return fmt.Errorf("无效的额度重置策略: %s", f.ResetPolicy)
+	}
+
+	return nil
+}
+
+// EffectiveResetPolicy 生效的额度重置策略，未配置时为自然月
+func (f *PlanFeatures) EffectiveResetPolicy() string {
+	if f.ResetPolicy == "" {
+		return ResetPolicyCalendarMonth
+	}
+	return f.ResetPolicy
+}
+
+// AllowsModelTier 是否可以使用指定的模型等级
+func (f *PlanFeatures) AllowsModelTier(tier string) bool {
+	for _, t := range f.ModelTiers {
+		if t == tier {
+			return true
+		}
+	}
+	return false
+}
+
+// HighestModelTier 可使用的最高模型等级
+func (f *PlanFeatures) HighestModelTier() string {
+	highest := ""
+	for _, t := range ModelTiers {
+		if f.AllowsModelTier(t) {
+			highest = t
+		}
+	}
+	return highest
+}
+
 // VipPlan VIP套餐模型
 type VipPlan struct {
-	ID        uint           `gorm:"primarykey" json:"id"`
-	Name      string         `gorm:"size:50;not null" json:"name"`      // 套餐名称
-	Price     float64        `gorm:"not null" json:"price"`            // 价格
-	Duration  int            `gorm:"not null" json:"duration"`         // 有效期（天数）
-	Features  string         `gorm:"type:text" json:"features"`        // 套餐特色，JSON格式
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID          uint           `gorm:"primarykey" json:"id"`
+	Name        string         `gorm:"size:50;not null" json:"name"`              // 套餐名称
+	Description string         `gorm:"type:text" json:"description"`              // 套餐介绍
+	Price       float64        `gorm:"not null" json:"price"`                     // 价格
+	Duration    int            `gorm:"not null" json:"duration"`                  // 有效期（天数）
+	Features    PlanFeatures   `gorm:"type:text;serializer:json" json:"features"` // 套餐AI权益
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 }
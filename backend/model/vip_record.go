@@ -11,7 +11,8 @@ type VipRecord struct {
 	ID        uint           `gorm:"primarykey" json:"id"`
 	UserID    uint           `gorm:"not null;index" json:"user_id"`        // 用户ID
 	PlanID    uint           `gorm:"not null;index" json:"plan_id"`        // 套餐ID
-	IsActive  bool           `gorm:"default:false" json:"is_active"`       // 是否激活
+	OrderID   *uint          `gorm:"uniqueIndex" json:"order_id,omitempty"` // 来源订单ID，管理员开通时为空
+	IsActive  bool           `gorm:"default:false" json:"is_active"`       // 是否有效，取消VIP时置为false；叠加购买时未开始的记录也为true
 	StartAt   time.Time      `json:"start_at"`                              // 开始时间
 	EndAt     time.Time      `json:"end_at"`                                // 结束时间
 	CreatedAt time.Time      `json:"created_at"`
@@ -0,0 +1,69 @@
+package aiprovider
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	defaultEchoModel = "echo"
+	echoChunkSize    = 4 // 流式输出时每个分片的字符数
+)
+
+// EchoProvider 本地回显提供方，原样返回最后一条用户消息，用于开发和测试
+type EchoProvider struct {
+	model string
+}
+
+// NewEchoProvider 创建回显提供方
+func NewEchoProvider(model string) *EchoProvider {
+	if model == "" {
+		model = defaultEchoModel
+	}
+	return &EchoProvider{model: model}
+}
+
+// Complete 返回最后一条用户消息
+func (p *EchoProvider) Complete(ctx context.Context, req *Request) (*Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	content := lastUserMessage(req.Messages)
+	return &Response{
+		Content: content,
+		Model:   p.model,
+		Usage:   EstimateUsage(req, content),
+	}, nil
+}
+
+// Stream 将最后一条用户消息按固定长度分片回调
+func (p *EchoProvider) Stream(ctx context.Context, req *Request, onDelta DeltaFunc) (*Response, error) {
+	content := []rune(lastUserMessage(req.Messages))
+	for start := 0; start < len(content); start += echoChunkSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		end := min(start+echoChunkSize, len(content))
+		if err := onDelta(string(content[start:end])); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Response{
+		Content: string(content),
+		Model:   p.model,
+		Usage:   EstimateUsage(req, string(content)),
+	}, nil
+}
+
+// lastUserMessage 获取最后一条用户消息内容
+func lastUserMessage(messages []Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == "user" {
+			return strings.TrimSpace(messages[i].Content)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,229 @@
+package aiprovider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OpenAIProvider OpenAI兼容接口提供方（/chat/completions）
+type OpenAIProvider struct {
+	baseURL string
+	apiKey  string
+	model   string
+	timeout time.Duration
+	client  *http.Client
+}
+
+// NewOpenAIProvider 创建OpenAI兼容提供方
+func NewOpenAIProvider(baseURL, apiKey, model string, timeout time.Duration) *OpenAIProvider {
+	return &OpenAIProvider{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		model:   model,
+		timeout: timeout,
+		// 流式响应持续时间不确定，超时由每次请求的context控制
+		client: &http.Client{},
+	}
+}
+
+type chatCompletionReq struct {
+	Model         string         `json:"model"`
+	Messages      []Message      `json:"messages"`
+	MaxTokens     int            `json:"max_tokens,omitempty"`
+	Stream        bool           `json:"stream,omitempty"`
+	StreamOptions *streamOptions `json:"stream_options,omitempty"`
+}
+
+// streamOptions 流式请求选项，include_usage使最后一个分片返回token用量
+type streamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type chatCompletionResp struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message Message `json:"message"`
+		Delta   Message `json:"delta"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage,omitempty"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Complete 调用 /chat/completions 接口
+func (p *OpenAIProvider) Complete(ctx context.Context, req *Request) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	model := p.modelOf(req)
+	httpResp, err := p.post(ctx, model, req, false)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取模型响应失败: %w", err)
+	}
+
+	var result chatCompletionResp
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("解析模型响应失败(status=%d): %w", httpResp.StatusCode, err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, statusError(httpResp.StatusCode, &result)
+	}
+	if len(result.Choices) == 0 {
+		return nil, fmt.Errorf("模型响应为空")
+	}
+
+	if result.Model != "" {
+		model = result.Model
+	}
+
+	resp := &Response{
+		Content: result.Choices[0].Message.Content,
+		Model:   model,
+	}
+	if result.Usage != nil {
+		resp.Usage = TokenUsage{
+			PromptTokens:     result.Usage.PromptTokens,
+			CompletionTokens: result.Usage.CompletionTokens,
+		}
+	}
+	return resp, nil
+}
+
+// Stream 以 stream=true 调用 /chat/completions 接口，解析SSE增量
+func (p *OpenAIProvider) Stream(ctx context.Context, req *Request, onDelta DeltaFunc) (*Response, error) {
+	model := p.modelOf(req)
+	httpResp, err := p.post(ctx, model, req, true)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		var result chatCompletionResp
+		body, _ := io.ReadAll(httpResp.Body)
+		_ = json.Unmarshal(body, &result)
+		return nil, statusError(httpResp.StatusCode, &result)
+	}
+
+	var (
+		content strings.Builder
+		usage   TokenUsage
+	)
+	scanner := bufio.NewScanner(httpResp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk chatCompletionResp
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, fmt.Errorf("解析模型流式响应失败: %w", err)
+		}
+		if chunk.Error != nil {
+			return nil, fmt.Errorf("模型接口返回错误: %s", chunk.Error.Message)
+		}
+		if chunk.Model != "" {
+			model = chunk.Model
+		}
+		if chunk.Usage != nil {
+			usage = TokenUsage{
+				PromptTokens:     chunk.Usage.PromptTokens,
+				CompletionTokens: chunk.Usage.CompletionTokens,
+			}
+		}
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+
+		delta := chunk.Choices[0].Delta.Content
+		content.WriteString(delta)
+		if err := onDelta(delta); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("读取模型流式响应失败: %w", err)
+	}
+
+	return &Response{
+		Content: content.String(),
+		Model:   model,
+		Usage:   usage,
+	}, nil
+}
+
+// modelOf 获取本次请求使用的模型
+func (p *OpenAIProvider) modelOf(req *Request) string {
+	if req.Model != "" {
+		return req.Model
+	}
+	return p.model
+}
+
+// post 发送 /chat/completions 请求
+func (p *OpenAIProvider) post(ctx context.Context, model string, req *Request, stream bool) (*http.Response, error) {
+	payload := chatCompletionReq{
+		Model:     model,
+		Messages:  req.Messages,
+		MaxTokens: req.MaxTokens,
+		Stream:    stream,
+	}
+	if stream {
+		payload.StreamOptions = &streamOptions{IncludeUsage: true}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if stream {
+		httpReq.Header.Set("Accept", "text/event-stream")
+	}
+	if p.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("请求模型接口失败: %w", err)
+	}
+
+	return httpResp, nil
+}
+
+// statusError 构造非200响应的错误信息
+func statusError(statusCode int, result *chatCompletionResp) error {
+	if result.Error != nil {
+		return fmt.Errorf("模型接口返回错误(status=%d): %s", statusCode, result.Error.Message)
+	}
+	return fmt.Errorf("模型接口返回错误(status=%d)", statusCode)
+}
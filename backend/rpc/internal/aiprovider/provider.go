@@ -0,0 +1,112 @@
+package aiprovider
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// 支持的模型提供方类型
+const (
+	TypeEcho   = "echo"
+	TypeOpenAI = "openai"
+)
+
+// Config AI模型提供方配置
+type Config struct {
+	Type    string            `json:",default=echo,options=echo|openai"` // 提供方类型
+	BaseURL string            `json:",optional"`                         // OpenAI兼容接口地址，如 https://api.openai.com/v1
+	APIKey  string            `json:",optional"`                         // 接口密钥
+	Model   string            `json:",optional"`                         // 默认模型名称
+	Models  map[string]string `json:",optional"`                         // 各模型等级使用的模型名称，未配置的等级使用Model
+	Timeout int64             `json:",default=60000"`                    // 请求超时时间（毫秒）
+}
+
+// Message 对话消息
+type Message struct {
+	Role    string `json:"role"` // system, user, assistant
+	Content string `json:"content"`
+}
+
+// Request 模型请求
+type Request struct {
+	Model     string    // 为空时使用提供方默认模型
+	Messages  []Message // 对话消息
+	MaxTokens int       // 最大输出token数，0表示不限制
+}
+
+// TokenUsage 模型调用消耗的token数
+type TokenUsage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Total token总数
+func (u TokenUsage) Total() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// Response 模型响应
+type Response struct {
+	Content string     // 模型回复内容
+	Model   string     // 实际使用的模型
+	Usage   TokenUsage // 提供方返回的token用量，未返回时为零值
+}
+
+// DeltaFunc 流式增量回调，在调用Stream的goroutine中按顺序调用，返回错误时终止流式输出
+type DeltaFunc func(delta string) error
+
+// Provider AI模型提供方接口
+type Provider interface {
+	// Complete 发送对话请求并返回完整回复
+	Complete(ctx context.Context, req *Request) (*Response, error)
+	// Stream 发送对话请求，逐段回调增量内容，结束后返回完整回复
+	Stream(ctx context.Context, req *Request, onDelta DeltaFunc) (*Response, error)
+}
+
+// EstimateTokens 估算文本的token数，用于提供方未返回用量或流式请求中途断开的情况
+// 按经验值估算：ASCII字符约4个一个token，其他字符（如中文）每个字符一个token
+func EstimateTokens(text string) int {
+	ascii, other := 0, 0
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return (ascii+3)/4 + other
+}
+
+// EstimateUsage 估算一次请求的token用量
+func EstimateUsage(req *Request, completion string) TokenUsage {
+	prompt := 0
+	for _, m := range req.Messages {
+		prompt += EstimateTokens(m.Content)
+	}
+	return TokenUsage{
+		PromptTokens:     prompt,
+		CompletionTokens: EstimateTokens(completion),
+	}
+}
+
+// ModelFor 模型等级对应的模型名称，未配置时返回空字符串，即使用提供方默认模型
+func (c Config) ModelFor(tier string) string {
+	return c.Models[tier]
+}
+
+// New 根据配置创建模型提供方
+func New(c Config) (Provider, error) {
+	switch c.Type {
+	case "", TypeEcho:
+		return NewEchoProvider(c.Model), nil
+	case TypeOpenAI:
+		if c.BaseURL == "" {
+			return nil, fmt.Errorf("openai提供方缺少BaseURL配置")
+		}
+		return NewOpenAIProvider(c.BaseURL, c.APIKey, c.Model, time.Duration(c.Timeout)*time.Millisecond), nil
+	default:
+		return nil, fmt.Errorf("不支持的AI提供方类型: %s", c.Type)
+	}
+}
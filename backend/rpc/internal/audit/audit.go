@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"backend/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// Event 一条待记录的审计事件
+type Event struct {
+	Action  string         // 事件，见model.Audit*常量
+	UserID  uint           // 事件涉及的用户，0表示无
+	ActorID uint           // 操作人，0表示系统触发
+	IP      string         // 来源IP
+	Detail  map[string]any // 事件详情
+}
+
+// Record 写入一条审计日志；审计失败只打日志，不影响业务结果
+// 请求的context取消后日志仍会写入
+func Record(ctx context.Context, db *gorm.DB, event Event) {
+	entry := model.AuditLog{
+		Action: event.Action,
+		IP:     event.IP,
+	}
+	if event.UserID != 0 {
+		entry.UserID = &event.UserID
+	}
+	if event.ActorID != 0 {
+		entry.ActorID = &event.ActorID
+	}
+	if len(event.Detail) > 0 {
+		detail, err := json.Marshal(event.Detail)
+		if err != nil {
+			logx.WithContext(ctx).Error("序列化审计详情失败: ", err)
+		} else {
+			entry.Detail = string(detail)
+		}
+	}
+
+	if err := db.WithContext(context.WithoutCancel(ctx)).Create(&entry).Error; err != nil {
+		logx.WithContext(ctx).Errorf("记录审计日志失败: action=%s err=%v", event.Action, err)
+	}
+}
@@ -1,9 +1,113 @@
 package config
 
-import "github.com/zeromicro/go-zero/zrpc"
+import (
+	"slices"
+	"time"
+
+	"backend/rpc/internal/aiprovider"
+	"backend/rpc/internal/dataexport"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/mailer"
+	"backend/rpc/internal/metering"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/outbox"
+	"backend/rpc/internal/payment"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/internal/userdeletion"
+	"backend/rpc/internal/usertoken"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// 认证后端
+const (
+	AuthBackendRPC   = "rpc"   // 调用外部认证服务，需要配置AuthRpc
+	AuthBackendLocal = "local" // 在进程内使用本地用户表，无需部署认证服务
+)
 
 type Config struct {
 	zrpc.RpcServerConf
+	// 认证后端：rpc 调用外部认证服务；local 使用本地用户表，用于开发、测试和单机部署
+	AuthBackend string `json:",default=rpc,options=rpc|local"`
 	// 外部服务配置
 	AuthRpc zrpc.RpcClientConf `json:",optional"` // 外部认证服务
+	// 外部认证服务是否实现了ResetUserPassword，未实现时不提供通过邮件重置密码；本地认证始终支持
+	AuthPasswordReset bool `json:",default=false"`
+	// 同步认证服务的操作的投递和重试
+	Outbox outbox.Config
+	// 账号注销：冷静期、删除用户的保留期和到期清除
+	UserDeletion userdeletion.Config
+	// 个人数据导出：导出文件的存放、保留时长和下载链接签名
+	DataExport dataexport.Config
+	// JWT签发配置
+	JWT utils.JWTConfig
+	// 登录防暴力破解：失败退避和账号锁定
+	LoginGuard loginguard.Config `json:",optional"`
+	// 两步验证配置
+	TwoFactor twofactor.Config
+	// 第三方登录（OIDC）配置
+	OIDC oidc.Config `json:",optional"`
+	// 邮件发送配置
+	Mail mailer.Config `json:",optional"`
+	// 邮箱验证和重置密码令牌配置
+	UserToken usertoken.Config
+	// 前端地址，用于拼接邮件中的链接
+	WebBaseURL string `json:",default=http://localhost:5173"`
+	// 启动时授予管理员角色的用户ID，用于初始化第一个管理员
+	BootstrapAdminUserIds []string `json:",optional"`
+	// AI模型配置
+	AI aiprovider.Config `json:",optional"`
+	// 未开通VIP用户的AI权益
+	FreeTier metering.FreeTierConfig
+	// 用户未设置时区时使用的默认时区（IANA名称），用于计算AI额度的重置时间
+	DefaultTimezone string `json:",default=Asia/Shanghai"`
+	// 支付配置
+	Payment payment.Config `json:",optional"`
+	// 订单配置
+	Order struct {
+		NodeID        int64 `json:",default=0,env=ORDER_NODE_ID"` // 订单号生成器节点ID（0~1023），多实例部署时必须互不相同
+		PendingTTL    int64 `json:",default=1800"`                // 待支付订单有效期（秒），超时后自动过期
+		SweepInterval int64 `json:",default=60"`                  // 过期订单扫描间隔（秒）
+	} `json:",optional"`
+}
+
+// PasswordResetEnabled 是否支持通过邮件重置密码，需要认证后端能在不校验旧密码的情况下设置新密码
+func (c Config) PasswordResetEnabled() bool {
+	return c.AuthBackend == AuthBackendLocal || c.AuthPasswordReset
+}
+
+// aiRequestMargin AI同步请求未单独配置超时时，在模型调用超时之外预留的时长，用于扣减次数和记录流水
+const aiRequestMargin = 5 * time.Second
+
+// AIRequestTimeout AI同步请求的RPC超时，0表示不限制
+// 未在MethodTimeouts中单独配置时为模型调用超时加上预留时长，服务默认超时为0（不限制）时仍不限制
+func (c Config) AIRequestTimeout() time.Duration {
+	for _, mt := range c.MethodTimeouts {
+		if mt.FullMethod == super.Super_AIRequest_FullMethodName {
+			return mt.Timeout
+		}
+	}
+	if c.Timeout == 0 {
+		return 0
+	}
+	return time.Duration(c.AI.Timeout)*time.Millisecond + aiRequestMargin
+}
+
+// WithAIRequestTimeout 未在MethodTimeouts中配置AI同步请求的超时时补充默认值，在创建RPC服务前调用
+// 否则AI同步请求使用服务默认超时（通常远小于模型调用超时），模型已计费，用户却收到超时错误
+func (c Config) WithAIRequestTimeout() Config {
+	for _, mt := range c.MethodTimeouts {
+		if mt.FullMethod == super.Super_AIRequest_FullMethodName {
+			return c
+		}
+	}
+	if timeout := c.AIRequestTimeout(); timeout > 0 {
+		c.MethodTimeouts = append(slices.Clone(c.MethodTimeouts), zrpc.MethodTimeoutConf{
+			FullMethod: super.Super_AIRequest_FullMethodName,
+			Timeout:    timeout,
+		})
+	}
+	return c
 }
@@ -0,0 +1,134 @@
+package credits
+
+import (
+	"errors"
+	"time"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrInvalidPack 加油包配置无效
+	ErrInvalidPack = errors.New("加油包的次数和有效期必须大于0")
+	// ErrInsufficient 没有可用的额度
+	ErrInsufficient = errors.New("AI额度不足")
+)
+
+// Grant 按加油包为用户发放一笔额度并记录流水，需在事务中调用
+// 额度从now起计算有效期；orderID为0表示非订单发放
+func Grant(tx *gorm.DB, userID uint, pack *model.CreditPack, orderID uint, now time.Time) (*model.AICreditGrant, error) {
+	if pack.Credits <= 0 || pack.ValidDays <= 0 {
+		return nil, ErrInvalidPack
+	}
+
+	grant := model.AICreditGrant{
+		UserID:    userID,
+		PackID:    pack.ID,
+		Credits:   pack.Credits,
+		Remaining: pack.Credits,
+		ExpiresAt: now.AddDate(0, 0, pack.ValidDays),
+	}
+	if orderID != 0 {
+		grant.OrderID = &orderID
+	}
+	if err := tx.Create(&grant).Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(&model.AICreditLedger{
+		UserID:  userID,
+		GrantID: grant.ID,
+		Type:    model.AICreditEntryGrant,
+		Amount:  pack.Credits,
+		OrderID: grant.OrderID,
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return &grant, nil
+}
+
+// Balance 用户当前可用的额度，已到期的额度不计入
+func Balance(db *gorm.DB, userID uint, now time.Time) (int64, error) {
+	var balance int64
+	err := db.Model(&model.AICreditGrant{}).
+		Where("user_id = ? AND remaining > 0 AND expires_at > ?", userID, now).
+		Select("COALESCE(SUM(remaining), 0)").
+		Scan(&balance).Error
+	return balance, err
+}
+
+// Active 用户未到期且有剩余的额度，按到期时间排序
+func Active(db *gorm.DB, userID uint, now time.Time) ([]model.AICreditGrant, error) {
+	var grants []model.AICreditGrant
+	err := db.Where("user_id = ? AND remaining > 0 AND expires_at > ?", userID, now).
+		Order("expires_at, id").
+		Find(&grants).Error
+	return grants, err
+}
+
+// Spend 为一次AI调用消耗一次额度并记录流水，先到期的额度先使用，没有可用额度时返回ErrInsufficient
+// 扣减是一条带剩余次数条件的UPDATE，并发消耗不会使额度变为负数
+func Spend(db *gorm.DB, userID uint, feature string, now time.Time) (*model.AICreditGrant, error) {
+	// 条件扣减失败说明这笔额度已被并发请求用完，之后不会再被查到，循环一定会结束
+	for {
+		var grant model.AICreditGrant
+		err := db.Where("user_id = ? AND remaining > 0 AND expires_at > ?", userID, now).
+			Order("expires_at, id").
+			First(&grant).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInsufficient
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		spent := false
+		err = db.Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&model.AICreditGrant{}).
+				Where("id = ? AND remaining > 0", grant.ID).
+				Update("remaining", gorm.Expr("remaining - 1"))
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+			spent = true
+
+			return tx.Create(&model.AICreditLedger{
+				UserID:  userID,
+				GrantID: grant.ID,
+				Type:    model.AICreditEntrySpend,
+				Amount:  -1,
+				Feature: feature,
+			}).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+		if spent {
+			grant.Remaining--
+			return &grant, nil
+		}
+	}
+}
+
+// Ledger 分页查询用户的额度流水，按时间倒序
+func Ledger(db *gorm.DB, userID uint, page, pageSize int) ([]model.AICreditLedger, int64, error) {
+	query := db.Model(&model.AICreditLedger{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []model.AICreditLedger
+	if err := query.Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,199 @@
+package dataexport
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"backend/model"
+	"backend/utils"
+
+	"gorm.io/gorm"
+)
+
+// manifest 导出文件的说明，写在export.json中
+type manifest struct {
+	UserID      uint              `json:"user_id"`
+	GeneratedAt string            `json:"generated_at"`
+	Files       map[string]string `json:"files"`
+}
+
+// files 导出文件中的数据文件及其说明
+var files = map[string]string{
+	"profile.json":          "认证服务中的用户资料",
+	"account.json":          "账号信息：角色、时区、邮箱验证时间等",
+	"identities.json":       "绑定的第三方账号",
+	"vip_orders.json":       "VIP套餐和AI额度加油包订单",
+	"vip_records.json":      "VIP开通记录",
+	"ai_meters.json":        "AI用量计量：各功能在每个周期内的使用次数",
+	"ai_usages.json":        "旧版AI使用次数记录",
+	"ai_usage_ledger.json":  "AI调用流水",
+	"ai_credit_grants.json": "AI额度加油包的额度",
+	"ai_credit_ledger.json": "AI额度加油包的额度变动流水",
+}
+
+// build 生成用户的导出文件，返回文件名和大小
+// 先写入临时文件，完整写入后再重命名，失败时删除临时文件
+func (s *Service) build(ctx context.Context, export *model.DataExport) (string, int64, error) {
+	if err := os.MkdirAll(s.c.Dir, 0o700); err != nil {
+		return "", 0, err
+	}
+	token, err := utils.GenerateRandomToken(16)
+	if err != nil {
+		return "", 0, err
+	}
+	fileName := fmt.Sprintf("%d-%s.zip", export.ID, token)
+	tmpPath := s.path(fileName) + ".tmp"
+
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", 0, err
+	}
+	if err := s.writeArchive(ctx, file, export.UserID); err != nil {
+		file.Close()
+		os.Remove(tmpPath)
+		return "", 0, err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(tmpPath)
+		return "", 0, err
+	}
+	if err := os.Rename(tmpPath, s.path(fileName)); err != nil {
+		os.Remove(tmpPath)
+		return "", 0, err
+	}
+
+	info, err := os.Stat(s.path(fileName))
+	if err != nil {
+		return "", 0, err
+	}
+	return fileName, info.Size(), nil
+}
+
+// writeArchive 将用户的数据写成ZIP归档
+func (s *Service) writeArchive(ctx context.Context, w io.Writer, userID uint) error {
+	zw := zip.NewWriter(w)
+
+	if err := writeJSON(zw, "export.json", manifest{
+		UserID:      userID,
+		GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
+		Files:       files,
+	}); err != nil {
+		return err
+	}
+
+	// 1. 认证服务中的用户资料
+	profile, err := s.profile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "profile.json", profile); err != nil {
+		return err
+	}
+
+	// 2. 本地账号信息，认证服务中的用户尚未同步到本地时为null
+	var users []model.User
+	if err := s.db.WithContext(ctx).Where("id = ?", userID).Limit(1).Find(&users).Error; err != nil {
+		return err
+	}
+	var account *model.User
+	if len(users) > 0 {
+		account = &users[0]
+	}
+	if err := writeJSON(zw, "account.json", account); err != nil {
+		return err
+	}
+
+	// 3. 订单、VIP记录、AI用量和额度等按用户保存的数据
+	byUser := func() *gorm.DB {
+		return s.db.WithContext(ctx).Where("user_id = ?", userID)
+	}
+	if err := writeRows[model.UserIdentity](zw, "identities.json", byUser(), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.VipOrder](zw, "vip_orders.json", byUser().Preload("Plan").Preload("CreditPack"), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.VipRecord](zw, "vip_records.json", byUser().Preload("Plan"), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.AIMeter](zw, "ai_meters.json", byUser(), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.AIUsage](zw, "ai_usages.json", byUser(), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.AIUsageLedger](zw, "ai_usage_ledger.json", byUser(), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.AICreditGrant](zw, "ai_credit_grants.json", byUser(), s.c.BatchSize); err != nil {
+		return err
+	}
+	if err := writeRows[model.AICreditLedger](zw, "ai_credit_ledger.json", byUser(), s.c.BatchSize); err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// writeJSON 在归档中写入一个JSON文件
+func writeJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
+
+// writeRows 按主键分批读取查询结果，在归档中写成一个JSON数组，避免一次性加载流水等大量数据
+func writeRows[T any](zw *zip.Writer, name string, query *gorm.DB, batchSize int) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	empty := true
+	var rows []T
+	if err := query.FindInBatches(&rows, batchSize, func(tx *gorm.DB, batch int) error {
+		for i := range rows {
+			data, err := json.MarshalIndent(&rows[i], "  ", "  ")
+			if err != nil {
+				return err
+			}
+			sep := ",\n  "
+			if empty {
+				sep = "\n  "
+				empty = false
+			}
+			if _, err := io.WriteString(w, sep); err != nil {
+				return err
+			}
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}).Error; err != nil {
+		return err
+	}
+
+	end := "\n]\n"
+	if empty {
+		end = "]\n"
+	}
+	_, err = io.WriteString(w, end)
+	return err
+}
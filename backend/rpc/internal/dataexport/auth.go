@@ -0,0 +1,49 @@
+package dataexport
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/rpc/pb/auth"
+)
+
+// authProfile 导出文件中认证服务的用户资料
+type authProfile struct {
+	ID           string `json:"id"`
+	Username     string `json:"username"`
+	Email        string `json:"email"`
+	Avatar       string `json:"avatar"`
+	IsVip        bool   `json:"is_vip"`
+	VipExpiresAt string `json:"vip_expires_at"`
+	AutoRenew    bool   `json:"auto_renew"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// AuthProfile 从认证服务获取用户资料
+func AuthProfile(authClient auth.AuthClient) ProfileFunc {
+	return func(ctx context.Context, userID uint) (any, error) {
+		if authClient == nil {
+			return nil, errors.New("AuthClient未初始化")
+		}
+		resp, err := authClient.GetUserInfo(ctx, &auth.GetUserInfoReq{
+			UserId: strconv.FormatUint(uint64(userID), 10),
+		})
+		if err != nil {
+			return nil, err
+		}
+		user := resp.GetUser()
+		return authProfile{
+			ID:           user.GetId(),
+			Username:     user.GetUsername(),
+			Email:        user.GetEmail(),
+			Avatar:       user.GetAvatar(),
+			IsVip:        user.GetIsVip(),
+			VipExpiresAt: user.GetVipExpiresAt(),
+			AutoRenew:    user.GetAutoRenew(),
+			CreatedAt:    user.GetCreatedAt(),
+			UpdatedAt:    user.GetUpdatedAt(),
+		}, nil
+	}
+}
@@ -0,0 +1,328 @@
+// Package dataexport 生成用户个人数据的导出文件
+// 用户申请后由后台任务将资料、VIP订单和记录、AI用量等数据写成ZIP归档（每类数据一个JSON文件），
+// 生成后通过带签名和有效期的链接下载，过期后删除文件
+package dataexport
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/lease"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// processBatch 每次扫描最多生成的导出数，生成较慢，避免一次领取过多导致租约到期
+const processBatch = 10
+
+var (
+	// ErrNotFound 导出任务不存在
+	ErrNotFound = errors.New("导出任务不存在")
+	// ErrInvalidLink 下载链接签名错误、已过期，或导出文件已删除
+	ErrInvalidLink = errors.New("下载链接无效或已过期")
+)
+
+// Config 个人数据导出配置
+type Config struct {
+	Dir         string `json:",default=data/exports"`            // 导出文件存放目录，多实例部署时需使用共享存储
+	Secret      string `json:",optional,env=DATA_EXPORT_SECRET"` // 下载链接签名密钥，至少32字节
+	Retention   int64  `json:",default=86400"`                   // 导出文件保留时长（秒），过期后删除
+	LinkTTL     int64  `json:",default=600"`                     // 下载链接有效期（秒）
+	Interval    int64  `json:",default=5"`                       // 后台生成的扫描间隔（秒）
+	Lease       int64  `json:",default=600"`                     // 生成中的导出的租约（秒），实例中途退出时租约到期后由其他实例重新生成
+	RetryDelay  int64  `json:",default=60"`                      // 生成失败后的重试等待时长（秒）
+	MaxAttempts int    `json:",default=3"`                       // 最多尝试次数，超过后标记为失败
+	BatchSize   int    `json:",default=1000"`                    // 读取流水等数据时每批的行数
+}
+
+// ProfileFunc 获取用户在认证服务中的资料，写入导出文件
+type ProfileFunc func(ctx context.Context, userID uint) (any, error)
+
+// Service 个人数据导出
+type Service struct {
+	db      *gorm.DB
+	c       Config
+	secret  []byte
+	exports *lease.Table
+	profile ProfileFunc
+}
+
+// New 创建个人数据导出服务
+func New(db *gorm.DB, c Config, profile ProfileFunc) (*Service, error) {
+	if len(c.Secret) < 32 {
+		return nil, errors.New("数据导出下载链接签名密钥至少需要32字节，请配置DataExport.Secret或环境变量DATA_EXPORT_SECRET")
+	}
+	if c.Dir == "" {
+		return nil, errors.New("数据导出文件存放目录不能为空")
+	}
+	if c.Retention <= 0 || c.LinkTTL <= 0 || c.Interval <= 0 || c.Lease <= 0 || c.RetryDelay <= 0 {
+		return nil, errors.New("数据导出的保留时长、链接有效期、扫描间隔、租约和重试等待时长必须大于0")
+	}
+	if c.MaxAttempts <= 0 || c.BatchSize <= 0 {
+		return nil, errors.New("数据导出的最多尝试次数和批大小必须大于0")
+	}
+	exports := lease.New(db, &model.DataExport{}, model.DataExportRunning, time.Duration(c.Lease)*time.Second)
+	return &Service{db: db, c: c, secret: []byte(c.Secret), exports: exports, profile: profile}, nil
+}
+
+// Interval 后台生成的扫描间隔
+func (s *Service) Interval() time.Duration {
+	return time.Duration(s.c.Interval) * time.Second
+}
+
+// Start 申请导出，返回导出任务和是否新建
+// 已有等待或正在生成的导出时直接返回；已有未过期的导出文件时，fresh为false则直接返回，否则重新生成
+func (s *Service) Start(ctx context.Context, userID uint, fresh bool, now time.Time) (*model.DataExport, bool, error) {
+	var export model.DataExport
+	created := false
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁定用户，同一用户同时申请时只创建一个导出任务
+		var users []model.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", userID).Find(&users).Error; err != nil {
+			return err
+		}
+
+		var latest []model.DataExport
+		if err := tx.Where("user_id = ? AND status IN ?", userID,
+			[]string{model.DataExportPending, model.DataExportRunning, model.DataExportReady}).
+			Order("id DESC").Limit(1).Find(&latest).Error; err != nil {
+			return err
+		}
+		if len(latest) > 0 {
+			switch {
+			case latest[0].Status != model.DataExportReady:
+				export = latest[0]
+				return nil
+			case !fresh && latest[0].ExpiresAt != nil && latest[0].ExpiresAt.After(now):
+				export = latest[0]
+				return nil
+			}
+		}
+
+		export = model.DataExport{UserID: userID, Status: model.DataExportPending, NextAttemptAt: now}
+		created = true
+		return tx.Create(&export).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &export, created, nil
+}
+
+// Get 查询用户的导出任务
+func (s *Service) Get(ctx context.Context, userID, id uint) (*model.DataExport, error) {
+	var export model.DataExport
+	err := s.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userID).First(&export).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+// Link 为已生成的导出签发下载链接，有效期不超过导出文件的过期时间；导出未生成时返回空
+func (s *Service) Link(export *model.DataExport, now time.Time) (string, time.Time) {
+	if export.Status != model.DataExportReady || export.ExpiresAt == nil || !export.ExpiresAt.After(now) {
+		return "", time.Time{}
+	}
+	expiresAt := now.Add(time.Duration(s.c.LinkTTL) * time.Second)
+	if expiresAt.After(*export.ExpiresAt) {
+		expiresAt = *export.ExpiresAt
+	}
+	expires := expiresAt.Unix()
+	return fmt.Sprintf("/api/export/download?id=%d&expires=%d&signature=%s",
+		export.ID, expires, s.sign(export.ID, export.UserID, expires)), time.Unix(expires, 0)
+}
+
+// Open 校验下载链接并打开导出文件，调用方负责关闭文件
+func (s *Service) Open(ctx context.Context, id uint, expires int64, signature string, now time.Time) (*model.DataExport, *os.File, error) {
+	if expires < now.Unix() {
+		return nil, nil, ErrInvalidLink
+	}
+
+	var export model.DataExport
+	err := s.db.WithContext(ctx).First(&export, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, ErrInvalidLink
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 签名绑定导出任务和用户，先校验签名再判断状态，避免通过错误信息探测导出任务
+	if !hmac.Equal([]byte(signature), []byte(s.sign(export.ID, export.UserID, expires))) {
+		return nil, nil, ErrInvalidLink
+	}
+	if export.Status != model.DataExportReady || export.ExpiresAt == nil || !export.ExpiresAt.After(now) {
+		return nil, nil, ErrInvalidLink
+	}
+
+	file, err := os.Open(s.path(export.FileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, ErrInvalidLink
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return &export, file, nil
+}
+
+// Process 生成一批到期的导出，包括等待生成、等待重试和租约已到期的导出，返回成功生成的数量
+func (s *Service) Process(ctx context.Context, now time.Time) (int, error) {
+	var exports []model.DataExport
+	if err := s.db.WithContext(ctx).
+		Where("status IN ? AND next_attempt_at <= ?", []string{model.DataExportPending, model.DataExportRunning}, now).
+		Order("next_attempt_at, id").
+		Limit(processBatch).
+		Find(&exports).Error; err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for i := range exports {
+		claimed, err := s.claim(ctx, &exports[i], now)
+		if err != nil {
+			return generated, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := s.run(ctx, &exports[i]); err == nil {
+			generated++
+		}
+	}
+	return generated, nil
+}
+
+// Expire 删除过期的导出文件，返回删除的数量
+func (s *Service) Expire(ctx context.Context, now time.Time) (int, error) {
+	var exports []model.DataExport
+	if err := s.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", model.DataExportReady, now).
+		Order("id").
+		Find(&exports).Error; err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for i := range exports {
+		if err := s.remove(exports[i].FileName); err != nil {
+			return expired, err
+		}
+		if err := s.db.WithContext(ctx).Model(&model.DataExport{}).
+			Where("id = ? AND status = ?", exports[i].ID, model.DataExportReady).
+			Updates(map[string]interface{}{
+				"status":    model.DataExportExpired,
+				"file_name": "",
+			}).Error; err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// Discard 删除用户的全部导出文件和导出任务，在彻底清除用户数据后调用
+func (s *Service) Discard(ctx context.Context, userID uint) error {
+	var exports []model.DataExport
+	if err := s.db.WithContext(ctx).Where("user_id = ?", userID).Find(&exports).Error; err != nil {
+		return err
+	}
+	for i := range exports {
+		if err := s.remove(exports[i].FileName); err != nil {
+			return err
+		}
+	}
+	return s.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.DataExport{}).Error
+}
+
+// claim 领取导出并标记为生成中，多个实例同时领取时只有一个成功
+func (s *Service) claim(ctx context.Context, export *model.DataExport, now time.Time) (bool, error) {
+	leaseUntil, claimed, err := s.exports.Claim(ctx, export.ID, export.Status, export.Attempts, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+	export.Status = model.DataExportRunning
+	export.NextAttemptAt = leaseUntil
+	export.Attempts++
+	return true, nil
+}
+
+// run 生成已领取的导出并记录结果，失败时未超过尝试次数则等待一段时间后重试
+func (s *Service) run(ctx context.Context, export *model.DataExport) error {
+	logger := logx.WithContext(ctx)
+
+	fileName, size, err := s.build(ctx, export)
+
+	// 生成耗时较长，以完成时间计算文件的过期时间
+	now := time.Now()
+	updates := map[string]interface{}{}
+	switch {
+	case err == nil:
+		expiresAt := now.Add(time.Duration(s.c.Retention) * time.Second)
+		updates["status"] = model.DataExportReady
+		updates["file_name"] = fileName
+		updates["size"] = size
+		updates["completed_at"] = now
+		updates["expires_at"] = expiresAt
+		updates["last_error"] = ""
+	case export.Attempts >= s.c.MaxAttempts:
+		logger.Errorf("生成个人数据导出多次失败，已放弃: id=%d user_id=%d err=%v", export.ID, export.UserID, err)
+		updates["status"] = model.DataExportFailed
+		updates["last_error"] = lease.Error(err)
+	default:
+		logger.Errorf("生成个人数据导出失败，稍后重试: id=%d user_id=%d attempts=%d err=%v", export.ID, export.UserID, export.Attempts, err)
+		updates["status"] = model.DataExportPending
+		updates["next_attempt_at"] = now.Add(time.Duration(s.c.RetryDelay) * time.Second)
+		updates["last_error"] = lease.Error(err)
+	}
+
+	recorded, updateErr := s.exports.Finish(ctx, export.ID, export.Attempts, updates)
+	if updateErr != nil || !recorded {
+		if updateErr != nil {
+			logger.Errorf("记录个人数据导出结果失败: id=%d err=%v", export.ID, updateErr)
+		}
+		// 结果未记录，生成的文件不会被引用
+		if fileName != "" {
+			if removeErr := s.remove(fileName); removeErr != nil {
+				logger.Errorf("删除未使用的导出文件失败: file=%s err=%v", fileName, removeErr)
+			}
+		}
+	}
+	return err
+}
+
+// sign 计算下载链接的签名
+func (s *Service) sign(id, userID uint, expires int64) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "data_export:%d:%d:%d", id, userID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path 导出文件的完整路径
+func (s *Service) path(fileName string) string {
+	return filepath.Join(s.c.Dir, fileName)
+}
+
+// remove 删除导出文件，文件不存在时忽略
+func (s *Service) remove(fileName string) error {
+	if fileName == "" {
+		return nil
+	}
+	if err := os.Remove(s.path(fileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
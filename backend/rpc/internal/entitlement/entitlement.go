@@ -0,0 +1,166 @@
+package entitlement
+
+import (
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/credits"
+	"backend/rpc/internal/orderstate"
+	"backend/rpc/internal/outbox"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrInvalidPlanDuration 套餐有效期无效
+	ErrInvalidPlanDuration = errors.New("套餐有效期无效")
+	// ErrOrderUserPurged 订单的用户已被彻底清除
+	ErrOrderUserPurged = errors.New("订单的用户已被清除")
+)
+
+// Activate 按套餐时长为用户开通VIP，需在事务中调用，同时记录同步认证服务的操作
+// 用户已有未到期的VIP时，新的有效期接在当前有效期结束之后；orderID为0表示非订单开通（如管理员赠送）
+func Activate(tx *gorm.DB, userID uint, plan *model.VipPlan, orderID uint, now time.Time) (*model.User, *model.VipRecord, error) {
+	if plan.Duration <= 0 {
+		return nil, nil, ErrInvalidPlanDuration
+	}
+
+	// 1. 锁定用户，同一用户的并发开通按顺序叠加
+	var user model.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, userID).Error; err != nil {
+		return nil, nil, err
+	}
+
+	// 2. 计算新有效期的开始时间
+	currentEnd, err := currentPeriodEnd(tx, &user, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	start := now
+	if currentEnd.After(now) {
+		start = currentEnd
+	}
+	end := start.AddDate(0, 0, plan.Duration)
+
+	// 3. 创建VIP记录
+	record := model.VipRecord{
+		UserID:   user.ID,
+		PlanID:   plan.ID,
+		IsActive: true,
+		StartAt:  start,
+		EndAt:    end,
+	}
+	if orderID != 0 {
+		record.OrderID = &orderID
+	}
+	if err := tx.Create(&record).Error; err != nil {
+		return nil, nil, err
+	}
+
+	// 4. 更新用户VIP状态，连续的VIP期间保留最初的开始时间
+	vipStart := start
+	if user.IsVip && user.VipStartAt != nil && currentEnd.After(now) {
+		vipStart = *user.VipStartAt
+	}
+	if err := tx.Model(&user).Updates(map[string]interface{}{
+		"is_vip":       true,
+		"vip_start_at": vipStart,
+		"vip_end_at":   end,
+	}).Error; err != nil {
+		return nil, nil, err
+	}
+	user.IsVip = true
+	user.VipStartAt = &vipStart
+	user.VipEndAt = &end
+
+	// 5. 记录同步认证服务VIP状态的操作
+	if _, err := outbox.Enqueue(tx, model.OutboxAuthSyncVip, user.ID, now); err != nil {
+		return nil, nil, err
+	}
+
+	return &user, &record, nil
+}
+
+// ActivateOrder 在一个事务中将待支付订单标记为已支付并发放所购商品
+// VIP套餐订单创建VIP记录并更新用户VIP状态，返回更新后的用户；加油包订单发放AI额度，返回的用户为nil
+// order需预加载Plan和CreditPack，paidFields为标记支付时同时更新的订单字段（如交易号、支付时间）
+func ActivateOrder(db *gorm.DB, order *model.VipOrder, paidFields map[string]interface{}, now time.Time) (*model.User, error) {
+	if order.UserID == nil {
+		return nil, ErrOrderUserPurged
+	}
+
+	var user *model.User
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := orderstate.Transition(tx, order, model.VipOrderStatusPaid, paidFields); err != nil {
+			return err
+		}
+
+		var err error
+		if order.ProductType == model.OrderProductCreditPack {
+			_, err = credits.Grant(tx, *order.UserID, &order.CreditPack, order.ID, now)
+			return err
+		}
+		user, _, err = Activate(tx, *order.UserID, &order.Plan, order.ID, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Revoke 取消用户VIP，所有VIP记录置为非激活，需在事务中调用，同时记录同步认证服务的操作
+func Revoke(tx *gorm.DB, userID uint) (*model.User, error) {
+	var user model.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Model(&model.VipRecord{}).
+		Where("user_id = ? AND is_active = ?", user.ID, true).
+		Update("is_active", false).Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Model(&user).Updates(map[string]interface{}{
+		"is_vip":       false,
+		"vip_start_at": nil,
+		"vip_end_at":   nil,
+	}).Error; err != nil {
+		return nil, err
+	}
+	user.IsVip = false
+	user.VipStartAt = nil
+	user.VipEndAt = nil
+
+	// 记录同步认证服务VIP状态的操作
+	if _, err := outbox.Enqueue(tx, model.OutboxAuthSyncVip, user.ID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// currentPeriodEnd 用户当前VIP有效期的结束时间，没有有效期时返回零值
+func currentPeriodEnd(tx *gorm.DB, user *model.User, now time.Time) (time.Time, error) {
+	var end time.Time
+	if user.IsVip && user.VipEndAt != nil {
+		end = *user.VipEndAt
+	}
+
+	var record model.VipRecord
+	err := tx.Where("user_id = ? AND is_active = ? AND end_at > ?", user.ID, true, now).
+		Order("end_at DESC").
+		First(&record).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, err
+	}
+	if err == nil && record.EndAt.After(end) {
+		end = record.EndAt
+	}
+
+	return end, nil
+}
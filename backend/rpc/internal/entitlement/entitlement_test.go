@@ -0,0 +1,166 @@
+package entitlement
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+var entitlementTables = []any{&model.VipRecord{}, &model.OutboxEvent{}}
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	return testdb.Open(t, append([]any{&model.VipPlan{}}, entitlementTables...)...)
+}
+
+func createPlan(t *testing.T, db *gorm.DB, days int) *model.VipPlan {
+	t.Helper()
+
+	plan := &model.VipPlan{
+		Name:     "测试套餐",
+		Price:    19.9,
+		Duration: days,
+		Features: model.PlanFeatures{
+			Quotas:     map[string]int{model.AIFeatureChat: 100, model.AIFeatureContent: 50, model.AIFeatureAnalysis: 20},
+			ModelTiers: []string{model.ModelTierBasic},
+			MaxTokens:  4096,
+		},
+	}
+	if err := db.Create(plan).Error; err != nil {
+		t.Fatalf("创建套餐失败: %v", err)
+	}
+	t.Cleanup(func() { db.Unscoped().Delete(plan) })
+	return plan
+}
+
+func activate(t *testing.T, db *gorm.DB, userID uint, plan *model.VipPlan, now time.Time) (*model.User, *model.VipRecord) {
+	t.Helper()
+
+	var (
+		user   *model.User
+		record *model.VipRecord
+	)
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, record, err = Activate(tx, userID, plan, 0, now)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("开通VIP失败: %v", err)
+	}
+	return user, record
+}
+
+// 没有VIP的用户从现在开始计算有效期
+func TestActivateStartsNowWithoutVip(t *testing.T) {
+	db := openTestDB(t)
+	plan := createPlan(t, db, 30)
+	user := testdb.CreateUser(t, db, entitlementTables...)
+	now := time.Now().Truncate(time.Second)
+
+	updated, record := activate(t, db, user.ID, plan, now)
+	if !record.StartAt.Equal(now) || !record.EndAt.Equal(now.AddDate(0, 0, 30)) {
+		t.Fatalf("有效期 = %v - %v，期望从 %v 起30天", record.StartAt, record.EndAt, now)
+	}
+	if !updated.IsVip || !updated.VipEndAt.Equal(record.EndAt) {
+		t.Fatalf("用户VIP状态错误: %+v", updated)
+	}
+
+	var events int64
+	db.Model(&model.OutboxEvent{}).Where("user_id = ? AND kind = ?", user.ID, model.OutboxAuthSyncVip).Count(&events)
+	if events != 1 {
+		t.Fatalf("应记录一次同步认证服务的操作，得到%d", events)
+	}
+}
+
+// 已有未到期的VIP时，新的有效期接在当前有效期结束之后，VIP开始时间保持不变
+func TestActivateStacksOnCurrentExpiry(t *testing.T) {
+	db := openTestDB(t)
+	monthly := createPlan(t, db, 30)
+	yearly := createPlan(t, db, 365)
+	user := testdb.CreateUser(t, db, entitlementTables...)
+	now := time.Now().Truncate(time.Second)
+
+	_, first := activate(t, db, user.ID, monthly, now)
+
+	later := now.Add(10 * 24 * time.Hour)
+	updated, second := activate(t, db, user.ID, yearly, later)
+	if !second.StartAt.Equal(first.EndAt) {
+		t.Fatalf("叠加的有效期应从 %v 开始，得到 %v", first.EndAt, second.StartAt)
+	}
+	wantEnd := first.EndAt.AddDate(0, 0, 365)
+	if !second.EndAt.Equal(wantEnd) || !updated.VipEndAt.Equal(wantEnd) {
+		t.Fatalf("叠加后应到期于 %v，得到记录 %v 用户 %v", wantEnd, second.EndAt, updated.VipEndAt)
+	}
+	if !updated.VipStartAt.Equal(now) {
+		t.Fatalf("连续的VIP期间应保留最初的开始时间 %v，得到 %v", now, updated.VipStartAt)
+	}
+}
+
+// VIP已到期后再次开通，从现在开始计算，不接在过期的有效期之后
+func TestActivateAfterExpiryStartsNow(t *testing.T) {
+	db := openTestDB(t)
+	plan := createPlan(t, db, 30)
+	user := testdb.CreateUser(t, db, entitlementTables...)
+	now := time.Now().Truncate(time.Second)
+
+	_, first := activate(t, db, user.ID, plan, now)
+
+	later := first.EndAt.Add(24 * time.Hour)
+	updated, second := activate(t, db, user.ID, plan, later)
+	if !second.StartAt.Equal(later) || !updated.VipStartAt.Equal(later) {
+		t.Fatalf("过期后的新有效期应从 %v 开始，得到记录 %v 用户 %v", later, second.StartAt, updated.VipStartAt)
+	}
+}
+
+func TestActivateRejectsInvalidDuration(t *testing.T) {
+	db := openTestDB(t)
+	user := testdb.CreateUser(t, db, entitlementTables...)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		_, _, err := Activate(tx, user.ID, &model.VipPlan{Duration: 0}, 0, time.Now())
+		return err
+	})
+	if !errors.Is(err, ErrInvalidPlanDuration) {
+		t.Fatalf("期望ErrInvalidPlanDuration，得到 %v", err)
+	}
+}
+
+// 取消VIP后所有记录置为非激活，再次开通从现在开始计算
+func TestRevokeDeactivatesAllRecords(t *testing.T) {
+	db := openTestDB(t)
+	plan := createPlan(t, db, 30)
+	user := testdb.CreateUser(t, db, entitlementTables...)
+	now := time.Now().Truncate(time.Second)
+
+	activate(t, db, user.ID, plan, now)
+	activate(t, db, user.ID, plan, now)
+
+	var revoked *model.User
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		revoked, err = Revoke(tx, user.ID)
+		return err
+	}); err != nil {
+		t.Fatalf("取消VIP失败: %v", err)
+	}
+	if revoked.IsVip || revoked.VipEndAt != nil {
+		t.Fatalf("取消后用户仍为VIP: %+v", revoked)
+	}
+
+	var active int64
+	db.Model(&model.VipRecord{}).Where("user_id = ? AND is_active = ?", user.ID, true).Count(&active)
+	if active != 0 {
+		t.Fatalf("取消后仍有%d条激活的VIP记录", active)
+	}
+
+	_, record := activate(t, db, user.ID, plan, now)
+	if !record.StartAt.Equal(now) {
+		t.Fatalf("取消后再次开通应从 %v 开始，得到 %v", now, record.StartAt)
+	}
+}
@@ -20,6 +20,8 @@ func New(httpCode int, message string) error {
 		code = codes.NotFound
 	case 409:
 		code = codes.AlreadyExists
+	case 429:
+		code = codes.ResourceExhausted
 	case 500:
 		code = codes.Internal
 	default:
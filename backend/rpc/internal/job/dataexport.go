@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"backend/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// DataExporter 定期生成用户申请的个人数据导出，并删除过期的导出文件
+// 多实例同时运行时由乐观锁保证每个导出只被一个实例生成
+type DataExporter struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewDataExporter(svcCtx *svc.ServiceContext) *DataExporter {
+	return &DataExporter{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动生成，阻塞直到Stop被调用
+func (e *DataExporter) Start() {
+	ticker := time.NewTicker(e.svcCtx.DataExport.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.export(context.Background())
+		case <-e.done:
+			return
+		}
+	}
+}
+
+// Stop 停止生成
+func (e *DataExporter) Stop() {
+	close(e.done)
+}
+
+// export 生成一批到期的导出，并删除过期的导出文件
+func (e *DataExporter) export(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	now := time.Now()
+
+	if generated, err := e.svcCtx.DataExport.Process(ctx, now); err != nil {
+		logger.Errorf("生成个人数据导出失败: %v", err)
+	} else if generated > 0 {
+		logger.Infof("已生成%d个个人数据导出", generated)
+	}
+
+	if expired, err := e.svcCtx.DataExport.Expire(ctx, now); err != nil {
+		logger.Errorf("删除过期的个人数据导出文件失败: %v", err)
+	} else if expired > 0 {
+		logger.Infof("已删除%d个过期的个人数据导出文件", expired)
+	}
+}
@@ -0,0 +1,91 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/orderstate"
+	"backend/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// orderExpiryBatchSize 每批处理的过期订单数
+const orderExpiryBatchSize = 100
+
+// OrderExpirySweeper 定期将超过支付期限的待支付订单置为过期
+// 多实例同时运行时由乐观锁保证每个订单只被处理一次
+type OrderExpirySweeper struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewOrderExpirySweeper(svcCtx *svc.ServiceContext) *OrderExpirySweeper {
+	return &OrderExpirySweeper{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动扫描，阻塞直到Stop被调用
+func (s *OrderExpirySweeper) Start() {
+	ticker := time.NewTicker(time.Duration(s.svcCtx.Config.Order.SweepInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.sweep(context.Background())
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Stop 停止扫描
+func (s *OrderExpirySweeper) Stop() {
+	close(s.done)
+}
+
+// sweep 分批处理过期订单
+func (s *OrderExpirySweeper) sweep(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	deadline := time.Now().Add(-time.Duration(s.svcCtx.Config.Order.PendingTTL) * time.Second)
+
+	var lastID uint
+	expired := 0
+	for {
+		var orders []model.VipOrder
+		if err := s.svcCtx.DB.WithContext(ctx).
+			Where("status = ? AND created_at < ? AND id > ?", model.VipOrderStatusPending, deadline, lastID).
+			Order("id").
+			Limit(orderExpiryBatchSize).
+			Find(&orders).Error; err != nil {
+			logger.Errorf("查询过期订单失败: %v", err)
+			return
+		}
+
+		for i := range orders {
+			err := orderstate.Transition(s.svcCtx.DB.WithContext(ctx), &orders[i], model.VipOrderStatusExpired, nil)
+			switch {
+			case err == nil:
+				expired++
+			case errors.Is(err, orderstate.ErrConflict):
+				// 订单已被支付、取消或由其他实例处理
+			default:
+				logger.Errorf("订单过期失败: order_no=%s err=%v", orders[i].OrderNo, err)
+			}
+		}
+
+		if len(orders) < orderExpiryBatchSize {
+			break
+		}
+		lastID = orders[len(orders)-1].ID
+	}
+
+	if expired > 0 {
+		logger.Infof("已过期%d个超时未支付的订单", expired)
+	}
+}
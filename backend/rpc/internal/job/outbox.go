@@ -0,0 +1,73 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"backend/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// OutboxDispatcher 定期投递到期的跨服务操作，并清理超过保留时长的已投递操作
+// 多实例同时运行时由乐观锁保证每次投递只被一个实例执行
+type OutboxDispatcher struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewOutboxDispatcher(svcCtx *svc.ServiceContext) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动投递，阻塞直到Stop被调用
+func (d *OutboxDispatcher) Start() {
+	ticker := time.NewTicker(d.svcCtx.Outbox.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			d.dispatch(context.Background())
+		case <-d.done:
+			return
+		}
+	}
+}
+
+// Stop 停止投递
+func (d *OutboxDispatcher) Stop() {
+	close(d.done)
+}
+
+// dispatch 分批投递到期的操作，直到没有到期的操作
+func (d *OutboxDispatcher) dispatch(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	now := time.Now()
+
+	total := 0
+	for {
+		delivered, err := d.svcCtx.Outbox.Dispatch(ctx, now)
+		if err != nil {
+			logger.Errorf("投递跨服务操作失败: %v", err)
+			return
+		}
+		total += delivered
+		// 本批全部失败或没有到期的操作时结束，失败的操作已推迟到下次
+		if delivered == 0 {
+			break
+		}
+	}
+	if total > 0 {
+		logger.Infof("已投递%d个跨服务操作", total)
+	}
+
+	if purged, err := d.svcCtx.Outbox.Purge(ctx, now); err != nil {
+		logger.Errorf("清理已投递的跨服务操作失败: %v", err)
+	} else if purged > 0 {
+		logger.Infof("已清理%d个已投递的跨服务操作", purged)
+	}
+}
@@ -0,0 +1,103 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/userdeletion"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// UserPurger 定期彻底清除注销到期的用户
+// 多实例同时运行时由注销记录的状态更新保证每个用户只被清除一次
+type UserPurger struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewUserPurger(svcCtx *svc.ServiceContext) *UserPurger {
+	return &UserPurger{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动清除，阻塞直到Stop被调用
+func (p *UserPurger) Start() {
+	ticker := time.NewTicker(p.svcCtx.UserDeletion.Interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.purge(context.Background())
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// Stop 停止清除
+func (p *UserPurger) Stop() {
+	close(p.done)
+}
+
+// purge 分批清除到期的用户，清除后删除个人数据导出文件，并立即删除认证服务中的用户，失败时由跨服务操作投递重试
+func (p *UserPurger) purge(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	now := time.Now()
+
+	var lastID uint
+	purged := 0
+	for {
+		deletions, err := p.svcCtx.UserDeletion.Due(ctx, now, lastID)
+		if err != nil {
+			logger.Errorf("查询到期的注销记录失败: %v", err)
+			return
+		}
+
+		for i := range deletions {
+			deletion := &deletions[i]
+			result, err := p.svcCtx.UserDeletion.Purge(ctx, deletion, now)
+			switch {
+			case errors.Is(err, userdeletion.ErrNotRequested):
+				// 注销已被撤销或由其他实例清除
+				continue
+			case err != nil:
+				logger.Errorf("清除用户数据失败: user_id=%d err=%v", deletion.UserID, err)
+				continue
+			}
+
+			purged++
+			audit.Record(ctx, p.svcCtx.DB, audit.Event{
+				Action: model.AuditUserPurged,
+				UserID: deletion.UserID,
+				Detail: map[string]any{
+					"source":            deletion.Source,
+					"requested_at":      deletion.CreatedAt.Format("2006-01-02 15:04:05"),
+					"anonymized_orders": result.AnonymizedOrders,
+				},
+			})
+			if err := p.svcCtx.DataExport.Discard(ctx, deletion.UserID); err != nil {
+				logger.Errorf("删除用户的个人数据导出文件失败: user_id=%d err=%v", deletion.UserID, err)
+			}
+			if err := p.svcCtx.Outbox.DeliverNew(ctx, deletion.UserID, now); err != nil {
+				logger.Errorf("调用AuthService删除用户失败，稍后重试: user_id=%d err=%v", deletion.UserID, err)
+			}
+		}
+
+		if len(deletions) < p.svcCtx.UserDeletion.BatchSize() {
+			break
+		}
+		lastID = deletions[len(deletions)-1].ID
+	}
+
+	if purged > 0 {
+		logger.Infof("已清除%d个注销到期的用户", purged)
+	}
+}
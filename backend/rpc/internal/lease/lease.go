@@ -0,0 +1,65 @@
+// Package lease 后台任务按租约领取数据库中的任务行，多个实例可同时处理同一张任务表
+// 领取时推迟下次处理时间作为租约并计入尝试次数，尝试次数作为乐观锁的版本号，多个实例同时领取时只有一个成功；
+// 实例中途退出时租约到期后由其他实例重新领取，先前领取者的结果不再写入
+package lease
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// maxErrorLen 记录的失败原因最大长度（字符）
+const maxErrorLen = 500
+
+// Table 按租约领取的任务表，表中需有id、status、attempts和next_attempt_at字段
+type Table struct {
+	db      *gorm.DB
+	model   any
+	running string
+	lease   time.Duration
+}
+
+// New 创建任务表，model为表对应的模型，running为领取后的状态，lease为租约时长
+func New(db *gorm.DB, model any, running string, lease time.Duration) *Table {
+	return &Table{db: db, model: model, running: running, lease: lease}
+}
+
+// Claim 领取状态为status、已尝试attempts次的任务，返回租约到期时间和是否领取成功
+func (t *Table) Claim(ctx context.Context, id uint, status string, attempts int, now time.Time) (time.Time, bool, error) {
+	leaseUntil := now.Add(t.lease)
+	result := t.db.WithContext(ctx).Model(t.model).
+		Where("id = ? AND status = ? AND attempts = ?", id, status, attempts).
+		Updates(map[string]interface{}{
+			"status":          t.running,
+			"next_attempt_at": leaseUntil,
+			"attempts":        gorm.Expr("attempts + 1"),
+		})
+	if result.Error != nil {
+		return time.Time{}, false, result.Error
+	}
+	return leaseUntil, result.RowsAffected > 0, nil
+}
+
+// Finish 记录第attempts次领取的处理结果，返回结果是否已写入
+// 处理期间ctx可能已取消，结果仍需写入；租约到期后已被其他实例重新领取时不覆盖其结果
+func (t *Table) Finish(ctx context.Context, id uint, attempts int, updates map[string]interface{}) (bool, error) {
+	result := t.db.WithContext(context.WithoutCancel(ctx)).Model(t.model).
+		Where("id = ? AND status = ? AND attempts = ?", id, t.running, attempts).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Error 记录的失败原因，超过长度限制时截断
+func Error(err error) string {
+	s := err.Error()
+	if utf8.RuneCountInString(s) <= maxErrorLen {
+		return s
+	}
+	return string([]rune(s)[:maxErrorLen])
+}
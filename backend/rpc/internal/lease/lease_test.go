@@ -0,0 +1,21 @@
+package lease
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestErrorTruncatesByRune(t *testing.T) {
+	short := errors.New("认证服务不可用")
+	if got := Error(short); got != short.Error() {
+		t.Fatalf("未超长的原因不应截断: %q", got)
+	}
+
+	long := errors.New(strings.Repeat("错", maxErrorLen+10))
+	got := Error(long)
+	if utf8.RuneCountInString(got) != maxErrorLen || !utf8.ValidString(got) {
+		t.Fatalf("截断后应为%d个完整字符，得到%d个", maxErrorLen, utf8.RuneCountInString(got))
+	}
+}
@@ -0,0 +1,344 @@
+// Package localauth 在进程内基于本地用户表实现认证服务，无需部署外部Auth服务即可运行
+package localauth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/pb/auth"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+const (
+	minPasswordLen  = 6
+	maxPasswordLen  = 72 // bcrypt只使用前72字节
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// dummyHash 用户不存在时也做一次bcrypt比较，避免通过响应时间判断账号是否存在
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("localauth-dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// Client 本地认证服务，实现 auth.AuthClient，用户数据保存在主服务的users表
+type Client struct {
+	db *gorm.DB
+}
+
+var _ auth.AuthClient = (*Client)(nil)
+
+// New 创建本地认证服务
+func New(db *gorm.DB) *Client {
+	return &Client{db: db}
+}
+
+func (c *Client) Register(ctx context.Context, in *auth.RegisterReq, _ ...grpc.CallOption) (*auth.RegisterResp, error) {
+	username := strings.TrimSpace(in.Username)
+	email := strings.TrimSpace(in.Email)
+	if username == "" || email == "" {
+		return nil, errorx.InvalidArgument("用户名和邮箱不能为空")
+	}
+	if err := checkPassword(in.Password); err != nil {
+		return nil, err
+	}
+
+	user := model.User{
+		Username: username,
+		Email:    email,
+		Password: in.Password, // 保存前由 BeforeSave 哈希
+		Role:     model.RoleUser,
+	}
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkUnique(tx, 0, username, email); err != nil {
+			return err
+		}
+		return tx.Create(&user).Error
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &auth.RegisterResp{User: userOf(&user)}, nil
+}
+
+// Login 校验用户名或邮箱和密码，访问Token由主服务签发，这里不返回Token
+func (c *Client) Login(ctx context.Context, in *auth.LoginReq, _ ...grpc.CallOption) (*auth.LoginResp, error) {
+	query := c.db.WithContext(ctx)
+	switch {
+	case in.Email != "":
+		query = query.Where("email = ?", strings.TrimSpace(in.Email))
+	case in.Username != "":
+		query = query.Where("username = ?", strings.TrimSpace(in.Username))
+	default:
+		return nil, errorx.InvalidArgument("用户名或邮箱不能为空")
+	}
+
+	var user model.User
+	err := query.First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		bcrypt.CompareHashAndPassword(dummyHash(), []byte(in.Password))
+		return nil, errorx.Unauthenticated("用户名或密码错误")
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if !user.CheckPassword(in.Password) {
+		return nil, errorx.Unauthenticated("用户名或密码错误")
+	}
+	return &auth.LoginResp{User: userOf(&user)}, nil
+}
+
+func (c *Client) GetUserInfo(ctx context.Context, in *auth.GetUserInfoReq, _ ...grpc.CallOption) (*auth.GetUserInfoResp, error) {
+	user, err := c.find(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetUserInfoResp{User: userOf(user)}, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, in *auth.GetUserReq, _ ...grpc.CallOption) (*auth.GetUserResp, error) {
+	user, err := c.find(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.GetUserResp{User: userOf(user)}, nil
+}
+
+// UpdateUserInfo 只更新非空字段
+func (c *Client) UpdateUserInfo(ctx context.Context, in *auth.UpdateUserInfoReq, _ ...grpc.CallOption) (*auth.UpdateUserInfoResp, error) {
+	userID, err := parseUserID(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	username := strings.TrimSpace(in.Username)
+	email := strings.TrimSpace(in.Email)
+
+	var user model.User
+	err = c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&user, userID).Error; err != nil {
+			return err
+		}
+		if err := checkUnique(tx, user.ID, username, email); err != nil {
+			return err
+		}
+
+		updates := map[string]any{}
+		if username != "" {
+			updates["username"] = username
+		}
+		if email != "" {
+			updates["email"] = email
+		}
+		if in.Avatar != "" {
+			updates["avatar"] = in.Avatar
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		if err := tx.Model(&model.User{}).Where("id = ?", user.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+		return tx.First(&user, userID).Error
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &auth.UpdateUserInfoResp{User: userOf(&user)}, nil
+}
+
+func (c *Client) UpdateUserPassword(ctx context.Context, in *auth.UpdateUserPasswordReq, _ ...grpc.CallOption) (*auth.UpdateUserPasswordResp, error) {
+	user, err := c.find(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CheckPassword(in.OldPassword) {
+		return nil, errorx.InvalidArgument("旧密码不正确")
+	}
+	if err := c.setPassword(ctx, user.ID, in.NewPassword); err != nil {
+		return nil, err
+	}
+	return &auth.UpdateUserPasswordResp{}, nil
+}
+
+// ResetUserPassword 不校验旧密码，调用方负责校验重置令牌
+func (c *Client) ResetUserPassword(ctx context.Context, in *auth.ResetUserPasswordReq, _ ...grpc.CallOption) (*auth.ResetUserPasswordResp, error) {
+	user, err := c.find(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.setPassword(ctx, user.ID, in.NewPassword); err != nil {
+		return nil, err
+	}
+	return &auth.ResetUserPasswordResp{}, nil
+}
+
+func (c *Client) DeleteUser(ctx context.Context, in *auth.DeleteUserReq, _ ...grpc.CallOption) (*auth.DeleteUserResp, error) {
+	userID, err := parseUserID(in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	result := c.db.WithContext(ctx).Delete(&model.User{}, userID)
+	if result.Error != nil {
+		return nil, dbError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errorx.NotFound("用户不存在")
+	}
+	return &auth.DeleteUserResp{}, nil
+}
+
+// UpdateUserVip VIP状态由主服务在同一张users表中维护，这里只返回当前用户
+func (c *Client) UpdateUserVip(ctx context.Context, in *auth.UpdateUserVipReq, _ ...grpc.CallOption) (*auth.UpdateUserVipResp, error) {
+	user, err := c.find(ctx, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.UpdateUserVipResp{User: userOf(user)}, nil
+}
+
+func (c *Client) GetUsers(ctx context.Context, in *auth.GetUsersReq, _ ...grpc.CallOption) (*auth.GetUsersResp, error) {
+	page := max(int(in.Page), 1)
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	var total int64
+	if err := c.db.WithContext(ctx).Model(&model.User{}).Count(&total).Error; err != nil {
+		return nil, dbError(err)
+	}
+	var users []model.User
+	if err := c.db.WithContext(ctx).Order("id").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&users).Error; err != nil {
+		return nil, dbError(err)
+	}
+
+	resp := &auth.GetUsersResp{
+		Users: make([]*auth.User, 0, len(users)),
+		Total: int32(total),
+	}
+	for i := range users {
+		resp.Users = append(resp.Users, userOf(&users[i]))
+	}
+	return resp, nil
+}
+
+func (c *Client) GetUserCount(ctx context.Context, in *auth.GetUserCountReq, _ ...grpc.CallOption) (*auth.GetUserCountResp, error) {
+	var count int64
+	if err := c.db.WithContext(ctx).Model(&model.User{}).Count(&count).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return &auth.GetUserCountResp{Count: int32(count)}, nil
+}
+
+func (c *Client) find(ctx context.Context, id string) (*model.User, error) {
+	userID, err := parseUserID(id)
+	if err != nil {
+		return nil, err
+	}
+	var user model.User
+	if err := c.db.WithContext(ctx).First(&user, userID).Error; err != nil {
+		return nil, dbError(err)
+	}
+	return &user, nil
+}
+
+// setPassword 更新密码，由 BeforeSave 哈希
+func (c *Client) setPassword(ctx context.Context, userID uint, password string) error {
+	if err := checkPassword(password); err != nil {
+		return err
+	}
+	user := model.User{ID: userID, Password: password}
+	if err := c.db.WithContext(ctx).Model(&user).Select("password").Updates(&user).Error; err != nil {
+		return dbError(err)
+	}
+	return nil
+}
+
+// errTaken 用户名或邮箱已被占用
+type errTaken string
+
+func (e errTaken) Error() string { return string(e) }
+
+// checkUnique 检查用户名和邮箱未被其他用户占用，已删除的用户仍占用唯一索引
+func checkUnique(tx *gorm.DB, selfID uint, username, email string) error {
+	if username != "" {
+		var count int64
+		if err := tx.Unscoped().Model(&model.User{}).Where("username = ? AND id <> ?", username, selfID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errTaken("用户名已存在")
+		}
+	}
+	if email != "" {
+		var count int64
+		if err := tx.Unscoped().Model(&model.User{}).Where("email = ? AND id <> ?", email, selfID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errTaken("邮箱已被注册")
+		}
+	}
+	return nil
+}
+
+func checkPassword(password string) error {
+	if utf8.RuneCountInString(password) < minPasswordLen {
+		return errorx.InvalidArgument("密码长度不能少于6位")
+	}
+	if len(password) > maxPasswordLen {
+		return errorx.InvalidArgument("密码长度不能超过72字节")
+	}
+	return nil
+}
+
+func parseUserID(id string) (uint, error) {
+	userID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || userID == 0 {
+		return 0, errorx.NotFound("用户不存在")
+	}
+	return uint(userID), nil
+}
+
+// dbError 将数据库错误转换为与外部认证服务一致的gRPC错误
+func dbError(err error) error {
+	var taken errTaken
+	switch {
+	case errors.As(err, &taken):
+		return errorx.AlreadyExists(taken.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errorx.NotFound("用户不存在")
+	default:
+		return errorx.Internal(err.Error())
+	}
+}
+
+func userOf(u *model.User) *auth.User {
+	vipExpiresAt := ""
+	if u.VipEndAt != nil {
+		vipExpiresAt = u.VipEndAt.Format("2006-01-02 15:04:05")
+	}
+	return &auth.User{
+		Id:           strconv.FormatUint(uint64(u.ID), 10),
+		Username:     u.Username,
+		Email:        u.Email,
+		Avatar:       u.Avatar,
+		CreatedAt:    u.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    u.UpdatedAt.Format("2006-01-02 15:04:05"),
+		IsVip:        u.IsVip,
+		VipExpiresAt: vipExpiresAt,
+	}
+}
@@ -0,0 +1,202 @@
+package localauth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/auth"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// uniqueName 测试中注册用户的用户名，邮箱为用户名加@example.com
+func uniqueName(prefix string) string {
+	return fmt.Sprintf("%s_%d", prefix, time.Now().UnixNano())
+}
+
+// register 注册用户，测试结束时删除
+func register(t *testing.T, db *gorm.DB, c *Client, username, email, password string) (*auth.User, error) {
+	t.Helper()
+	resp, err := c.Register(context.Background(), &auth.RegisterReq{Username: username, Email: email, Password: password})
+	if err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() { db.Unscoped().Delete(&model.User{}, resp.User.Id) })
+	return resp.User, nil
+}
+
+func login(c *Client, username, email, password string) error {
+	_, err := c.Login(context.Background(), &auth.LoginReq{Username: username, Email: email, Password: password})
+	return err
+}
+
+func TestRegisterHashesPasswordAndLogin(t *testing.T) {
+	db := testdb.Open(t)
+	c := New(db)
+
+	name := uniqueName("reg")
+	user, err := register(t, db, c, " "+name+" ", name+"@example.com", "secret-password")
+	if err != nil {
+		t.Fatalf("注册失败: %v", err)
+	}
+	if user.Username != name {
+		t.Fatalf("用户名未去除首尾空格: %q", user.Username)
+	}
+
+	// 密码由 BeforeSave 哈希后保存
+	var stored model.User
+	if err := db.First(&stored, user.Id).Error; err != nil {
+		t.Fatalf("查找用户失败: %v", err)
+	}
+	if stored.Password == "secret-password" {
+		t.Fatal("密码以明文保存")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(stored.Password), []byte("secret-password")); err != nil {
+		t.Fatalf("保存的密码不是原密码的bcrypt哈希: %v", err)
+	}
+
+	if err := login(c, name, "", "secret-password"); err != nil {
+		t.Fatalf("用户名登录失败: %v", err)
+	}
+	if err := login(c, "", name+"@example.com", "secret-password"); err != nil {
+		t.Fatalf("邮箱登录失败: %v", err)
+	}
+	if got := status.Code(login(c, name, "", "wrong-password")); got != codes.Unauthenticated {
+		t.Fatalf("密码错误返回%v，期望%v", got, codes.Unauthenticated)
+	}
+	if got := status.Code(login(c, uniqueName("nobody"), "", "secret-password")); got != codes.Unauthenticated {
+		t.Fatalf("用户不存在返回%v，期望%v", got, codes.Unauthenticated)
+	}
+}
+
+func TestRegisterRejectsInvalidInput(t *testing.T) {
+	db := testdb.Open(t)
+	c := New(db)
+
+	name := uniqueName("bad")
+	tests := []struct {
+		name                      string
+		username, email, password string
+	}{
+		{name: "用户名为空", username: " ", email: name + "@example.com", password: "secret-password"},
+		{name: "邮箱为空", username: name, email: "", password: "secret-password"},
+		{name: "密码过短", username: name, email: name + "@example.com", password: "12345"},
+		{name: "密码超过72字节", username: name, email: name + "@example.com", password: string(make([]byte, 73))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := register(t, db, c, tt.username, tt.email, tt.password)
+			if got := status.Code(err); got != codes.InvalidArgument {
+				t.Fatalf("返回%v，期望%v", got, codes.InvalidArgument)
+			}
+		})
+	}
+}
+
+func TestRegisterRejectsTakenUsernameAndEmail(t *testing.T) {
+	db := testdb.Open(t)
+	c := New(db)
+	existing := testdb.CreateUser(t, db)
+
+	if _, err := register(t, db, c, existing.Username, uniqueName("other")+"@example.com", "secret-password"); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("重复用户名返回%v，期望%v", status.Code(err), codes.AlreadyExists)
+	}
+	if _, err := register(t, db, c, uniqueName("other"), existing.Email, "secret-password"); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("重复邮箱返回%v，期望%v", status.Code(err), codes.AlreadyExists)
+	}
+
+	// 已删除的用户在保留期内可以恢复，仍占用用户名和邮箱
+	if err := db.Delete(existing).Error; err != nil {
+		t.Fatalf("删除用户失败: %v", err)
+	}
+	if _, err := register(t, db, c, existing.Username, uniqueName("other")+"@example.com", "secret-password"); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("已删除用户的用户名返回%v，期望%v", status.Code(err), codes.AlreadyExists)
+	}
+	if _, err := register(t, db, c, uniqueName("other"), existing.Email, "secret-password"); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("已删除用户的邮箱返回%v，期望%v", status.Code(err), codes.AlreadyExists)
+	}
+}
+
+func TestUpdateUserInfo(t *testing.T) {
+	db := testdb.Open(t)
+	c := New(db)
+	ctx := context.Background()
+	user := testdb.CreateUser(t, db)
+	other := testdb.CreateUser(t, db)
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	update := func(username, email string) (*auth.User, error) {
+		resp, err := c.UpdateUserInfo(ctx, &auth.UpdateUserInfoReq{UserId: userID, Username: username, Email: email})
+		if err != nil {
+			return nil, err
+		}
+		return resp.User, nil
+	}
+
+	// 不能改为其他用户的用户名或邮箱
+	if _, err := update(other.Username, ""); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("改为其他用户的用户名返回%v，期望%v", status.Code(err), codes.AlreadyExists)
+	}
+	if _, err := update("", other.Email); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("改为其他用户的邮箱返回%v，期望%v", status.Code(err), codes.AlreadyExists)
+	}
+
+	// 提交自己当前的用户名不算冲突，空字段不更新
+	email := uniqueName("new") + "@example.com"
+	updated, err := update(user.Username, email)
+	if err != nil {
+		t.Fatalf("更新用户信息失败: %v", err)
+	}
+	if updated.Username != user.Username || updated.Email != email {
+		t.Fatalf("更新后的用户信息错误: %+v", updated)
+	}
+
+	// 更新资料不会重新哈希已保存的密码
+	if err := login(c, "", email, "password"); err != nil {
+		t.Fatalf("更新资料后用原密码登录失败: %v", err)
+	}
+
+	if _, err := c.UpdateUserInfo(ctx, &auth.UpdateUserInfoReq{UserId: "0", Email: uniqueName("x") + "@example.com"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("用户不存在返回%v，期望%v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestUpdateAndResetPassword(t *testing.T) {
+	db := testdb.Open(t)
+	c := New(db)
+	ctx := context.Background()
+	user := testdb.CreateUser(t, db)
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	if _, err := c.UpdateUserPassword(ctx, &auth.UpdateUserPasswordReq{UserId: userID, OldPassword: "wrong-password", NewPassword: "changed-password"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("旧密码错误返回%v，期望%v", status.Code(err), codes.InvalidArgument)
+	}
+	if _, err := c.UpdateUserPassword(ctx, &auth.UpdateUserPasswordReq{UserId: userID, OldPassword: "password", NewPassword: "short"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("新密码过短返回%v，期望%v", status.Code(err), codes.InvalidArgument)
+	}
+	if _, err := c.UpdateUserPassword(ctx, &auth.UpdateUserPasswordReq{UserId: userID, OldPassword: "password", NewPassword: "changed-password"}); err != nil {
+		t.Fatalf("修改密码失败: %v", err)
+	}
+	if err := login(c, user.Username, "", "changed-password"); err != nil {
+		t.Fatalf("修改密码后登录失败: %v", err)
+	}
+	if err := login(c, user.Username, "", "password"); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("修改密码后旧密码登录返回%v，期望%v", status.Code(err), codes.Unauthenticated)
+	}
+
+	// 重置密码不校验旧密码
+	if _, err := c.ResetUserPassword(ctx, &auth.ResetUserPasswordReq{UserId: userID, NewPassword: "reset-password"}); err != nil {
+		t.Fatalf("重置密码失败: %v", err)
+	}
+	if err := login(c, user.Username, "", "reset-password"); err != nil {
+		t.Fatalf("重置密码后登录失败: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/aiprovider"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/metering"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AIRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAIRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AIRequestLogic {
+	return &AIRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AI请求相关服务
+func (l *AIRequestLogic) AIRequest(in *super.AIRequestReq) (*super.AIRequestResp, error) {
+	// 1. 校验参数，调用模型前检查剩余次数，避免无额度时浪费模型调用
+	call, err := prepareAIRequest(l.ctx, l.svcCtx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 调用模型，无论成功与否都记录调用流水
+	result, err := l.svcCtx.AIProvider.Complete(l.ctx, call.req)
+	if err != nil {
+		l.Error("调用AI模型失败: ", err)
+		call.record(l.ctx, l.svcCtx, nil, "", model.AIUsageStatusFailed)
+		return nil, errorx.Internal("AI服务暂时不可用，请稍后重试")
+	}
+	call.record(l.ctx, l.svcCtx, result, result.Content, model.AIUsageStatusSuccess)
+
+	// 3. 模型调用成功后扣减使用次数（UpdateAIUsage会再次校验上限）
+	// 此时客户端可能已断开或即将超时，扣减仍需完成，否则用户可以免费获得结果
+	updateResp, err := NewUpdateAIUsageLogic(context.WithoutCancel(l.ctx), l.svcCtx).UpdateAIUsage(&super.UpdateAIUsageReq{
+		UserId:    in.UserId,
+		UsageType: call.feature,
+	})
+	if err != nil {
+		l.Error("扣减AI使用次数失败: ", err)
+		return nil, err
+	}
+
+	return &super.AIRequestResp{
+		Response: result.Content,
+		Model:    result.Model,
+		Usage:    updateResp.Usage,
+	}, nil
+}
+
+// aiCall 一次AI模型调用
+type aiCall struct {
+	userID    uint
+	feature   string
+	tier      string
+	usage     *metering.Usage // 调用前的用量，用于记录调用时生效的套餐
+	req       *aiprovider.Request
+	startedAt time.Time
+}
+
+// prepareAIRequest 校验AI请求参数并检查剩余次数、token预算和模型等级，返回待执行的模型调用
+func prepareAIRequest(ctx context.Context, svcCtx *svc.ServiceContext, in *super.AIRequestReq) (*aiCall, error) {
+	prompt := strings.TrimSpace(in.Prompt)
+	if prompt == "" {
+		return nil, errorx.InvalidArgument("提示词不能为空")
+	}
+
+	usageType := in.UsageType
+	if usageType == "" {
+		usageType = model.AIFeatureChat
+	}
+
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+	usage, err := svcCtx.Metering.Check(ctx, uint(userID), usageType)
+	if err != nil {
+		return nil, meteringError(logx.WithContext(ctx), err)
+	}
+
+	// 模型等级和输出长度由用户生效中的套餐权益决定
+	tier := in.ModelTier
+	if tier == "" {
+		tier = usage.Features.HighestModelTier()
+	}
+	if !model.IsValidModelTier(tier) {
+		return nil, errorx.InvalidArgument("无效的模型等级，支持的等级：basic、advanced、premium")
+	}
+	if !usage.Features.AllowsModelTier(tier) {
+		return nil, errorx.New(403, "当前套餐不支持该模型等级")
+	}
+
+	return &aiCall{
+		userID:  uint(userID),
+		feature: usageType,
+		tier:    tier,
+		usage:   usage,
+		req: &aiprovider.Request{
+			Model: svcCtx.Config.AI.ModelFor(tier),
+			Messages: []aiprovider.Message{
+				{Role: "user", Content: prompt},
+			},
+			MaxTokens: usage.Features.MaxTokens,
+		},
+		startedAt: time.Now(),
+	}, nil
+}
+
+// record 记录调用流水，提供方未返回token用量时按内容估算；记录失败只打日志，不影响请求结果
+func (c *aiCall) record(ctx context.Context, svcCtx *svc.ServiceContext, result *aiprovider.Response, content, status string) {
+	entry := &model.AIUsageLedger{
+		UserID:    c.userID,
+		Feature:   c.feature,
+		Model:     c.req.Model,
+		ModelTier: c.tier,
+		LatencyMs: time.Since(c.startedAt).Milliseconds(),
+		Status:    status,
+		OrderID:   c.usage.OrderID,
+	}
+	if c.usage.PlanID != 0 {
+		entry.PlanID = &c.usage.PlanID
+	}
+	if result != nil && result.Model != "" {
+		entry.Model = result.Model
+	}
+
+	if status != model.AIUsageStatusFailed {
+		tokens := aiprovider.EstimateUsage(c.req, content)
+		if result != nil && result.Usage.Total() > 0 {
+			tokens = result.Usage
+		}
+		entry.PromptTokens = tokens.PromptTokens
+		entry.CompletionTokens = tokens.CompletionTokens
+	}
+
+	// 客户端断开时请求的context已取消，流水仍需写入
+	if err := svcCtx.Metering.Record(context.WithoutCancel(ctx), entry); err != nil {
+		logx.WithContext(ctx).Error("记录AI调用流水失败: ", err)
+	}
+}
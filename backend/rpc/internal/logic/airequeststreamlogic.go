@@ -0,0 +1,98 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AIRequestStreamLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAIRequestStreamLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AIRequestStreamLogic {
+	return &AIRequestStreamLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *AIRequestStreamLogic) AIRequestStream(in *super.AIRequestReq, stream super.Super_AIRequestStreamServer) error {
+	// 1. 校验参数并检查剩余次数
+	call, err := prepareAIRequest(l.ctx, l.svcCtx, in)
+	if err != nil {
+		return err
+	}
+
+	// 2. 计费：每个流只扣减一次。一旦模型开始输出，即使客户端中途断开也要扣减，
+	// 因此使用不随流取消的context
+	var (
+		chargeOnce sync.Once
+		usage      *super.AIUsageData
+		chargeErr  error
+		produced   bool
+	)
+	charge := func() {
+		chargeOnce.Do(func() {
+			resp, err := NewUpdateAIUsageLogic(context.WithoutCancel(l.ctx), l.svcCtx).UpdateAIUsage(&super.UpdateAIUsageReq{
+				UserId:    in.UserId,
+				UsageType: call.feature,
+			})
+			if err != nil {
+				l.Error("扣减AI使用次数失败: ", err)
+				chargeErr = err
+				return
+			}
+			usage = resp.Usage
+		})
+	}
+	defer func() {
+		if produced {
+			charge()
+		}
+	}()
+
+	// 3. 流式调用模型，逐段转发给客户端，并记录调用流水
+	var content strings.Builder
+	result, err := l.svcCtx.AIProvider.Stream(l.ctx, call.req, func(delta string) error {
+		produced = true
+		content.WriteString(delta)
+		return stream.Send(&super.AIStreamChunk{Delta: delta})
+	})
+	if err != nil {
+		if l.ctx.Err() != nil {
+			l.Info("客户端已断开AI流式请求")
+			// 已输出的部分按估算的token数记录
+			if produced {
+				call.record(l.ctx, l.svcCtx, nil, content.String(), model.AIUsageStatusCancelled)
+			}
+			return l.ctx.Err()
+		}
+		l.Error("调用AI模型失败: ", err)
+		call.record(l.ctx, l.svcCtx, nil, content.String(), model.AIUsageStatusFailed)
+		return errorx.Internal("AI服务暂时不可用，请稍后重试")
+	}
+	call.record(l.ctx, l.svcCtx, result, result.Content, model.AIUsageStatusSuccess)
+
+	// 4. 输出完成后扣减次数，并在最后一个分片中返回最新使用量
+	charge()
+	if chargeErr != nil {
+		return chargeErr
+	}
+
+	return stream.Send(&super.AIStreamChunk{
+		Done:  true,
+		Model: result.Model,
+		Usage: usage,
+	})
+}
@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/userdeletion"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAccountDeletionLogic {
+	return &CancelAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CancelAccountDeletion 用户在冷静期内撤销注销申请
+func (l *CancelAccountDeletionLogic) CancelAccountDeletion(in *super.CancelAccountDeletionReq) (*super.CancelAccountDeletionResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	err = l.svcCtx.UserDeletion.Cancel(l.ctx, uint(userID), time.Now())
+	if errors.Is(err, userdeletion.ErrNotRequested) {
+		return nil, errorx.NotFound(err.Error())
+	}
+	if err != nil {
+		l.Error("撤销注销申请失败: ", err)
+		return nil, errorx.Internal("撤销注销申请失败")
+	}
+
+	l.Infof("用户撤销注销申请: user_id=%d", userID)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditDeletionCancelled,
+		UserID:  uint(userID),
+		ActorID: uint(userID),
+		IP:      in.ClientIp,
+	})
+
+	return &super.CancelAccountDeletionResp{}, nil
+}
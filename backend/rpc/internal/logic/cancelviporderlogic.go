@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/orderstate"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type CancelVipOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelVipOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelVipOrderLogic {
+	return &CancelVipOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CancelVipOrderLogic) CancelVipOrder(in *super.CancelVipOrderReq) (*super.CancelVipOrderResp, error) {
+	// 1. 查找订单，只能取消自己的订单
+	var order model.VipOrder
+	err := l.svcCtx.DB.WithContext(l.ctx).Preload("Plan").Preload("CreditPack").
+		Where("order_no = ? AND user_id = ?", in.OrderNo, in.UserId).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("订单不存在")
+		}
+		l.Error("查找订单失败: ", err)
+		return nil, errorx.Internal("取消订单失败")
+	}
+
+	// 2. 变更订单状态
+	err = orderstate.Transition(l.svcCtx.DB.WithContext(l.ctx), &order, model.VipOrderStatusCancelled, nil)
+	switch {
+	case errors.Is(err, orderstate.ErrInvalidTransition):
+		return nil, errorx.InvalidArgument("只能取消待支付的订单")
+	case errors.Is(err, orderstate.ErrConflict):
+		return nil, errorx.New(409, err.Error())
+	case err != nil:
+		l.Error("取消订单失败: ", err)
+		return nil, errorx.Internal("取消订单失败")
+	}
+
+	return &super.CancelVipOrderResp{
+		Order: toVipOrder(&order),
+	}, nil
+}
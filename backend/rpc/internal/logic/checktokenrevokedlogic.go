@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckTokenRevokedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckTokenRevokedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckTokenRevokedLogic {
+	return &CheckTokenRevokedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CheckTokenRevokedLogic) CheckTokenRevoked(in *super.CheckTokenRevokedReq) (*super.CheckTokenRevokedResp, error) {
+	if in.TokenId == "" {
+		return nil, errorx.InvalidArgument("Token ID不能为空")
+	}
+
+	var count int64
+	if err := l.svcCtx.DB.WithContext(l.ctx).Model(&model.RevokedToken{}).
+		Where("token_id = ?", in.TokenId).
+		Count(&count).Error; err != nil {
+		l.Error("查询Token黑名单失败: ", err)
+		return nil, errorx.Internal("查询Token状态失败")
+	}
+
+	return &super.CheckTokenRevokedResp{
+		Revoked: count > 0,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCreditPackLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateCreditPackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCreditPackLogic {
+	return &CreateCreditPackLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateCreditPackLogic) CreateCreditPack(in *super.CreateCreditPackReq) (*super.CreateCreditPackResp, error) {
+	// 1. 校验加油包参数
+	if in.Name == "" {
+		return nil, errorx.InvalidArgument("加油包名称不能为空")
+	}
+	if in.Credits <= 0 {
+		return nil, errorx.InvalidArgument("加油包次数必须大于0")
+	}
+	if in.ValidDays <= 0 {
+		return nil, errorx.InvalidArgument("加油包有效期必须大于0")
+	}
+	if in.Price < 0 {
+		return nil, errorx.InvalidArgument("加油包价格不能为负数")
+	}
+
+	// 2. 保存到数据库
+	pack := model.CreditPack{
+		Name:        in.Name,
+		Description: in.Description,
+		Price:       float64(in.Price),
+		Credits:     int(in.Credits),
+		ValidDays:   int(in.ValidDays),
+	}
+	if err := l.svcCtx.DB.WithContext(l.ctx).Create(&pack).Error; err != nil {
+		l.Error("创建加油包失败: ", err)
+		return nil, errorx.Internal("创建加油包失败，请稍后重试")
+	}
+
+	return &super.CreateCreditPackResp{
+		Pack: toCreditPack(&pack),
+	}, nil
+}
@@ -2,7 +2,8 @@ package logic
 
 import (
 	"context"
-	"time"
+	"errors"
+	"strconv"
 
 	"backend/model"
 	"backend/rpc/internal/errorx"
@@ -10,8 +11,12 @@ import (
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
+// maxIdempotencyKeyLen 幂等键最大长度
+const maxIdempotencyKeyLen = 64
+
 type CreateVipOrderLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -28,6 +33,10 @@ func NewCreateVipOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cr
 
 // 用户相关服务
 func (l *CreateVipOrderLogic) CreateVipOrder(in *super.CreateVipOrderReq) (*super.CreateVipOrderResp, error) {
+	if len(in.IdempotencyKey) > maxIdempotencyKeyLen {
+		return nil, errorx.InvalidArgument("Idempotency-Key长度不能超过64")
+	}
+
 	// 验证用户是否存在
 	var user model.User
 	userResult := l.svcCtx.DB.First(&user, in.UserId)
@@ -36,43 +45,128 @@ func (l *CreateVipOrderLogic) CreateVipOrder(in *super.CreateVipOrderReq) (*supe
 		return nil, errorx.NotFound("用户不存在")
 	}
 
-	// 验证套餐是否存在
-	var plan model.VipPlan
-	planResult := l.svcCtx.DB.First(&plan, in.PlanId)
-	if planResult.Error != nil {
-		l.Error("查找VIP套餐失败: ", planResult.Error)
-		return nil, errorx.NotFound("VIP套餐不存在")
+	// 携带幂等键的重试请求直接返回首次创建的订单
+	if in.IdempotencyKey != "" {
+		existing, err := l.findByIdempotencyKey(user.ID, in.IdempotencyKey)
+		if err != nil {
+			l.Error("查找订单失败: ", err)
+			return nil, errorx.Internal("创建订单失败")
+		}
+		if existing != nil {
+			return l.idempotentResp(existing, in)
+		}
 	}
 
-	// 生成订单号（简化版，使用时间戳+随机数）
-	orderNo := "ORD" + time.Now().Format("20060102150405") + in.UserId
-
-	// 创建订单
+	// 创建订单，订单号由雪花算法生成，金额取所购商品的价格
 	order := model.VipOrder{
-		UserID:    user.ID,
-		PlanID:    plan.ID,
-		OrderNo:   orderNo,
-		Amount:    plan.Price,
-		Status:    "pending", // 初始状态为待支付
-		PayMethod: "",
+		UserID:      &user.ID,
+		ProductType: productType(in),
+		OrderNo:     l.svcCtx.OrderNo.Next(),
+		Status:      model.VipOrderStatusPending, // 初始状态为待支付
+		PayMethod:   "",
+	}
+	switch order.ProductType {
+	case model.OrderProductVipPlan:
+		// 验证套餐是否存在
+		planResult := l.svcCtx.DB.First(&order.Plan, in.PlanId)
+		if planResult.Error != nil {
+			l.Error("查找VIP套餐失败: ", planResult.Error)
+			return nil, errorx.NotFound("VIP套餐不存在")
+		}
+		order.PlanID = &order.Plan.ID
+		order.Amount = order.Plan.Price
+	case model.OrderProductCreditPack:
+		// 验证加油包是否存在
+		packResult := l.svcCtx.DB.First(&order.CreditPack, in.CreditPackId)
+		if packResult.Error != nil {
+			l.Error("查找加油包失败: ", packResult.Error)
+			return nil, errorx.NotFound("加油包不存在")
+		}
+		order.CreditPackID = &order.CreditPack.ID
+		order.Amount = order.CreditPack.Price
+	default:
+		return nil, errorx.InvalidArgument("无效的商品类型，支持的类型：vip_plan、credit_pack")
+	}
+	if in.IdempotencyKey != "" {
+		order.IdempotencyKey = &in.IdempotencyKey
 	}
 
-	createResult := l.svcCtx.DB.Create(&order)
+	createResult := l.svcCtx.DB.Omit("Plan", "CreditPack").Create(&order)
 	if createResult.Error != nil {
+		// 并发的重试请求可能已经用同一幂等键创建了订单，唯一索引冲突后返回该订单
+		if in.IdempotencyKey != "" {
+			if existing, err := l.findByIdempotencyKey(user.ID, in.IdempotencyKey); err == nil && existing != nil {
+				return l.idempotentResp(existing, in)
+			}
+		}
 		l.Error("创建订单失败: ", createResult.Error)
 		return nil, errorx.Internal("创建订单失败")
 	}
 
 	// 构建响应
 	return &super.CreateVipOrderResp{
-		Order: &super.VipOrder{
-			Id:        string(rune(order.ID)),
-			UserId:    in.UserId,
-			PlanId:    in.PlanId,
-			PlanName:  plan.Name,
-			Amount:    float32(order.Amount),
-			Status:    order.Status,
-			CreatedAt: order.CreatedAt.Format("2006-01-02 15:04:05"),
-		},
+		Order: toVipOrder(&order),
 	}, nil
 }
+
+// productType 请求购买的商品类型，未指定时为VIP套餐
+func productType(in *super.CreateVipOrderReq) string {
+	if in.ProductType == "" {
+		return model.OrderProductVipPlan
+	}
+	return in.ProductType
+}
+
+// findByIdempotencyKey 根据幂等键查找订单，不存在时返回nil
+func (l *CreateVipOrderLogic) findByIdempotencyKey(userID uint, key string) (*model.VipOrder, error) {
+	var order model.VipOrder
+	err := l.svcCtx.DB.WithContext(l.ctx).Preload("Plan").Preload("CreditPack").
+		Where("user_id = ? AND idempotency_key = ?", userID, key).
+		First(&order).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// idempotentResp 返回幂等键对应的已有订单，幂等键不能用于不同的商品
+func (l *CreateVipOrderLogic) idempotentResp(order *model.VipOrder, in *super.CreateVipOrderReq) (*super.CreateVipOrderResp, error) {
+	productID := in.PlanId
+	orderProductID := formatOptionalID(order.PlanID)
+	if order.ProductType == model.OrderProductCreditPack {
+		productID = in.CreditPackId
+		orderProductID = formatOptionalID(order.CreditPackID)
+	}
+	if order.ProductType != productType(in) || orderProductID != productID {
+		return nil, errorx.New(409, "Idempotency-Key已用于其他订单")
+	}
+
+	return &super.CreateVipOrderResp{
+		Order: toVipOrder(order),
+	}, nil
+}
+
+// toVipOrder 将订单转换为RPC结构，需预加载Plan和CreditPack
+func toVipOrder(order *model.VipOrder) *super.VipOrder {
+	paidAt := ""
+	if order.PaidAt != nil {
+		paidAt = order.PaidAt.Format("2006-01-02 15:04:05")
+	}
+
+	return &super.VipOrder{
+		Id:           strconv.FormatUint(uint64(order.ID), 10),
+		OrderNo:      order.OrderNo,
+		UserId:       formatOptionalID(order.UserID),
+		PlanId:       formatOptionalID(order.PlanID),
+		PlanName:     order.ProductName(),
+		Amount:       float32(order.Amount),
+		Status:       order.Status,
+		CreatedAt:    order.CreatedAt.Format("2006-01-02 15:04:05"),
+		PaidAt:       paidAt,
+		ProductType:  order.ProductType,
+		CreditPackId: formatOptionalID(order.CreditPackID),
+	}
+}
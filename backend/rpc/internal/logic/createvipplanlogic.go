@@ -27,31 +27,84 @@ func NewCreateVipPlanLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cre
 }
 
 func (l *CreateVipPlanLogic) CreateVipPlan(in *super.CreateVipPlanReq) (*super.CreateVipPlanResp, error) {
-	// 1. 创建VIP套餐
+	// 1. 校验套餐参数和AI权益
+	if in.Name == "" {
+		return nil, errorx.InvalidArgument("套餐名称不能为空")
+	}
+	if in.DurationDays <= 0 {
+		return nil, errorx.InvalidArgument("套餐有效期必须大于0")
+	}
+	if in.Price < 0 {
+		return nil, errorx.InvalidArgument("套餐价格不能为负数")
+	}
+	features := fromPlanFeatures(in.Features)
+	if err := features.Validate(); err != nil {
+		return nil, errorx.InvalidArgument("套餐权益无效: " + err.Error())
+	}
+
+	// 2. 创建VIP套餐
 	plan := model.VipPlan{
-		Name:     in.Name,
-		Features: in.Description,
-		Price:    float64(in.Price),
-		Duration: int(in.DurationDays),
+		Name:        in.Name,
+		Description: in.Description,
+		Price:       float64(in.Price),
+		Duration:    int(in.DurationDays),
+		Features:    features,
 	}
 
-	// 2. 保存到数据库
+	// 3. 保存到数据库
 	err := l.svcCtx.DB.Create(&plan).Error
 	if err != nil {
 		l.Error("创建VIP套餐失败: ", err)
 		return nil, errorx.Internal("创建VIP套餐失败，请稍后重试")
 	}
 
-	// 3. 构建响应
+	// 4. 构建响应
 	return &super.CreateVipPlanResp{
-		Plan: &super.VipPlan{
-			Id:           strconv.Itoa(int(plan.ID)),
-			Name:         plan.Name,
-			Description:  plan.Features,
-			Price:        float32(plan.Price),
-			DurationDays: int32(plan.Duration),
-			CreatedAt:    plan.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:    plan.UpdatedAt.Format("2006-01-02 15:04:05"),
-		},
+		Plan: toVipPlan(&plan),
 	}, nil
 }
+
+// toVipPlan 将套餐转换为RPC结构
+func toVipPlan(plan *model.VipPlan) *super.VipPlan {
+	quotas := make(map[string]int32, len(plan.Features.Quotas))
+	for feature, quota := range plan.Features.Quotas {
+		quotas[feature] = int32(quota)
+	}
+
+	return &super.VipPlan{
+		Id:           strconv.FormatUint(uint64(plan.ID), 10),
+		Name:         plan.Name,
+		Description:  plan.Description,
+		Price:        float32(plan.Price),
+		DurationDays: int32(plan.Duration),
+		CreatedAt:    plan.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    plan.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Features: &super.PlanFeatures{
+			Quotas:      quotas,
+			ModelTiers:  plan.Features.ModelTiers,
+			MaxTokens:   int32(plan.Features.MaxTokens),
+			TokenBudget: int32(plan.Features.TokenBudget),
+			ResetPolicy: plan.Features.EffectiveResetPolicy(),
+		},
+	}
+}
+
+// fromPlanFeatures 将RPC结构的套餐权益转换为模型
+func fromPlanFeatures(in *super.PlanFeatures) model.PlanFeatures {
+	if in == nil {
+		return model.PlanFeatures{}
+	}
+
+	quotas := make(map[string]int, len(in.Quotas))
+	for feature, quota := range in.Quotas {
+		quotas[feature] = int(quota)
+	}
+
+	return model.PlanFeatures{
+		Quotas:      quotas,
+		ModelTiers:  in.ModelTiers,
+		MaxTokens:   int(in.MaxTokens),
+		TokenBudget: int(in.TokenBudget),
+		ResetPolicy: in.ResetPolicy,
+	}
+}
@@ -2,13 +2,22 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
 
+	"backend/model"
+	"backend/rpc/internal/audit"
 	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/outbox"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/auth"
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type DeleteUserLogic struct {
@@ -25,22 +34,76 @@ func NewDeleteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delete
 	}
 }
 
+// DeleteUser 停用用户：软删除本地数据并阻止登录，保留期内可恢复，到期后由后台任务彻底清除并删除认证服务中的用户
+// 只存在于认证服务的用户直接删除，删除操作与本地记录在同一事务中写入，失败时由后台任务重试
 func (l *DeleteUserLogic) DeleteUser(in *super.DeleteUserReq) (*super.DeleteUserResp, error) {
 	// 检查AuthClient是否初始化
 	if l.svcCtx.AuthClient == nil {
 		l.Error("AuthClient未初始化")
 		return nil, errorx.Internal("服务器内部错误")
 	}
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+	operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+	now := time.Now()
 
-	// 调用外部AuthService的DeleteUser方法
-	_, err := l.svcCtx.AuthClient.DeleteUser(l.ctx, &auth.DeleteUserReq{
-		UserId: in.UserId,
+	// 1. 本地没有该用户时确认认证服务中存在，并直接删除
+	var user model.User
+	err = l.svcCtx.DB.WithContext(l.ctx).Select("id").First(&user, userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return l.deleteRemoteOnly(in.UserId, uint(userID), now)
+	}
+	if err != nil {
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("删除用户失败，请稍后重试")
+	}
+
+	// 2. 停用用户并记录注销
+	var deletion *model.UserDeletion
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		deletion, err = l.svcCtx.UserDeletion.Deactivate(tx, user.ID, model.UserDeletionAdmin, uint(operatorID), now)
+		return err
 	})
 	if err != nil {
-		l.Error("调用AuthService删除用户失败: ", err)
+		l.Error("删除本地用户数据失败: ", err)
 		return nil, errorx.Internal("删除用户失败，请稍后重试")
 	}
 
-	// 构建响应
+	// 3. 记录审计日志
+	purgeAt := deletion.PurgeAt.Format("2006-01-02 15:04:05")
+	l.Infof("用户已删除: user_id=%d purge_at=%s operator_id=%s", user.ID, purgeAt, in.OperatorId)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditUserDeleted,
+		UserID:  user.ID,
+		ActorID: uint(operatorID),
+		Detail: map[string]any{
+			"purge_at": purgeAt,
+		},
+	})
+
+	return &super.DeleteUserResp{PurgeAt: purgeAt}, nil
+}
+
+// deleteRemoteOnly 删除只存在于认证服务的用户，本地没有可恢复的数据
+func (l *DeleteUserLogic) deleteRemoteOnly(id string, userID uint, now time.Time) (*super.DeleteUserResp, error) {
+	if _, err := l.svcCtx.AuthClient.GetUser(l.ctx, &auth.GetUserReq{UserId: id}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("调用AuthService获取用户失败: ", err)
+		return nil, errorx.Internal("删除用户失败，请稍后重试")
+	}
+
+	if _, err := outbox.Enqueue(l.svcCtx.DB.WithContext(l.ctx), model.OutboxAuthDeleteUser, userID, now); err != nil {
+		l.Error("记录删除认证服务用户的操作失败: ", err)
+		return nil, errorx.Internal("删除用户失败，请稍后重试")
+	}
+	// 立即删除认证服务中的用户，失败不影响删除结果，由后台任务重试
+	if err := l.svcCtx.Outbox.DeliverNew(l.ctx, userID, now); err != nil {
+		l.Error("调用AuthService删除用户失败，稍后重试: ", err)
+	}
 	return &super.DeleteUserResp{}, nil
 }
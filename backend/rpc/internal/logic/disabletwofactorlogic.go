@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisableTwoFactorLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisableTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableTwoFactorLogic {
+	return &DisableTwoFactorLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DisableTwoFactorLogic) DisableTwoFactor(in *super.DisableTwoFactorReq) (*super.DisableTwoFactorResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 1. 验证码错误与登录共用失败计数，防止盗用登录会话后暴力猜测验证码
+	now := time.Now()
+	attempt := loginguard.Attempt{UserID: uint(userID), IP: in.ClientIp}
+	if err := checkLoginAttempt(l.ctx, l.svcCtx, attempt, now); err != nil {
+		return nil, err
+	}
+
+	// 2. 关闭前需要验证码或恢复码，防止登录会话被盗用时直接关闭两步验证
+	if _, err := l.svcCtx.TwoFactor.Verify(l.ctx, uint(userID), in.Code, now); err != nil {
+		switch {
+		case errors.Is(err, twofactor.ErrInvalidCode):
+			return nil, recordAttemptFailure(l.ctx, l.svcCtx, attempt, now, errorx.InvalidArgument(err.Error()))
+		case errors.Is(err, twofactor.ErrNotEnabled):
+			return nil, errorx.InvalidArgument(err.Error())
+		}
+		l.Error("校验两步验证码失败: ", err)
+		return nil, errorx.Internal("关闭两步验证失败")
+	}
+	if err := l.svcCtx.LoginGuard.Succeed(l.ctx, attempt); err != nil {
+		l.Error("清零登录失败次数失败: ", err)
+	}
+
+	// 3. 删除密钥和恢复码
+	if err := l.svcCtx.TwoFactor.Disable(l.ctx, uint(userID)); err != nil {
+		l.Error("关闭两步验证失败: ", err)
+		return nil, errorx.Internal("关闭两步验证失败")
+	}
+
+	l.Infof("用户已关闭两步验证: user_id=%d", userID)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditTwoFactorDisabled,
+		UserID:  uint(userID),
+		ActorID: uint(userID),
+		IP:      in.ClientIp,
+	})
+
+	return &super.DisableTwoFactorResp{}, nil
+}
@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/internal/totp"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDisableTwoFactorLocksAfterRepeatedFailures(t *testing.T) {
+	tables := []any{&model.UserTOTP{}, &model.RecoveryCode{}, &model.LoginThrottle{}, &model.AuditLog{}}
+	db := testdb.Open(t, tables...)
+	user := testdb.CreateUser(t, db, tables...)
+	ctx := context.Background()
+
+	const lockThreshold = 3
+	guard, err := loginguard.New(db, loginguard.Config{
+		BackoffAfter:   100,
+		IPBackoffAfter: 100,
+		BaseDelay:      1,
+		MaxDelay:       300,
+		LockThreshold:  lockThreshold,
+		LockDuration:   900,
+		FailureWindow:  3600,
+	})
+	if err != nil {
+		t.Fatalf("创建登录防护失败: %v", err)
+	}
+	twoFactor, err := twofactor.New(db, twofactor.Config{
+		Issuer:        "SuperAI",
+		EncryptionKey: "0123456789abcdef0123456789abcdef",
+		ChallengeTTL:  300,
+		MaxAttempts:   5,
+		RecoveryCodes: 10,
+	})
+	if err != nil {
+		t.Fatalf("创建两步验证服务失败: %v", err)
+	}
+
+	// 绑定并启用两步验证
+	secret, _, err := twoFactor.Setup(ctx, user.ID, user.Email)
+	if err != nil {
+		t.Fatalf("生成两步验证密钥失败: %v", err)
+	}
+	code, err := totp.Code(secret, time.Now())
+	if err != nil {
+		t.Fatalf("生成验证码失败: %v", err)
+	}
+	if _, err := twoFactor.Enable(ctx, user.ID, code, time.Now()); err != nil {
+		t.Fatalf("启用两步验证失败: %v", err)
+	}
+
+	l := NewDisableTwoFactorLogic(ctx, &svc.ServiceContext{DB: db, LoginGuard: guard, TwoFactor: twoFactor})
+	disable := func(code string) error {
+		_, err := l.DisableTwoFactor(&super.DisableTwoFactorReq{
+			UserId:   fmt.Sprint(user.ID),
+			Code:     code,
+			ClientIp: "203.0.113.10",
+		})
+		return err
+	}
+
+	// 未达到锁定阈值前返回验证码错误，达到阈值时锁定账号
+	for i := 1; i <= lockThreshold; i++ {
+		want := codes.InvalidArgument
+		if i == lockThreshold {
+			want = codes.ResourceExhausted
+		}
+		if got := status.Code(disable("wrong-recovery-code")); got != want {
+			t.Fatalf("第%d次错误返回%v，期望%v", i, got, want)
+		}
+	}
+
+	// 锁定后即使验证码正确也不能关闭两步验证
+	code, err = totp.Code(secret, time.Now().Add(30*time.Second))
+	if err != nil {
+		t.Fatalf("生成验证码失败: %v", err)
+	}
+	if got := status.Code(disable(code)); got != codes.ResourceExhausted {
+		t.Fatalf("锁定后返回%v，期望%v", got, codes.ResourceExhausted)
+	}
+	enabled, err := twoFactor.Enabled(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("查询两步验证状态失败: %v", err)
+	}
+	if !enabled {
+		t.Fatal("账号锁定后两步验证被关闭")
+	}
+}
@@ -0,0 +1,101 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/dataexport"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// dataExportChunkSize 下载导出文件时每个分片的大小
+const dataExportChunkSize = 64 << 10
+
+type DownloadDataExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDownloadDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadDataExportLogic {
+	return &DownloadDataExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DownloadDataExport 校验下载链接的签名和有效期，分片返回导出文件
+func (l *DownloadDataExportLogic) DownloadDataExport(in *super.DownloadDataExportReq, stream super.Super_DownloadDataExportServer) error {
+	exportID, err := strconv.ParseUint(in.ExportId, 10, 64)
+	if err != nil {
+		return errorx.New(403, dataexport.ErrInvalidLink.Error())
+	}
+
+	// 1. 校验下载链接并打开导出文件
+	export, file, err := l.svcCtx.DataExport.Open(l.ctx, uint(exportID), in.Expires, in.Signature, time.Now())
+	if errors.Is(err, dataexport.ErrInvalidLink) {
+		return errorx.New(403, err.Error())
+	}
+	if err != nil {
+		l.Error("打开个人数据导出文件失败: ", err)
+		return errorx.Internal("下载失败")
+	}
+	defer file.Close()
+
+	// 2. 链接签发后账号被删除或注销时不再允许下载
+	closed, err := l.svcCtx.UserDeletion.Closed(l.ctx, export.UserID)
+	if err != nil {
+		l.Error("查询账号注销状态失败: ", err)
+		return errorx.Internal("下载失败")
+	}
+	if closed {
+		return errorx.New(403, dataexport.ErrInvalidLink.Error())
+	}
+
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditDataDownloaded,
+		UserID:  export.UserID,
+		ActorID: export.UserID,
+		IP:      in.ClientIp,
+		Detail:  map[string]any{"export_id": export.ID},
+	})
+
+	// 3. 分片发送文件，第一个分片携带文件名和大小
+	first := &super.DataExportChunk{
+		FileName: fmt.Sprintf("data-export-%d-%s.zip", export.UserID, export.CreatedAt.Format("20060102")),
+		Size:     export.Size,
+	}
+	buf := make([]byte, dataExportChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 || first != nil {
+			chunk := first
+			if chunk == nil {
+				chunk = &super.DataExportChunk{}
+			}
+			chunk.Data = buf[:n]
+			if sendErr := stream.Send(chunk); sendErr != nil {
+				return sendErr
+			}
+			first = nil
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			l.Error("读取个人数据导出文件失败: ", err)
+			return errorx.Internal("下载失败")
+		}
+	}
+}
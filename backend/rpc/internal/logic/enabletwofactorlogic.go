@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EnableTwoFactorLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewEnableTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EnableTwoFactorLogic {
+	return &EnableTwoFactorLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *EnableTwoFactorLogic) EnableTwoFactor(in *super.EnableTwoFactorReq) (*super.EnableTwoFactorResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 校验验证码确认用户已正确绑定验证器，启用后生成恢复码
+	codes, err := l.svcCtx.TwoFactor.Enable(l.ctx, uint(userID), in.Code, time.Now())
+	if err != nil {
+		switch {
+		case errors.Is(err, twofactor.ErrInvalidCode), errors.Is(err, twofactor.ErrNotSetup):
+			return nil, errorx.InvalidArgument(err.Error())
+		case errors.Is(err, twofactor.ErrAlreadyEnabled):
+			return nil, errorx.AlreadyExists(err.Error())
+		}
+		l.Error("启用两步验证失败: ", err)
+		return nil, errorx.Internal("启用两步验证失败")
+	}
+
+	l.Infof("用户已启用两步验证: user_id=%d", userID)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditTwoFactorEnabled,
+		UserID:  uint(userID),
+		ActorID: uint(userID),
+	})
+
+	return &super.EnableTwoFactorResp{
+		RecoveryCodes: codes,
+	}, nil
+}
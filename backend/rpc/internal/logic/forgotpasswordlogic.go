@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/mailer"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type ForgotPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewForgotPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ForgotPasswordLogic {
+	return &ForgotPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ForgotPasswordLogic) ForgotPassword(in *super.ForgotPasswordReq) (*super.ForgotPasswordResp, error) {
+	if !l.svcCtx.Config.PasswordResetEnabled() {
+		return nil, errPasswordResetUnsupported
+	}
+	email := strings.TrimSpace(in.Email)
+	if email == "" {
+		return nil, errorx.InvalidArgument("邮箱不能为空")
+	}
+
+	// 邮箱不存在或发送失败时同样返回成功，避免通过该接口探测已注册的邮箱
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "username", "email").Where("email = ?", email).First(&user).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Error("查找用户失败: ", err)
+		}
+		return &super.ForgotPasswordResp{}, nil
+	}
+
+	token, err := l.svcCtx.UserTokens.Issue(l.ctx, user.ID, model.UserTokenPasswordReset, user.Email)
+	if err != nil {
+		l.Error("签发重置密码令牌失败: ", err)
+		return &super.ForgotPasswordResp{}, nil
+	}
+	link := l.svcCtx.Config.WebBaseURL + "/reset-password?token=" + url.QueryEscape(token)
+	if err := l.svcCtx.Mailer.Send(l.ctx, &mailer.Message{
+		To:      user.Email,
+		Subject: "重置密码",
+		Body: fmt.Sprintf("%s，您好：\n\n我们收到了重置您账号密码的请求，请在%s内打开以下链接设置新密码：\n%s\n\n如果这不是您的操作，请忽略本邮件，您的密码不会改变。\n",
+			user.Username, formatTTL(l.svcCtx.UserTokens.TTL(model.UserTokenPasswordReset)), link),
+	}); err != nil {
+		l.Error("发送重置密码邮件失败: ", err)
+	}
+
+	return &super.ForgotPasswordResp{}, nil
+}
@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountDeletionLogic {
+	return &GetAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetAccountDeletionLogic) GetAccountDeletion(in *super.GetAccountDeletionReq) (*super.AccountDeletionResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	deletion, err := l.svcCtx.UserDeletion.Pending(l.ctx, uint(userID))
+	if err != nil {
+		l.Error("查询注销申请失败: ", err)
+		return nil, errorx.Internal("查询注销申请失败")
+	}
+	return accountDeletionOf(deletion), nil
+}
+
+// accountDeletionOf 转换注销记录，deletion为nil表示没有待处理的注销申请
+func accountDeletionOf(deletion *model.UserDeletion) *super.AccountDeletionResp {
+	if deletion == nil {
+		return &super.AccountDeletionResp{}
+	}
+	return &super.AccountDeletionResp{
+		Scheduled:   true,
+		RequestedAt: deletion.CreatedAt.Format("2006-01-02 15:04:05"),
+		PurgeAt:     deletion.PurgeAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/rpc/internal/credits"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAICreditLedgerLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAICreditLedgerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAICreditLedgerLogic {
+	return &GetAICreditLedgerLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAICreditLedger 分页查询用户的AI额度流水
+func (l *GetAICreditLedgerLogic) GetAICreditLedger(in *super.GetAICreditLedgerReq) (*super.GetAICreditLedgerResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(in.PageSize)
+	if pageSize < 1 || pageSize > maxAIUsageHistoryPageSize {
+		pageSize = defaultAIUsageHistoryPageSize
+	}
+
+	entries, total, err := credits.Ledger(l.svcCtx.DB.WithContext(l.ctx), uint(userID), page, pageSize)
+	if err != nil {
+		l.Error("查询AI额度流水失败: ", err)
+		return nil, errorx.Internal("查询AI额度流水失败")
+	}
+
+	respEntries := make([]*super.AICreditLedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		respEntries = append(respEntries, &super.AICreditLedgerEntry{
+			Id:        strconv.FormatUint(uint64(e.ID), 10),
+			GrantId:   strconv.FormatUint(uint64(e.GrantID), 10),
+			Type:      e.Type,
+			Amount:    int32(e.Amount),
+			Feature:   e.Feature,
+			OrderId:   formatOptionalID(e.OrderID),
+			CreatedAt: e.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &super.GetAICreditLedgerResp{
+		Entries: respEntries,
+		Total:   int32(total),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/rpc/internal/credits"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAICreditsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAICreditsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAICreditsLogic {
+	return &GetAICreditsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAICredits 查询用户可用的AI额度
+func (l *GetAICreditsLogic) GetAICredits(in *super.GetAICreditsReq) (*super.GetAICreditsResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	grants, err := credits.Active(l.svcCtx.DB.WithContext(l.ctx), uint(userID), time.Now())
+	if err != nil {
+		l.Error("查询AI额度失败: ", err)
+		return nil, errorx.Internal("查询AI额度失败")
+	}
+
+	var balance int64
+	respGrants := make([]*super.AICreditGrant, 0, len(grants))
+	for _, g := range grants {
+		balance += int64(g.Remaining)
+		respGrants = append(respGrants, &super.AICreditGrant{
+			Id:        strconv.FormatUint(uint64(g.ID), 10),
+			PackId:    strconv.FormatUint(uint64(g.PackID), 10),
+			OrderId:   formatOptionalID(g.OrderID),
+			Credits:   int32(g.Credits),
+			Remaining: int32(g.Remaining),
+			ExpiresAt: g.ExpiresAt.Format("2006-01-02 15:04:05"),
+			CreatedAt: g.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &super.GetAICreditsResp{
+		Balance: balance,
+		Grants:  respGrants,
+	}, nil
+}
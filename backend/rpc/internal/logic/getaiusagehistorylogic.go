@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AI调用流水分页大小
+const (
+	defaultAIUsageHistoryPageSize = 20
+	maxAIUsageHistoryPageSize     = 100
+)
+
+type GetAIUsageHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAIUsageHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAIUsageHistoryLogic {
+	return &GetAIUsageHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAIUsageHistory 分页查询用户的AI调用流水
+func (l *GetAIUsageHistoryLogic) GetAIUsageHistory(in *super.GetAIUsageHistoryReq) (*super.GetAIUsageHistoryResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 1. 解析日期范围，结束日期包含当天
+	var from, to time.Time
+	if in.StartDate != "" {
+		if from, err = time.ParseInLocation("2006-01-02", in.StartDate, time.Local); err != nil {
+			return nil, errorx.InvalidArgument("开始日期格式应为2006-01-02")
+		}
+	}
+	if in.EndDate != "" {
+		if to, err = time.ParseInLocation("2006-01-02", in.EndDate, time.Local); err != nil {
+			return nil, errorx.InvalidArgument("结束日期格式应为2006-01-02")
+		}
+		to = to.AddDate(0, 0, 1)
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, errorx.InvalidArgument("开始日期不能晚于结束日期")
+	}
+
+	// 2. 分页参数
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(in.PageSize)
+	if pageSize < 1 || pageSize > maxAIUsageHistoryPageSize {
+		pageSize = defaultAIUsageHistoryPageSize
+	}
+
+	// 3. 查询流水
+	entries, total, err := l.svcCtx.Metering.History(l.ctx, uint(userID), from, to, page, pageSize)
+	if err != nil {
+		l.Error("查询AI调用流水失败: ", err)
+		return nil, errorx.Internal("查询AI调用流水失败")
+	}
+
+	respEntries := make([]*super.AIUsageLedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		respEntries = append(respEntries, &super.AIUsageLedgerEntry{
+			Id:               strconv.FormatUint(uint64(e.ID), 10),
+			Feature:          e.Feature,
+			Model:            e.Model,
+			ModelTier:        e.ModelTier,
+			PromptTokens:     int32(e.PromptTokens),
+			CompletionTokens: int32(e.CompletionTokens),
+			TotalTokens:      int32(e.TotalTokens),
+			LatencyMs:        e.LatencyMs,
+			Status:           e.Status,
+			PlanId:           formatOptionalID(e.PlanID),
+			OrderId:          formatOptionalID(e.OrderID),
+			CreatedAt:        e.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return &super.GetAIUsageHistoryResp{
+		Entries: respEntries,
+		Total:   int32(total),
+	}, nil
+}
+
+// formatOptionalID 格式化可为空的ID，为空时返回空字符串
+func formatOptionalID(id *uint) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*id), 10)
+}
@@ -2,14 +2,18 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"time"
 
 	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/metering"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
 type GetAIUsageLogic struct {
@@ -28,59 +32,58 @@ func NewGetAIUsageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAIU
 
 // AI使用量相关服务
 func (l *GetAIUsageLogic) GetAIUsage(in *super.GetAIUsageReq) (*super.GetAIUsageResp, error) {
-	// 解析用户ID
 	userID, err := strconv.ParseUint(in.UserId, 10, 64)
 	if err != nil {
-		return nil, err
+		return nil, errorx.NotFound("用户不存在")
 	}
 
-	// 获取用户信息
-	var user model.User
-	if err := l.svcCtx.DB.First(&user, userID).Error; err != nil {
-		return nil, err
+	usage, err := l.svcCtx.Metering.Get(l.ctx, uint(userID))
+	if err != nil {
+		return nil, meteringError(l.Logger, err)
 	}
 
-	// 检查是否需要重置使用次数（按月重置）
-	now := time.Now()
-	if user.AILastResetAt == nil || now.Month() != user.AILastResetAt.Month() || now.Year() != user.AILastResetAt.Year() {
-		// 重置使用次数
-		user.AIChatCount = 0
-		user.AIContentCount = 0
-		user.AIAnalysisCount = 0
-		user.AILastResetAt = &now
+	return &super.GetAIUsageResp{
+		Usage: toAIUsageData(usage),
+	}, nil
+}
 
-		// 保存更新
-		if err := l.svcCtx.DB.Save(&user).Error; err != nil {
-			return nil, err
-		}
-	}
+// toAIUsageData 将计量结果转换为RPC结构
+func toAIUsageData(usage *metering.Usage) *super.AIUsageData {
+	chat := usage.Meters[model.AIFeatureChat]
+	content := usage.Meters[model.AIFeatureContent]
+	analysis := usage.Meters[model.AIFeatureAnalysis]
 
-	// 确定使用限制
-	chatLimit := int32(10)
-	contentLimit := int32(5)
-	analysisLimit := int32(3)
-	if user.IsVip {
-		chatLimit = int32(100)
-		contentLimit = int32(50)
-		analysisLimit = int32(20)
+	return &super.AIUsageData{
+		IsVip:           usage.IsVip,
+		AiChatCount:     int32(chat.Used),
+		AiChatLimit:     int32(chat.Limit),
+		AiContentCount:  int32(content.Used),
+		AiContentLimit:  int32(content.Limit),
+		AiAnalysisCount: int32(analysis.Used),
+		AiAnalysisLimit: int32(analysis.Limit),
+		AiLastResetAt:   usage.PeriodStart.Format(time.RFC3339),
+		TokenUsed:       usage.TokensUsed,
+		TokenBudget:     int64(usage.Features.TokenBudget),
+		NextResetAt:     usage.ResetAt.Format(time.RFC3339),
+		ResetPolicy:     usage.ResetPolicy,
+		CreditBalance:   usage.Credits,
 	}
+}
 
-	// 构建响应
-	lastResetAt := ""
-	if user.AILastResetAt != nil {
-		lastResetAt = user.AILastResetAt.Format(time.RFC3339)
+// meteringError 将计量服务的错误转换为RPC错误
+func meteringError(logger logx.Logger, err error) error {
+	var quotaErr *metering.QuotaExceededError
+	switch {
+	case errors.As(err, &quotaErr):
+		return errorx.New(403, quotaErr.Error())
+	case errors.Is(err, metering.ErrTokenBudgetExceeded):
+		return errorx.New(403, err.Error())
+	case errors.Is(err, metering.ErrUnknownFeature):
+		return errorx.InvalidArgument(err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return errorx.NotFound("用户不存在")
+	default:
+		logger.Error("AI用量计量失败: ", err)
+		return errorx.Internal("服务器内部错误")
 	}
-
-	return &super.GetAIUsageResp{
-		Usage: &super.AIUsageData{
-			IsVip:            user.IsVip,
-			AiChatCount:      int32(user.AIChatCount),
-			AiChatLimit:      chatLimit,
-			AiContentCount:   int32(user.AIContentCount),
-			AiContentLimit:   contentLimit,
-			AiAnalysisCount:  int32(user.AIAnalysisCount),
-			AiAnalysisLimit:  analysisLimit,
-			AiLastResetAt:    lastResetAt,
-		},
-	}, nil
 }
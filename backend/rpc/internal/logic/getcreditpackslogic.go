@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCreditPacksLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCreditPacksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCreditPacksLogic {
+	return &GetCreditPacksLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// AI额度加油包相关服务
+func (l *GetCreditPacksLogic) GetCreditPacks(in *super.GetCreditPacksReq) (*super.GetCreditPacksResp, error) {
+	var packs []model.CreditPack
+	if err := l.svcCtx.DB.WithContext(l.ctx).Order("price").Find(&packs).Error; err != nil {
+		l.Error("获取加油包失败: ", err)
+		return nil, errorx.Internal("获取加油包失败")
+	}
+
+	respPacks := make([]*super.CreditPack, len(packs))
+	for i := range packs {
+		respPacks[i] = toCreditPack(&packs[i])
+	}
+
+	return &super.GetCreditPacksResp{
+		Packs: respPacks,
+	}, nil
+}
+
+// toCreditPack 将加油包转换为RPC结构
+func toCreditPack(pack *model.CreditPack) *super.CreditPack {
+	return &super.CreditPack{
+		Id:          strconv.FormatUint(uint64(pack.ID), 10),
+		Name:        pack.Name,
+		Description: pack.Description,
+		Price:       float32(pack.Price),
+		Credits:     int32(pack.Credits),
+		ValidDays:   int32(pack.ValidDays),
+		CreatedAt:   pack.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   pack.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/rpc/internal/dataexport"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDataExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDataExportLogic {
+	return &GetDataExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetDataExport 查询个人数据导出的状态，已生成时每次查询都签发新的下载链接
+func (l *GetDataExportLogic) GetDataExport(in *super.GetDataExportReq) (*super.DataExportResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+	exportID, err := strconv.ParseUint(in.ExportId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound(dataexport.ErrNotFound.Error())
+	}
+
+	export, err := l.svcCtx.DataExport.Get(l.ctx, uint(userID), uint(exportID))
+	if errors.Is(err, dataexport.ErrNotFound) {
+		return nil, errorx.NotFound(err.Error())
+	}
+	if err != nil {
+		l.Error("查询个人数据导出失败: ", err)
+		return nil, errorx.Internal("查询导出状态失败")
+	}
+
+	return dataExportOf(l.svcCtx.DataExport, export, time.Now()), nil
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDeletedUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDeletedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDeletedUsersLogic {
+	return &GetDeletedUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetDeletedUsers 分页列出已删除、尚未清除的用户
+func (l *GetDeletedUsersLogic) GetDeletedUsers(in *super.GetDeletedUsersReq) (*super.GetDeletedUsersResp, error) {
+	page := max(int(in.Page), 1)
+	pageSize := int(in.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	pageSize = min(pageSize, 100)
+
+	deleted, total, err := l.svcCtx.UserDeletion.Deleted(l.ctx, page, pageSize)
+	if err != nil {
+		l.Error("查询已删除用户失败: ", err)
+		return nil, errorx.Internal("查询已删除用户失败")
+	}
+
+	users := make([]*super.DeletedUser, 0, len(deleted))
+	for _, d := range deleted {
+		user := &super.DeletedUser{
+			Id:        strconv.FormatUint(uint64(d.User.ID), 10),
+			Username:  d.User.Username,
+			Email:     d.User.Email,
+			DeletedAt: d.User.DeletedAt.Time.Format("2006-01-02 15:04:05"),
+		}
+		if d.Deletion != nil {
+			user.Source = d.Deletion.Source
+			user.PurgeAt = d.Deletion.PurgeAt.Format("2006-01-02 15:04:05")
+		}
+		users = append(users, user)
+	}
+
+	return &super.GetDeletedUsersResp{
+		Users: users,
+		Total: int32(total),
+	}, nil
+}
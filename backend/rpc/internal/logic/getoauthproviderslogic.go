@@ -0,0 +1,39 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOAuthProvidersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetOAuthProvidersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOAuthProvidersLogic {
+	return &GetOAuthProvidersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 第三方登录相关服务
+func (l *GetOAuthProvidersLogic) GetOAuthProviders(in *super.GetOAuthProvidersReq) (*super.GetOAuthProvidersResp, error) {
+	providers := l.svcCtx.OIDC.Providers()
+	resp := &super.GetOAuthProvidersResp{
+		Providers: make([]*super.OAuthProvider, 0, len(providers)),
+	}
+	for _, p := range providers {
+		resp.Providers = append(resp.Providers, &super.OAuthProvider{
+			Name:        p.Name(),
+			DisplayName: p.DisplayName(),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTwoFactorStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetTwoFactorStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTwoFactorStatusLogic {
+	return &GetTwoFactorStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 两步验证相关服务
+func (l *GetTwoFactorStatusLogic) GetTwoFactorStatus(in *super.GetTwoFactorStatusReq) (*super.GetTwoFactorStatusResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	enabled, remaining, err := l.svcCtx.TwoFactor.Status(l.ctx, uint(userID))
+	if err != nil {
+		l.Error("查询两步验证状态失败: ", err)
+		return nil, errorx.Internal("查询两步验证状态失败")
+	}
+
+	return &super.GetTwoFactorStatusResp{
+		Enabled:                enabled,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
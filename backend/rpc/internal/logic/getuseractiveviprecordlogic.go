@@ -28,9 +28,12 @@ func NewGetUserActiveVipRecordLogic(ctx context.Context, svcCtx *svc.ServiceCont
 }
 
 func (l *GetUserActiveVipRecordLogic) GetUserActiveVipRecord(in *super.GetUserActiveVipRecordReq) (*super.GetUserActiveVipRecordResp, error) {
-	// 1. 查找用户当前激活的VIP记录
+	// 1. 查找用户当前生效的VIP记录，叠加购买的记录在前一段结束后才开始
 	var record model.VipRecord
-	result := l.svcCtx.DB.Where("user_id = ? AND is_active = ? AND end_at > ?", in.UserId, true, time.Now()).First(&record)
+	now := time.Now()
+	result := l.svcCtx.DB.Where("user_id = ? AND is_active = ? AND start_at <= ? AND end_at > ?", in.UserId, true, now, now).
+		Order("start_at DESC").
+		First(&record)
 	if result.Error != nil {
 		l.Error("查找用户活跃VIP记录失败: ", result.Error)
 		return nil, errorx.NotFound("用户没有活跃的VIP记录")
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 
+	"backend/rpc/internal/config"
 	"backend/rpc/internal/errorx"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/auth"
@@ -40,8 +41,19 @@ func (l *GetUserCountLogic) GetUserCount(in *super.GetUserCountReq) (*super.GetU
 		return nil, errorx.Internal("服务器内部错误")
 	}
 
+	// 已删除等待清除的用户在认证服务中仍存在，不计入用户数
+	count := authResp.Count
+	if l.svcCtx.Config.AuthBackend != config.AuthBackendLocal {
+		deactivated, err := l.svcCtx.UserDeletion.Deactivated(l.ctx)
+		if err != nil {
+			l.Error("查询已删除用户数失败: ", err)
+			return nil, errorx.Internal("服务器内部错误")
+		}
+		count = max(count-int32(deactivated), 0)
+	}
+
 	// 构建响应
 	return &super.GetUserCountResp{
-		Count: authResp.Count,
+		Count: count,
 	}, nil
 }
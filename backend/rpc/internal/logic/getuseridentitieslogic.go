@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserIdentitiesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserIdentitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserIdentitiesLogic {
+	return &GetUserIdentitiesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetUserIdentitiesLogic) GetUserIdentities(in *super.GetUserIdentitiesReq) (*super.GetUserIdentitiesResp, error) {
+	var records []model.UserIdentity
+	if err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("user_id = ?", in.UserId).
+		Order("id").
+		Find(&records).Error; err != nil {
+		l.Error("查询第三方账号失败: ", err)
+		return nil, errorx.Internal("查询第三方账号失败")
+	}
+
+	resp := &super.GetUserIdentitiesResp{
+		Identities: make([]*super.UserIdentity, 0, len(records)),
+	}
+	for i := range records {
+		resp.Identities = append(resp.Identities, userIdentityOf(&records[i]))
+	}
+	return resp, nil
+}
+
+// userIdentityOf 转换为响应中的第三方账号
+func userIdentityOf(record *model.UserIdentity) *super.UserIdentity {
+	identity := &super.UserIdentity{
+		Provider:  record.Provider,
+		Email:     record.Email,
+		CreatedAt: record.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if record.LastLoginAt != nil {
+		identity.LastLoginAt = record.LastLoginAt.Format("2006-01-02 15:04:05")
+	}
+	return identity
+}
@@ -2,7 +2,10 @@ package logic
 
 import (
 	"context"
+	"strconv"
 
+	"backend/model"
+	"backend/rpc/internal/config"
 	"backend/rpc/internal/errorx"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/auth"
@@ -43,10 +46,20 @@ func (l *GetUsersLogic) GetUsers(in *super.GetUsersReq) (*super.GetUsersResp, er
 		return nil, errorx.Internal("服务器内部错误")
 	}
 
+	// 已删除等待清除的用户在认证服务中仍存在，不在列表中显示
+	hidden, deactivated, err := l.deactivated(authResp.Users)
+	if err != nil {
+		l.Error("查询已删除用户失败: ", err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+
 	// 将外部服务的响应转换为主服务的响应格式
-	respUsers := make([]*super.User, len(authResp.Users))
-	for i, authUser := range authResp.Users {
-		respUsers[i] = &super.User{
+	respUsers := make([]*super.User, 0, len(authResp.Users))
+	for _, authUser := range authResp.Users {
+		if hidden[authUser.Id] {
+			continue
+		}
+		respUsers = append(respUsers, &super.User{
 			Id:           authUser.Id,
 			Username:     authUser.Username,
 			Email:        authUser.Email,
@@ -56,11 +69,39 @@ func (l *GetUsersLogic) GetUsers(in *super.GetUsersReq) (*super.GetUsersResp, er
 			IsVip:        authUser.IsVip,
 			VipExpiresAt: authUser.VipExpiresAt,
 			AutoRenew:    authUser.AutoRenew,
-		}
+		})
 	}
 
 	return &super.GetUsersResp{
 		Users: respUsers,
-		Total: authResp.Total,
+		Total: max(authResp.Total-int32(deactivated), 0),
 	}, nil
 }
+
+// deactivated 返回本页中已在本地删除的用户，以及认证服务中仍存在的已删除用户总数
+// 使用本地认证时用户表只有一份，认证服务不会返回已删除的用户
+func (l *GetUsersLogic) deactivated(users []*auth.User) (map[string]bool, int64, error) {
+	if l.svcCtx.Config.AuthBackend == config.AuthBackendLocal || len(users) == 0 {
+		return nil, 0, nil
+	}
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.Id)
+	}
+	var deleted []uint
+	if err := l.svcCtx.DB.WithContext(l.ctx).Unscoped().Model(&model.User{}).
+		Where("id IN ? AND deleted_at IS NOT NULL", ids).
+		Pluck("id", &deleted).Error; err != nil {
+		return nil, 0, err
+	}
+	count, err := l.svcCtx.UserDeletion.Deactivated(l.ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hidden := make(map[string]bool, len(deleted))
+	for _, id := range deleted {
+		hidden[strconv.FormatUint(uint64(id), 10)] = true
+	}
+	return hidden, count, nil
+}
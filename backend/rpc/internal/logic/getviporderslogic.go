@@ -47,7 +47,7 @@ func (l *GetVipOrdersLogic) GetVipOrders(in *super.GetVipOrdersReq) (*super.GetV
 	l.svcCtx.DB.Model(&model.VipOrder{}).Where("user_id = ?", in.UserId).Count(&total)
 
 	// 分页查询，预加载套餐信息
-	result := l.svcCtx.DB.Preload("Plan").Where("user_id = ?", in.UserId).Offset(int(offset)).Limit(int(pageSize)).Find(&orders)
+	result := l.svcCtx.DB.Preload("Plan").Preload("CreditPack").Where("user_id = ?", in.UserId).Offset(int(offset)).Limit(int(pageSize)).Find(&orders)
 	if result.Error != nil {
 		l.Error("获取订单列表失败: ", result.Error)
 		return nil, errorx.Internal("获取订单列表失败: " + result.Error.Error())
@@ -55,23 +55,8 @@ func (l *GetVipOrdersLogic) GetVipOrders(in *super.GetVipOrdersReq) (*super.GetV
 
 	// 构建响应
 	respOrders := make([]*super.VipOrder, len(orders))
-	for i, order := range orders {
-		paidAt := ""
-		// 这里可以根据订单状态设置paid_at
-		// if order.Status == "paid" {
-		//     paidAt = order.UpdatedAt.Format("2006-01-02 15:04:05")
-		// }
-
-		respOrders[i] = &super.VipOrder{
-			Id:        string(rune(order.ID)),
-			UserId:    in.UserId,
-			PlanId:    string(rune(order.PlanID)),
-			PlanName:  order.Plan.Name,
-			Amount:    float32(order.Amount),
-			Status:    order.Status,
-			CreatedAt: order.CreatedAt.Format("2006-01-02 15:04:05"),
-			PaidAt:    paidAt,
-		}
+	for i := range orders {
+		respOrders[i] = toVipOrder(&orders[i])
 	}
 
 	return &super.GetVipOrdersResp{
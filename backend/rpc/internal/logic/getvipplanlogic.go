@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"strconv"
 
 	"backend/model"
 	"backend/rpc/internal/errorx"
@@ -37,14 +36,6 @@ func (l *GetVipPlanLogic) GetVipPlan(in *super.GetVipPlanReq) (*super.GetVipPlan
 
 	// 2. 构建响应
 	return &super.GetVipPlanResp{
-		Plan: &super.VipPlan{
-			Id:           strconv.Itoa(int(plan.ID)),
-			Name:         plan.Name,
-			Description:  plan.Features,
-			Price:        float32(plan.Price),
-			DurationDays: int32(plan.Duration),
-			CreatedAt:    plan.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:    plan.UpdatedAt.Format("2006-01-02 15:04:05"),
-		},
+		Plan: toVipPlan(&plan),
 	}, nil
 }
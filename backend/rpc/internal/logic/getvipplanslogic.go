@@ -36,16 +36,8 @@ func (l *GetVipPlansLogic) GetVipPlans(in *super.GetVipPlansReq) (*super.GetVipP
 
 	// 构建响应
 	respPlans := make([]*super.VipPlan, len(plans))
-	for i, plan := range plans {
-		respPlans[i] = &super.VipPlan{
-			Id:           string(rune(plan.ID)),
-			Name:         plan.Name,
-			Description:  plan.Features,
-			Price:        float32(plan.Price),
-			DurationDays: int32(plan.Duration),
-			CreatedAt:    plan.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:    plan.UpdatedAt.Format("2006-01-02 15:04:05"),
-		}
+	for i := range plans {
+		respPlans[i] = toVipPlan(&plans[i])
 	}
 
 	return &super.GetVipPlansResp{
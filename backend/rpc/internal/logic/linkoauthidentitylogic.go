@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LinkOAuthIdentityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkOAuthIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkOAuthIdentityLogic {
+	return &LinkOAuthIdentityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *LinkOAuthIdentityLogic) LinkOAuthIdentity(in *super.LinkOAuthIdentityReq) (*super.LinkOAuthIdentityResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 1. 核销授权状态并换取第三方身份
+	identity, linkUserID, err := l.svcCtx.OIDC.Finish(l.ctx, in.Provider, in.Code, in.State, time.Now())
+	if err != nil {
+		return nil, oauthError(l.ctx, err)
+	}
+	// 授权必须由该用户发起绑定，防止把他人的授权绑定到自己账号
+	if linkUserID != uint(userID) {
+		return nil, errorx.Unauthenticated(oidc.ErrInvalidState.Error())
+	}
+
+	// 2. 绑定，已登录用户主动绑定时不要求提供方验证邮箱
+	record, err := linkIdentity(l.ctx, l.svcCtx, uint(userID), identity, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &super.LinkOAuthIdentityResp{
+		Identity: userIdentityOf(record),
+	}, nil
+}
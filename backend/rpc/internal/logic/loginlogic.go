@@ -2,13 +2,23 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"time"
 
+	"backend/model"
+	"backend/rpc/internal/audit"
 	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/loginguard"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/auth"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type LoginLogic struct {
@@ -32,7 +42,14 @@ func (l *LoginLogic) Login(in *super.LoginReq) (*super.LoginResp, error) {
 		return nil, errorx.New(500, "服务未初始化")
 	}
 
-	// 调用外部AuthService的Login方法
+	// 1. 校验密码前检查账号和来源IP是否处于退避或锁定中
+	attempt := l.attemptOf(in)
+	now := time.Now()
+	if err := checkLoginAttempt(l.ctx, l.svcCtx, attempt, now); err != nil {
+		return nil, err
+	}
+
+	// 2. 调用外部AuthService的Login方法
 	authResp, err := l.svcCtx.AuthClient.Login(l.ctx, &auth.LoginReq{
 		Username: in.Username,
 		Email:    in.Email,
@@ -40,22 +57,223 @@ func (l *LoginLogic) Login(in *super.LoginReq) (*super.LoginResp, error) {
 	})
 	if err != nil {
 		l.Error("调用AuthService登录失败: ", err)
-		return nil, errorx.New(401, "用户名或密码错误")
+		// 认证服务不可用不是密码错误，不计入失败次数
+		if authUnavailable(err) {
+			return nil, errorx.Internal("登录服务暂时不可用，请稍后重试")
+		}
+		return nil, recordLoginFailure(l.ctx, l.svcCtx, attempt, now, "用户名或密码错误")
+	}
+
+	// 3. 密码正确，进入两步验证或签发Token
+	return startSession(l.ctx, l.svcCtx, authResp.User, attempt, now)
+}
+
+// startSession 密码或第三方账号验证通过后：已启用两步验证时只签发挑战令牌，验证码通过后才签发Token，失败次数也在那时清零；
+// 否则清零失败次数并签发Token
+func startSession(ctx context.Context, svcCtx *svc.ServiceContext, authUser *auth.User, attempt loginguard.Attempt, now time.Time) (*super.LoginResp, error) {
+	logger := logx.WithContext(ctx)
+	userID, err := strconv.ParseUint(authUser.Id, 10, 64)
+	if err != nil {
+		logger.Error("AuthService返回的用户ID无效: ", authUser.Id)
+		return nil, errorx.Internal("登录失败")
+	}
+
+	if err := checkAccountOpen(ctx, svcCtx, uint(userID)); err != nil {
+		return nil, err
+	}
+
+	enabled, err := svcCtx.TwoFactor.Enabled(ctx, uint(userID))
+	if err != nil {
+		logger.Error("查询两步验证状态失败: ", err)
+		return nil, errorx.Internal("登录失败")
+	}
+	if enabled {
+		challenge, ttl, err := svcCtx.TwoFactor.Challenge(ctx, uint(userID), now)
+		if err != nil {
+			logger.Error("签发两步验证挑战失败: ", err)
+			return nil, errorx.Internal("登录失败")
+		}
+		return &super.LoginResp{
+			TwoFactorRequired:  true,
+			ChallengeToken:     challenge,
+			ChallengeExpiresIn: int64(ttl.Seconds()),
+		}, nil
+	}
+	if err := svcCtx.LoginGuard.Succeed(ctx, attempt); err != nil {
+		logger.Error("清零登录失败次数失败: ", err)
+	}
+	return completeLogin(ctx, svcCtx, authUser)
+}
+
+// completeLogin 身份验证全部通过后由主服务签发访问Token和刷新令牌，网关使用同一密钥校验并从中解析用户ID
+func completeLogin(ctx context.Context, svcCtx *svc.ServiceContext, authUser *auth.User) (*super.LoginResp, error) {
+	logger := logx.WithContext(ctx)
+	userID, err := strconv.ParseUint(authUser.Id, 10, 64)
+	if err != nil {
+		logger.Error("AuthService返回的用户ID无效: ", authUser.Id)
+		return nil, errorx.Internal("登录失败")
+	}
+	if err := checkAccountOpen(ctx, svcCtx, uint(userID)); err != nil {
+		return nil, err
+	}
+	familyID, err := utils.GenerateRandomToken(24)
+	if err != nil {
+		logger.Error("生成令牌家族ID失败: ", err)
+		return nil, errorx.Internal("登录失败")
+	}
+	// 角色保存在本地用户表中
+	role := model.RoleUser
+	var user model.User
+	if err := svcCtx.DB.WithContext(ctx).Select("id", "role").First(&user, userID).Error; err == nil && user.Role != "" {
+		role = user.Role
+	}
+	tokens, err := issueTokenPair(svcCtx.DB, uint(userID), authUser.Username, role, familyID, nil)
+	if err != nil {
+		logger.Error("生成Token失败: ", err)
+		return nil, errorx.Internal("登录失败")
 	}
 
 	// 将外部服务的响应转换为主服务的响应格式
 	return &super.LoginResp{
 		User: &super.User{
-			Id:           authResp.User.Id,
-			Username:     authResp.User.Username,
-			Email:        authResp.User.Email,
-			Avatar:       authResp.User.Avatar,
-			CreatedAt:    authResp.User.CreatedAt,
-			UpdatedAt:    authResp.User.UpdatedAt,
-			IsVip:        authResp.User.IsVip,
-			VipExpiresAt: authResp.User.VipExpiresAt,
-			AutoRenew:    authResp.User.AutoRenew,
+			Id:           authUser.Id,
+			Username:     authUser.Username,
+			Email:        authUser.Email,
+			Avatar:       authUser.Avatar,
+			CreatedAt:    authUser.CreatedAt,
+			UpdatedAt:    authUser.UpdatedAt,
+			IsVip:        authUser.IsVip,
+			VipExpiresAt: authUser.VipExpiresAt,
+			AutoRenew:    authUser.AutoRenew,
+			Role:         role,
+		},
+		Token:        tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresIn:    tokens.expiresIn,
+	}, nil
+}
+
+// checkAccountOpen 拒绝已删除或已注销的账号登录，这些账号在认证服务中可能仍存在
+func checkAccountOpen(ctx context.Context, svcCtx *svc.ServiceContext, userID uint) error {
+	closed, err := svcCtx.UserDeletion.Closed(ctx, userID)
+	if err != nil {
+		logx.WithContext(ctx).Error("查询账号注销状态失败: ", err)
+		return errorx.Internal("登录失败")
+	}
+	if closed {
+		return errorx.New(403, "账号已删除或已注销")
+	}
+	return nil
+}
+
+// attemptOf 本次登录尝试，登录名对应的本地用户存在时按用户ID计数
+func (l *LoginLogic) attemptOf(in *super.LoginReq) loginguard.Attempt {
+	attempt := loginguard.Attempt{Login: in.Username, IP: in.ClientIp}
+	query := l.svcCtx.DB.WithContext(l.ctx).Select("id")
+	if in.Username != "" {
+		query = query.Where("username = ?", in.Username)
+	} else {
+		attempt.Login = in.Email
+		query = query.Where("email = ?", in.Email)
+	}
+
+	var user model.User
+	if err := query.First(&user).Error; err == nil {
+		attempt.UserID = user.ID
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error("查找登录用户失败: ", err)
+	}
+	return attempt
+}
+
+// checkLoginAttempt 校验密码或验证码前检查账号和来源IP是否处于退避或锁定中
+func checkLoginAttempt(ctx context.Context, svcCtx *svc.ServiceContext, attempt loginguard.Attempt, now time.Time) error {
+	if err := svcCtx.LoginGuard.Check(ctx, attempt, now); err != nil {
+		var blocked *loginguard.BlockedError
+		if errors.As(err, &blocked) {
+			return errorx.New(429, blocked.Error())
+		}
+		logx.WithContext(ctx).Error("检查登录失败次数失败: ", err)
+		return errorx.Internal("登录失败")
+	}
+	return nil
+}
+
+// recordLoginFailure 记录一次密码或验证码错误，返回给客户端的错误为message；
+// 账号因此被锁定时写入审计日志并提示锁定时长
+func recordLoginFailure(ctx context.Context, svcCtx *svc.ServiceContext, attempt loginguard.Attempt, now time.Time, message string) error {
+	return recordAttemptFailure(ctx, svcCtx, attempt, now, errorx.New(401, message))
+}
+
+// recordAttemptFailure 记录一次失败尝试，未锁定账号时返回failed；
+// 账号因此被锁定时写入审计日志并提示锁定时长
+func recordAttemptFailure(ctx context.Context, svcCtx *svc.ServiceContext, attempt loginguard.Attempt, now time.Time, failed error) error {
+	logger := logx.WithContext(ctx)
+	failure, err := svcCtx.LoginGuard.Fail(ctx, attempt, now)
+	if err != nil {
+		logger.Error("记录登录失败次数失败: ", err)
+		return failed
+	}
+	if failure.LockedUntil == nil {
+		return failed
+	}
+
+	logger.Infof("账号登录失败次数过多已锁定: login=%s user_id=%d ip=%s failures=%d", attempt.Login, attempt.UserID, attempt.IP, failure.Failures)
+	audit.Record(ctx, svcCtx.DB, audit.Event{
+		Action: model.AuditAccountLocked,
+		UserID: attempt.UserID,
+		IP:     attempt.IP,
+		Detail: map[string]any{
+			"login":        attempt.Login,
+			"failures":     failure.Failures,
+			"locked_until": failure.LockedUntil.Format("2006-01-02 15:04:05"),
 		},
-		Token: authResp.Token,
+	})
+	blocked := &loginguard.BlockedError{Locked: true, RetryAfter: failure.LockedUntil.Sub(now)}
+	return errorx.New(429, blocked.Error())
+}
+
+// authUnavailable 认证服务调用是否因服务本身不可用而失败
+func authUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.ResourceExhausted, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+// tokenPair 访问Token和刷新令牌
+type tokenPair struct {
+	accessToken  string
+	refreshToken string
+	expiresIn    int64 // 访问Token有效期（秒）
+}
+
+// issueTokenPair 签发访问Token，并在令牌家族中保存新的刷新令牌
+func issueTokenPair(db *gorm.DB, userID uint, username, role, familyID string, parentID *uint) (*tokenPair, error) {
+	accessToken, err := utils.GenerateToken(userID, username, role)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Create(&model.RefreshToken{
+		UserID:    userID,
+		TokenHash: utils.HashToken(refreshToken),
+		FamilyID:  familyID,
+		ParentID:  parentID,
+		ExpiresAt: time.Now().Add(utils.RefreshTokenExpire()),
+	}).Error; err != nil {
+		return nil, err
+	}
+
+	return &tokenPair{
+		accessToken:  accessToken,
+		refreshToken: refreshToken,
+		expiresIn:    int64(utils.AccessTokenExpire().Seconds()),
 	}, nil
 }
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LogoutLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *LogoutLogic) Logout(in *super.LogoutReq) (*super.LogoutResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil || in.TokenId == "" {
+		return nil, errorx.InvalidArgument("参数错误")
+	}
+	db := l.svcCtx.DB.WithContext(l.ctx)
+
+	// 1. 将当前访问Token加入黑名单，直到其过期
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.RevokedToken{
+		TokenID:   in.TokenId,
+		UserID:    uint(userID),
+		ExpiresAt: time.Unix(in.TokenExpiresAt, 0),
+	}).Error; err != nil {
+		l.Error("写入Token黑名单失败: ", err)
+		return nil, errorx.Internal("登出失败")
+	}
+
+	// 2. 吊销刷新令牌所在的家族，只能吊销自己的令牌
+	if in.RefreshToken != "" {
+		var token model.RefreshToken
+		err := db.Where("token_hash = ?", utils.HashToken(in.RefreshToken)).First(&token).Error
+		switch {
+		case err == nil && token.UserID == uint(userID):
+			if err := revokeTokenFamily(db, token.FamilyID); err != nil {
+				l.Error("吊销令牌家族失败: ", err)
+				return nil, errorx.Internal("登出失败")
+			}
+		case err != nil && !errors.Is(err, gorm.ErrRecordNotFound):
+			l.Error("查找刷新令牌失败: ", err)
+			return nil, errorx.Internal("登出失败")
+		}
+	}
+
+	// 3. 顺便清理已过期的黑名单记录
+	if err := db.Where("expires_at < ?", time.Now()).Delete(&model.RevokedToken{}).Error; err != nil {
+		l.Error("清理过期Token黑名单失败: ", err)
+	}
+
+	return &super.LogoutResp{}, nil
+}
@@ -0,0 +1,267 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type OAuthLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOAuthLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OAuthLoginLogic {
+	return &OAuthLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *OAuthLoginLogic) OAuthLogin(in *super.OAuthLoginReq) (*super.LoginResp, error) {
+	// 检查AuthClient是否初始化
+	if l.svcCtx.AuthClient == nil {
+		l.Error("AuthClient未初始化")
+		return nil, errorx.New(500, "服务未初始化")
+	}
+
+	// 1. 核销授权状态并换取第三方身份
+	now := time.Now()
+	identity, linkUserID, err := l.svcCtx.OIDC.Finish(l.ctx, in.Provider, in.Code, in.State, now)
+	if err != nil {
+		return nil, oauthError(l.ctx, err)
+	}
+	// 绑定流程发起的授权不能用于登录
+	if linkUserID != 0 {
+		return nil, errorx.Unauthenticated(oidc.ErrInvalidState.Error())
+	}
+
+	// 2. 查找或创建对应的本地用户
+	userID, err := l.userOf(identity, in.ClientIp, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 获取用户信息，进入两步验证或签发Token
+	userResp, err := l.svcCtx.AuthClient.GetUser(l.ctx, &auth.GetUserReq{
+		UserId: strconv.FormatUint(uint64(userID), 10),
+	})
+	if err != nil {
+		l.Error("调用AuthService获取用户失败: ", err)
+		return nil, errorx.Internal("登录失败")
+	}
+	return startSession(l.ctx, l.svcCtx, userResp.User, loginguard.Attempt{UserID: userID, IP: in.ClientIp}, now)
+}
+
+// userOf 第三方身份对应的本地用户：已绑定时直接返回；未绑定时按提供方已验证的邮箱匹配已有用户并绑定，
+// 没有匹配的用户时注册新用户
+func (l *OAuthLoginLogic) userOf(identity *oidc.Identity, ip string, now time.Time) (uint, error) {
+	// 1. 已绑定
+	var linked model.UserIdentity
+	err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("provider = ? AND subject = ?", identity.Provider, identity.Subject).
+		First(&linked).Error
+	if err == nil {
+		if err := l.svcCtx.DB.WithContext(l.ctx).Model(&linked).Update("last_login_at", now).Error; err != nil {
+			l.Error("更新第三方账号登录时间失败: ", err)
+		}
+		return linked.UserID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error("查找第三方账号失败: ", err)
+		return 0, errorx.Internal("登录失败")
+	}
+
+	// 2. 未绑定时只信任提供方已验证的邮箱
+	if !identity.EmailVerified {
+		return 0, errorx.InvalidArgument("该第三方账号未提供已验证的邮箱，请使用密码登录后在个人资料中绑定")
+	}
+	var user model.User
+	err = l.svcCtx.DB.WithContext(l.ctx).Select("id", "email_verified_at").
+		Where("email = ?", identity.Email).
+		First(&user).Error
+	switch {
+	case err == nil:
+		// 本地邮箱未验证时该账号可能是他人用这个邮箱抢注的，不能自动绑定
+		if user.EmailVerifiedAt == nil {
+			return 0, errorx.AlreadyExists("该邮箱已注册但尚未验证，请使用密码登录后在个人资料中绑定")
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if user.ID, err = l.register(identity, now); err != nil {
+			return 0, err
+		}
+	default:
+		l.Error("按邮箱查找用户失败: ", err)
+		return 0, errorx.Internal("登录失败")
+	}
+
+	// 3. 绑定第三方账号
+	if _, err := linkIdentity(l.ctx, l.svcCtx, user.ID, identity, ip, &now); err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+// register 为第三方身份注册新用户，密码随机生成，用户之后可以通过忘记密码设置密码
+func (l *OAuthLoginLogic) register(identity *oidc.Identity, now time.Time) (uint, error) {
+	username, err := availableUsername(l.svcCtx.DB.WithContext(l.ctx), identity)
+	if err != nil {
+		l.Error("生成用户名失败: ", err)
+		return 0, errorx.Internal("注册失败，请稍后重试")
+	}
+	password, err := utils.GenerateRandomToken(24)
+	if err != nil {
+		l.Error("生成随机密码失败: ", err)
+		return 0, errorx.Internal("注册失败，请稍后重试")
+	}
+
+	authResp, err := l.svcCtx.AuthClient.Register(l.ctx, &auth.RegisterReq{
+		Username: username,
+		Email:    identity.Email,
+		Password: password,
+	})
+	if err != nil {
+		l.Error("调用AuthService注册失败: ", err)
+		return 0, errorx.Internal("注册失败，请稍后重试")
+	}
+	userID, err := strconv.ParseUint(authResp.User.Id, 10, 64)
+	if err != nil {
+		l.Error("AuthService返回的用户ID无效: ", authResp.User.Id)
+		return 0, errorx.Internal("注册失败，请稍后重试")
+	}
+
+	// 邮箱已由提供方验证，无需再发送验证邮件
+	if err := l.svcCtx.DB.WithContext(l.ctx).Model(&model.User{}).
+		Where("id = ?", userID).
+		Update("email_verified_at", now).Error; err != nil {
+		l.Error("标记邮箱已验证失败: ", err)
+	}
+	l.Infof("通过第三方登录注册用户: user_id=%d provider=%s", userID, identity.Provider)
+	return uint(userID), nil
+}
+
+var (
+	errIdentityTaken  = errors.New("该第三方账号已绑定其他用户")
+	errProviderLinked = errors.New("已绑定该登录方式的其他账号，请先解绑")
+)
+
+// linkIdentity 将第三方账号绑定到用户并写入审计日志，lastLoginAt不为空时表示通过该账号登录
+func linkIdentity(ctx context.Context, svcCtx *svc.ServiceContext, userID uint, identity *oidc.Identity, ip string, lastLoginAt *time.Time) (*model.UserIdentity, error) {
+	logger := logx.WithContext(ctx)
+	record := model.UserIdentity{
+		UserID:      userID,
+		Provider:    identity.Provider,
+		Subject:     identity.Subject,
+		Email:       identity.Email,
+		LastLoginAt: lastLoginAt,
+	}
+	err := svcCtx.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&model.UserIdentity{}).
+			Where("provider = ? AND subject = ?", identity.Provider, identity.Subject).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errIdentityTaken
+		}
+		if err := tx.Model(&model.UserIdentity{}).
+			Where("user_id = ? AND provider = ?", userID, identity.Provider).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return errProviderLinked
+		}
+		return tx.Create(&record).Error
+	})
+	if err != nil {
+		if errors.Is(err, errIdentityTaken) || errors.Is(err, errProviderLinked) {
+			return nil, errorx.AlreadyExists(err.Error())
+		}
+		logger.Error("绑定第三方账号失败: ", err)
+		return nil, errorx.Internal("绑定第三方账号失败")
+	}
+
+	logger.Infof("用户绑定第三方账号: user_id=%d provider=%s", userID, identity.Provider)
+	audit.Record(ctx, svcCtx.DB, audit.Event{
+		Action:  model.AuditIdentityLinked,
+		UserID:  userID,
+		ActorID: userID,
+		IP:      ip,
+		Detail: map[string]any{
+			"provider": identity.Provider,
+			"email":    identity.Email,
+		},
+	})
+	return &record, nil
+}
+
+// oauthError 将授权状态核销或换取身份的错误转换为RPC错误
+func oauthError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, oidc.ErrUnknownProvider):
+		return errorx.InvalidArgument(err.Error())
+	case errors.Is(err, oidc.ErrInvalidState):
+		return errorx.Unauthenticated(err.Error())
+	default:
+		logx.WithContext(ctx).Error("第三方身份验证失败: ", err)
+		return errorx.Unauthenticated("第三方登录失败，请重试")
+	}
+}
+
+var usernameInvalidChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// availableUsername 根据提供方建议的用户名或邮箱前缀生成未被占用的用户名，重名时追加随机数字
+func availableUsername(db *gorm.DB, identity *oidc.Identity) (string, error) {
+	base := identity.Username
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	base = usernameInvalidChars.ReplaceAllString(base, "")
+	if len(base) > 40 {
+		base = base[:40]
+	}
+	if len(base) < 3 {
+		base = "user"
+	}
+
+	candidate := base
+	for range 5 {
+		// 已注销用户的用户名仍占用唯一索引
+		var count int64
+		if err := db.Unscoped().Model(&model.User{}).Where("username = ?", candidate).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+		if err != nil {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s_%06d", base, n.Int64())
+	}
+	return "", errors.New("没有可用的用户名")
+}
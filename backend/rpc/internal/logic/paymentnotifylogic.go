@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/entitlement"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/orderstate"
+	"backend/rpc/internal/payment"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type PaymentNotifyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPaymentNotifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PaymentNotifyLogic {
+	return &PaymentNotifyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PaymentNotify 处理支付回调：校验签名后将订单标记为已支付并开通VIP
+// 支付平台会重复推送同一回调，已处理过的回调直接返回成功应答
+func (l *PaymentNotifyLogic) PaymentNotify(in *super.PaymentNotifyReq) (*super.PaymentNotifyResp, error) {
+	provider := l.svcCtx.PaymentProvider
+	if in.Provider != provider.Name() {
+		return nil, errorx.NotFound("不支持的支付提供方")
+	}
+
+	// 1. 校验签名并解析回调
+	result, err := provider.VerifyNotify(l.ctx, &payment.Notify{
+		Headers: in.Headers,
+		Body:    in.Body,
+	})
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			l.Infof("支付回调签名无效: provider=%s", in.Provider)
+			return nil, errorx.Unauthenticated("签名无效")
+		}
+		l.Error("解析支付回调失败: ", err)
+		return nil, errorx.InvalidArgument("回调内容无效")
+	}
+	ack := &super.PaymentNotifyResp{Ack: provider.NotifyAck()}
+	if !result.Paid {
+		return ack, nil
+	}
+
+	// 2. 查找订单并校验金额
+	var order model.VipOrder
+	if err := l.svcCtx.DB.WithContext(l.ctx).Preload("Plan").Preload("CreditPack").Where("order_no = ?", result.OrderNo).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Errorf("支付回调的订单不存在: order_no=%s trade_no=%s", result.OrderNo, result.TradeNo)
+			return nil, errorx.NotFound("订单不存在")
+		}
+		l.Error("查找订单失败: ", err)
+		return nil, errorx.Internal("处理支付回调失败")
+	}
+	if order.Status == model.VipOrderStatusPaid {
+		if order.TradeNo != result.TradeNo {
+			// 同一订单被重复支付，需要人工退款
+			l.Errorf("订单重复支付: order_no=%s trade_no=%s paid_trade_no=%s", order.OrderNo, result.TradeNo, order.TradeNo)
+		}
+		return ack, nil
+	}
+	if !orderstate.CanTransition(order.Status, model.VipOrderStatusPaid) {
+		// 订单已取消或过期后才完成支付，需要人工退款
+		l.Errorf("非待支付订单收到支付回调: order_no=%s status=%s trade_no=%s", order.OrderNo, order.Status, result.TradeNo)
+		return nil, errorx.New(409, "订单状态异常")
+	}
+	if order.UserID == nil {
+		// 用户注销并被清除后才完成支付，需要人工退款
+		l.Errorf("已清除用户的订单收到支付回调: order_no=%s trade_no=%s", order.OrderNo, result.TradeNo)
+		return nil, errorx.New(409, "订单状态异常")
+	}
+	if payment.ToCents(result.Amount) != payment.ToCents(order.Amount) {
+		l.Errorf("支付金额不一致: order_no=%s amount=%.2f paid=%.2f", order.OrderNo, order.Amount, result.Amount)
+		return nil, errorx.InvalidArgument("支付金额不一致")
+	}
+
+	// 3. 在同一事务中标记订单已支付并开通VIP或发放AI额度，已有VIP时有效期顺延
+	// 乐观锁保证并发的重复回调只有一个能成功，其余返回冲突由支付平台稍后重试
+	user, err := entitlement.ActivateOrder(l.svcCtx.DB.WithContext(l.ctx), &order, map[string]interface{}{
+		"trade_no":     result.TradeNo,
+		"pay_provider": provider.Name(),
+		"paid_at":      result.PaidAt,
+	}, time.Now())
+	if errors.Is(err, orderstate.ErrConflict) {
+		return nil, errorx.New(409, err.Error())
+	}
+	if err != nil {
+		l.Error("处理支付回调失败: ", err)
+		return nil, errorx.Internal("处理支付回调失败")
+	}
+
+	// 4. 立即同步认证服务的VIP状态，失败不影响支付结果，由后台任务重试；加油包订单不涉及VIP状态
+	if user != nil {
+		if err := l.svcCtx.Outbox.DeliverNew(l.ctx, user.ID, time.Now()); err != nil {
+			l.Error("同步AuthService用户VIP状态失败，稍后重试: ", err)
+		}
+	}
+
+	return ack, nil
+}
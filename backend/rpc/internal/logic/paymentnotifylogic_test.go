@@ -0,0 +1,209 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/outbox"
+	"backend/rpc/internal/payment"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// paymentNotifyFixture 支付回调测试的服务、用户和待支付订单
+type paymentNotifyFixture struct {
+	db       *gorm.DB
+	user     *model.User
+	order    *model.VipOrder
+	provider *payment.FakeProvider
+	svcCtx   *svc.ServiceContext
+	synced   int // 同步认证服务VIP状态的次数
+}
+
+func newPaymentNotifyFixture(t *testing.T) *paymentNotifyFixture {
+	t.Helper()
+
+	tables := []any{&model.VipOrder{}, &model.VipRecord{}, &model.OutboxEvent{}}
+	db := testdb.Open(t, append([]any{&model.VipPlan{}, &model.CreditPack{}}, tables...)...)
+
+	plan := &model.VipPlan{
+		Name:     "月度会员",
+		Price:    19.9,
+		Duration: 30,
+		Features: model.PlanFeatures{
+			Quotas:     map[string]int{model.AIFeatureChat: 100, model.AIFeatureContent: 50, model.AIFeatureAnalysis: 20},
+			ModelTiers: []string{model.ModelTierBasic},
+			MaxTokens:  4096,
+		},
+	}
+	if err := db.Create(plan).Error; err != nil {
+		t.Fatalf("创建套餐失败: %v", err)
+	}
+	// 先注册，在删除订单和用户之后执行
+	t.Cleanup(func() { db.Unscoped().Delete(plan) })
+
+	user := testdb.CreateUser(t, db, tables...)
+	order := &model.VipOrder{
+		UserID:      &user.ID,
+		ProductType: model.OrderProductVipPlan,
+		PlanID:      &plan.ID,
+		OrderNo:     fmt.Sprintf("T%d", time.Now().UnixNano()),
+		Amount:      plan.Price,
+		Status:      model.VipOrderStatusPending,
+	}
+	if err := db.Create(order).Error; err != nil {
+		t.Fatalf("创建订单失败: %v", err)
+	}
+
+	box, err := outbox.New(db, outbox.Config{
+		Interval:    5,
+		BatchSize:   100,
+		MaxAttempts: 10,
+		BaseDelay:   5,
+		MaxDelay:    3600,
+		Lease:       60,
+		Retention:   604800,
+	})
+	if err != nil {
+		t.Fatalf("创建跨服务操作投递失败: %v", err)
+	}
+
+	f := &paymentNotifyFixture{
+		db:       db,
+		user:     user,
+		order:    order,
+		provider: payment.NewFakeProvider("payment-test-secret", "http://localhost/pay", "", 5*time.Minute),
+	}
+	box.Handle(model.OutboxAuthSyncVip, func(ctx context.Context, event *model.OutboxEvent) error {
+		f.synced++
+		return nil
+	})
+	f.svcCtx = &svc.ServiceContext{DB: db, Outbox: box, PaymentProvider: f.provider}
+	return f
+}
+
+// notify 发送一次签名的支付成功回调
+func (f *paymentNotifyFixture) notify(tradeNo string, amount float64) (*super.PaymentNotifyResp, error) {
+	body, _ := json.Marshal(payment.FakeNotifyBody{
+		OrderNo: f.order.OrderNo,
+		TradeNo: tradeNo,
+		Amount:  amount,
+		Status:  payment.FakeStatusSuccess,
+		PaidAt:  time.Now().Unix(),
+	})
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	return NewPaymentNotifyLogic(context.Background(), f.svcCtx).PaymentNotify(&super.PaymentNotifyReq{
+		Provider: payment.TypeFake,
+		Body:     body,
+		Headers: map[string]string{
+			payment.FakeTimestampHeader: timestamp,
+			payment.FakeSignatureHeader: f.provider.Sign(timestamp, body),
+		},
+	})
+}
+
+func (f *paymentNotifyFixture) reload(t *testing.T) (model.VipOrder, []model.VipRecord) {
+	t.Helper()
+
+	var order model.VipOrder
+	if err := f.db.First(&order, f.order.ID).Error; err != nil {
+		t.Fatalf("查询订单失败: %v", err)
+	}
+	var records []model.VipRecord
+	if err := f.db.Where("user_id = ?", f.user.ID).Find(&records).Error; err != nil {
+		t.Fatalf("查询VIP记录失败: %v", err)
+	}
+	return order, records
+}
+
+// 支付平台重复推送同一回调时返回成功应答，订单只处理一次，VIP不会重复开通
+func TestPaymentNotifyReplayIsIdempotent(t *testing.T) {
+	f := newPaymentNotifyFixture(t)
+
+	for i := 0; i < 3; i++ {
+		resp, err := f.notify("TRADE1", f.order.Amount)
+		if err != nil {
+			t.Fatalf("第%d次回调失败: %v", i+1, err)
+		}
+		if resp.Ack != f.provider.NotifyAck() {
+			t.Fatalf("第%d次回调应答 = %q", i+1, resp.Ack)
+		}
+	}
+
+	order, records := f.reload(t)
+	if order.Status != model.VipOrderStatusPaid || order.TradeNo != "TRADE1" || order.PayProvider != payment.TypeFake {
+		t.Fatalf("订单状态错误: status=%s trade_no=%s provider=%s", order.Status, order.TradeNo, order.PayProvider)
+	}
+	if len(records) != 1 || records[0].OrderID == nil || *records[0].OrderID != order.ID {
+		t.Fatalf("应只开通一条VIP记录，得到 %+v", records)
+	}
+	if f.synced != 1 {
+		t.Fatalf("应同步认证服务一次，得到%d次", f.synced)
+	}
+}
+
+// 签名无效的回调被拒绝，订单保持待支付
+func TestPaymentNotifyRejectsBadSignature(t *testing.T) {
+	f := newPaymentNotifyFixture(t)
+
+	body := []byte(fmt.Sprintf(`{"order_no":%q,"trade_no":"TRADE1","amount":%v,"status":"SUCCESS"}`, f.order.OrderNo, f.order.Amount))
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	forged := payment.NewFakeProvider("forged-secret", "", "", 5*time.Minute)
+	_, err := NewPaymentNotifyLogic(context.Background(), f.svcCtx).PaymentNotify(&super.PaymentNotifyReq{
+		Provider: payment.TypeFake,
+		Body:     body,
+		Headers: map[string]string{
+			payment.FakeTimestampHeader: timestamp,
+			payment.FakeSignatureHeader: forged.Sign(timestamp, body),
+		},
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("期望Unauthenticated，得到 %v", err)
+	}
+
+	order, records := f.reload(t)
+	if order.Status != model.VipOrderStatusPending || len(records) != 0 {
+		t.Fatalf("签名无效时不应处理订单: status=%s records=%d", order.Status, len(records))
+	}
+}
+
+// 支付金额与订单金额不一致时拒绝，不开通VIP
+func TestPaymentNotifyRejectsAmountMismatch(t *testing.T) {
+	f := newPaymentNotifyFixture(t)
+
+	if _, err := f.notify("TRADE1", 0.01); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("期望InvalidArgument，得到 %v", err)
+	}
+
+	order, records := f.reload(t)
+	if order.Status != model.VipOrderStatusPending || len(records) != 0 {
+		t.Fatalf("金额不一致时不应处理订单: status=%s records=%d", order.Status, len(records))
+	}
+}
+
+// 已支付的订单收到其他交易号的回调时只应答成功，不再次开通
+func TestPaymentNotifyDuplicatePaymentDoesNotReactivate(t *testing.T) {
+	f := newPaymentNotifyFixture(t)
+
+	if _, err := f.notify("TRADE1", f.order.Amount); err != nil {
+		t.Fatalf("回调失败: %v", err)
+	}
+	if _, err := f.notify("TRADE2", f.order.Amount); err != nil {
+		t.Fatalf("重复支付的回调应返回成功应答: %v", err)
+	}
+
+	order, records := f.reload(t)
+	if order.TradeNo != "TRADE1" || len(records) != 1 {
+		t.Fatalf("重复支付不应修改订单或再次开通: trade_no=%s records=%d", order.TradeNo, len(records))
+	}
+}
@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/orderstate"
+	"backend/rpc/internal/payment"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type PayVipOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPayVipOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PayVipOrderLogic {
+	return &PayVipOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *PayVipOrderLogic) PayVipOrder(in *super.PayVipOrderReq) (*super.PayVipOrderResp, error) {
+	// 1. 查找订单，只能支付自己的待支付订单
+	var order model.VipOrder
+	err := l.svcCtx.DB.WithContext(l.ctx).Preload("Plan").Preload("CreditPack").
+		Where("order_no = ? AND user_id = ?", in.OrderNo, in.UserId).
+		First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("订单不存在")
+		}
+		l.Error("查找订单失败: ", err)
+		return nil, errorx.Internal("发起支付失败")
+	}
+	if order.Status != model.VipOrderStatusPending {
+		return nil, errorx.InvalidArgument("订单当前状态不能支付")
+	}
+	// 已超过支付期限但尚未被后台任务扫描到的订单，直接置为过期
+	if time.Now().After(orderPaymentDeadline(l.svcCtx, &order)) {
+		if err := orderstate.Transition(l.svcCtx.DB.WithContext(l.ctx), &order, model.VipOrderStatusExpired, nil); err != nil {
+			l.Error("订单过期失败: ", err)
+		}
+		return nil, errorx.InvalidArgument("订单已过期，请重新下单")
+	}
+
+	// 2. 记录支付方式，订单同时被支付回调或其他请求修改时不再发起支付
+	provider := l.svcCtx.PaymentProvider
+	err = orderstate.Update(l.svcCtx.DB.WithContext(l.ctx), &order, map[string]interface{}{
+		"pay_method":   in.PayMethod,
+		"pay_provider": provider.Name(),
+	})
+	if errors.Is(err, orderstate.ErrConflict) {
+		return nil, errorx.New(409, err.Error())
+	}
+	if err != nil {
+		l.Error("更新订单支付方式失败: ", err)
+		return nil, errorx.Internal("发起支付失败")
+	}
+
+	// 3. 调用支付提供方创建支付
+	result, err := provider.CreatePayment(l.ctx, &payment.PaymentRequest{
+		OrderNo:   order.OrderNo,
+		Amount:    order.Amount,
+		Subject:   order.ProductName(),
+		PayMethod: in.PayMethod,
+	})
+	if err != nil {
+		l.Error("创建支付失败: ", err)
+		return nil, errorx.Internal("支付服务暂时不可用，请稍后重试")
+	}
+
+	return &super.PayVipOrderResp{
+		OrderNo:  order.OrderNo,
+		Provider: provider.Name(),
+		PayUrl:   result.PayURL,
+		QrCode:   result.QRCode,
+	}, nil
+}
+
+// orderPaymentDeadline 待支付订单的支付截止时间
+func orderPaymentDeadline(svcCtx *svc.ServiceContext, order *model.VipOrder) time.Time {
+	return order.CreatedAt.Add(time.Duration(svcCtx.Config.Order.PendingTTL) * time.Second)
+}
@@ -0,0 +1,316 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/config"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/outbox"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 用户数据差异类型
+const (
+	driftMissingLocal     = "missing_local"      // 认证服务有、本地没有：按认证服务创建本地用户
+	driftMissingRemote    = "missing_remote"     // 本地有、认证服务没有：停用本地用户，保留期后清除
+	driftRemoteNotDeleted = "remote_not_deleted" // 本地已删除且不再等待清除、认证服务未删除：重新记录删除操作
+	driftProfileMismatch  = "profile_mismatch"   // 用户名或邮箱不一致：以认证服务为准
+	driftVipMismatch      = "vip_mismatch"       // VIP状态不一致：以本地为准同步到认证服务
+)
+
+// reconcilePageSize 分页读取认证服务用户时每页的数量
+const reconcilePageSize = 100
+
+type ReconcileUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReconcileUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReconcileUsersLogic {
+	return &ReconcileUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReconcileUsers 对比本地用户表与认证服务的用户并修复差异
+// 用户名和邮箱以认证服务为准，VIP状态以本地为准；需要调用认证服务的修复记录为跨服务操作，由后台任务投递
+func (l *ReconcileUsersLogic) ReconcileUsers(in *super.ReconcileUsersReq) (*super.ReconcileUsersResp, error) {
+	if l.svcCtx.Config.AuthBackend == config.AuthBackendLocal {
+		return nil, errorx.InvalidArgument("使用本地认证时用户数据只有一份，无需对账")
+	}
+	// 检查AuthClient是否初始化
+	if l.svcCtx.AuthClient == nil {
+		l.Error("AuthClient未初始化")
+		return nil, errorx.Internal("服务器内部错误")
+	}
+
+	// 1. 读取认证服务的全部用户
+	remote, err := l.remoteUsers()
+	if err != nil {
+		l.Error("调用AuthService获取用户列表失败: ", err)
+		return nil, errorx.Internal("对账失败，请稍后重试")
+	}
+
+	// 2. 读取本地的全部用户，包括已删除的
+	var locals []model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Unscoped().
+		Select("id", "username", "email", "is_vip", "vip_end_at", "deleted_at").
+		Order("id").
+		Find(&locals).Error; err != nil {
+		l.Error("查询本地用户失败: ", err)
+		return nil, errorx.Internal("对账失败，请稍后重试")
+	}
+
+	// 认证服务返回空列表多半是配置错误，此时修复会删除全部本地用户
+	if len(remote) == 0 && !in.DryRun && slices.ContainsFunc(locals, func(u model.User) bool { return !u.DeletedAt.Valid }) {
+		return nil, errorx.InvalidArgument("认证服务没有返回任何用户，为避免误删本地用户已停止修复，请先只报告差异并检查配置")
+	}
+
+	// 3. 有未投递的同步操作的用户，差异可能只是暂时的，跳过
+	pending, err := l.svcCtx.Outbox.PendingUsers(l.ctx)
+	if err != nil {
+		l.Error("查询未投递的跨服务操作失败: ", err)
+		return nil, errorx.Internal("对账失败，请稍后重试")
+	}
+
+	// 4. 已删除等待清除的用户在认证服务中仍存在，清除时才删除；已清除的用户不应再在本地创建
+	scheduled, err := l.svcCtx.UserDeletion.Scheduled(l.ctx)
+	if err != nil {
+		l.Error("查询待清除的用户失败: ", err)
+		return nil, errorx.Internal("对账失败，请稍后重试")
+	}
+	purged, err := l.svcCtx.UserDeletion.Purged(l.ctx)
+	if err != nil {
+		l.Error("查询已清除的用户失败: ", err)
+		return nil, errorx.Internal("对账失败，请稍后重试")
+	}
+
+	// 5. 逐个对比并修复
+	operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+	resp := &super.ReconcileUsersResp{
+		RemoteUsers: int32(len(remote)),
+		LocalUsers:  int32(len(locals)),
+	}
+	now := time.Now()
+	seen := make(map[uint]bool, len(locals))
+	for i := range locals {
+		local := &locals[i]
+		seen[local.ID] = true
+		if pending[local.ID] {
+			resp.Skipped++
+			continue
+		}
+		resp.Drifts = append(resp.Drifts, l.reconcileLocal(local, remote[local.ID], scheduled[local.ID], uint(operatorID), in.DryRun, now)...)
+	}
+
+	remoteIDs := make([]uint, 0, len(remote))
+	for id := range remote {
+		if !seen[id] {
+			remoteIDs = append(remoteIDs, id)
+		}
+	}
+	slices.Sort(remoteIDs)
+	for _, id := range remoteIDs {
+		if pending[id] {
+			resp.Skipped++
+			continue
+		}
+		remoteUser := remote[id]
+		if purged[id] {
+			drift := &super.UserDrift{
+				UserId: remoteUser.Id,
+				Kind:   driftRemoteNotDeleted,
+				Detail: "本地已彻底清除，认证服务中仍存在",
+			}
+			l.repair(drift, in.DryRun, func() error {
+				_, err := outbox.Enqueue(l.svcCtx.DB.WithContext(l.ctx), model.OutboxAuthDeleteUser, id, now)
+				return err
+			})
+			resp.Drifts = append(resp.Drifts, drift)
+			continue
+		}
+		drift := &super.UserDrift{
+			UserId: remoteUser.Id,
+			Kind:   driftMissingLocal,
+			Detail: fmt.Sprintf("本地缺少用户 %s <%s>", remoteUser.Username, remoteUser.Email),
+		}
+		l.repair(drift, in.DryRun, func() error {
+			return l.createLocalUser(id, remoteUser)
+		})
+		resp.Drifts = append(resp.Drifts, drift)
+	}
+
+	// 6. 记录审计日志
+	repaired := 0
+	for _, drift := range resp.Drifts {
+		if drift.Repaired {
+			repaired++
+		}
+	}
+	l.Infof("用户对账完成: dry_run=%t drifts=%d repaired=%d skipped=%d operator_id=%s",
+		in.DryRun, len(resp.Drifts), repaired, resp.Skipped, in.OperatorId)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditUsersReconciled,
+		ActorID: uint(operatorID),
+		Detail: map[string]any{
+			"dry_run":  in.DryRun,
+			"drifts":   len(resp.Drifts),
+			"repaired": repaired,
+			"skipped":  resp.Skipped,
+		},
+	})
+
+	return resp, nil
+}
+
+// remoteUsers 分页读取认证服务的全部用户
+func (l *ReconcileUsersLogic) remoteUsers() (map[uint]*auth.User, error) {
+	users := make(map[uint]*auth.User)
+	for page := int32(1); ; page++ {
+		authResp, err := l.svcCtx.AuthClient.GetUsers(l.ctx, &auth.GetUsersReq{
+			Page:     page,
+			PageSize: reconcilePageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range authResp.Users {
+			id, err := strconv.ParseUint(user.Id, 10, 64)
+			if err != nil {
+				l.Error("AuthService返回的用户ID无效: ", user.Id)
+				continue
+			}
+			users[uint(id)] = user
+		}
+		if len(authResp.Users) < reconcilePageSize || len(users) >= int(authResp.Total) {
+			return users, nil
+		}
+	}
+}
+
+// reconcileLocal 对比一个本地用户与认证服务中的同一用户，remoteUser为nil表示认证服务中不存在
+// scheduled表示用户有待清除的注销记录
+func (l *ReconcileUsersLogic) reconcileLocal(local *model.User, remoteUser *auth.User, scheduled bool, operatorID uint, dryRun bool, now time.Time) []*super.UserDrift {
+	userID := strconv.FormatUint(uint64(local.ID), 10)
+
+	// 本地已删除：等待清除时认证服务中的用户在清除时删除；否则说明删除操作已放弃，重新记录
+	if local.DeletedAt.Valid {
+		if remoteUser == nil || scheduled {
+			return nil
+		}
+		drift := &super.UserDrift{
+			UserId: userID,
+			Kind:   driftRemoteNotDeleted,
+			Detail: fmt.Sprintf("本地已于 %s 删除，认证服务中仍存在", local.DeletedAt.Time.Format("2006-01-02 15:04:05")),
+		}
+		l.repair(drift, dryRun, func() error {
+			_, err := outbox.Enqueue(l.svcCtx.DB.WithContext(l.ctx), model.OutboxAuthDeleteUser, local.ID, now)
+			return err
+		})
+		return []*super.UserDrift{drift}
+	}
+
+	if remoteUser == nil {
+		drift := &super.UserDrift{
+			UserId: userID,
+			Kind:   driftMissingRemote,
+			Detail: fmt.Sprintf("认证服务中缺少用户 %s <%s>", local.Username, local.Email),
+		}
+		l.repair(drift, dryRun, func() error {
+			return l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+				_, err := l.svcCtx.UserDeletion.Deactivate(tx, local.ID, model.UserDeletionReconcile, operatorID, now)
+				return err
+			})
+		})
+		return []*super.UserDrift{drift}
+	}
+
+	var drifts []*super.UserDrift
+	if local.Username != remoteUser.Username || local.Email != remoteUser.Email {
+		drift := &super.UserDrift{
+			UserId: userID,
+			Kind:   driftProfileMismatch,
+			Detail: fmt.Sprintf("本地 %s <%s>，认证服务 %s <%s>", local.Username, local.Email, remoteUser.Username, remoteUser.Email),
+		}
+		l.repair(drift, dryRun, func() error {
+			return l.svcCtx.DB.WithContext(l.ctx).Model(&model.User{}).
+				Where("id = ?", local.ID).
+				Updates(map[string]interface{}{
+					"username": remoteUser.Username,
+					"email":    remoteUser.Email,
+				}).Error
+		})
+		drifts = append(drifts, drift)
+	}
+
+	localExpires := ""
+	if local.VipEndAt != nil {
+		localExpires = local.VipEndAt.Format("2006-01-02 15:04:05")
+	}
+	if local.IsVip != remoteUser.IsVip || localExpires != remoteUser.VipExpiresAt {
+		drift := &super.UserDrift{
+			UserId: userID,
+			Kind:   driftVipMismatch,
+			Detail: fmt.Sprintf("本地 is_vip=%t 到期 %q，认证服务 is_vip=%t 到期 %q",
+				local.IsVip, localExpires, remoteUser.IsVip, remoteUser.VipExpiresAt),
+		}
+		l.repair(drift, dryRun, func() error {
+			_, err := outbox.Enqueue(l.svcCtx.DB.WithContext(l.ctx), model.OutboxAuthSyncVip, local.ID, now)
+			return err
+		})
+		drifts = append(drifts, drift)
+	}
+	return drifts
+}
+
+// createLocalUser 按认证服务的用户创建本地用户，本地密码不会被使用，设为随机值
+func (l *ReconcileUsersLogic) createLocalUser(id uint, remoteUser *auth.User) error {
+	password, err := utils.GenerateRandomToken(24)
+	if err != nil {
+		return err
+	}
+	user := model.User{
+		ID:       id,
+		Username: remoteUser.Username,
+		Email:    remoteUser.Email,
+		Password: password,
+		Role:     model.RoleUser,
+		IsVip:    remoteUser.IsVip,
+	}
+	if remoteUser.VipExpiresAt != "" {
+		vipEndAt, err := time.ParseInLocation("2006-01-02 15:04:05", remoteUser.VipExpiresAt, time.Local)
+		if err != nil {
+			return fmt.Errorf("VIP到期时间无效: %w", err)
+		}
+		user.VipEndAt = &vipEndAt
+	}
+	return l.svcCtx.DB.WithContext(l.ctx).Create(&user).Error
+}
+
+// repair 非只报告模式下执行修复并记录结果
+func (l *ReconcileUsersLogic) repair(drift *super.UserDrift, dryRun bool, fix func() error) {
+	if dryRun {
+		return
+	}
+	if err := fix(); err != nil {
+		l.Errorf("修复用户数据差异失败: user_id=%s kind=%s err=%v", drift.UserId, drift.Kind, err)
+		drift.Error = err.Error()
+		return
+	}
+	drift.Repaired = true
+}
@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// errRefreshTokenReused 刷新令牌被重复使用
+var errRefreshTokenReused = errors.New("refresh token reused")
+
+type RefreshTokenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshTokenLogic {
+	return &RefreshTokenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 认证相关服务
+func (l *RefreshTokenLogic) RefreshToken(in *super.RefreshTokenReq) (*super.RefreshTokenResp, error) {
+	if in.RefreshToken == "" {
+		return nil, errorx.InvalidArgument("刷新令牌不能为空")
+	}
+
+	// 1. 查找刷新令牌
+	var token model.RefreshToken
+	if err := l.svcCtx.DB.WithContext(l.ctx).Where("token_hash = ?", utils.HashToken(in.RefreshToken)).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.Unauthenticated("刷新令牌无效")
+		}
+		l.Error("查找刷新令牌失败: ", err)
+		return nil, errorx.Internal("刷新失败")
+	}
+
+	// 2. 已使用或已吊销的令牌再次出现，说明令牌可能已泄露，吊销整个家族
+	if token.UsedAt != nil || token.RevokedAt != nil {
+		l.Infof("检测到刷新令牌重复使用，吊销令牌家族: user_id=%d family_id=%s", token.UserID, token.FamilyID)
+		if err := revokeTokenFamily(l.svcCtx.DB.WithContext(l.ctx), token.FamilyID); err != nil {
+			l.Error("吊销令牌家族失败: ", err)
+		}
+		return nil, errorx.Unauthenticated("刷新令牌已失效，请重新登录")
+	}
+	if time.Now().After(token.ExpiresAt) {
+		return nil, errorx.Unauthenticated("刷新令牌已过期，请重新登录")
+	}
+
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).First(&user, token.UserID).Error; err != nil {
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Unauthenticated("用户不存在")
+	}
+
+	// 3. 标记旧令牌已使用并签发新令牌；条件更新保证并发刷新时只有一个请求成功
+	var tokens *tokenPair
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", token.ID).
+			Update("used_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errRefreshTokenReused
+		}
+
+		var err error
+		tokens, err = issueTokenPair(tx, user.ID, user.Username, user.Role, token.FamilyID, &token.ID)
+		return err
+	})
+	if errors.Is(err, errRefreshTokenReused) {
+		if err := revokeTokenFamily(l.svcCtx.DB.WithContext(l.ctx), token.FamilyID); err != nil {
+			l.Error("吊销令牌家族失败: ", err)
+		}
+		return nil, errorx.Unauthenticated("刷新令牌已失效，请重新登录")
+	}
+	if err != nil {
+		l.Error("刷新Token失败: ", err)
+		return nil, errorx.Internal("刷新失败")
+	}
+
+	return &super.RefreshTokenResp{
+		Token:        tokens.accessToken,
+		RefreshToken: tokens.refreshToken,
+		ExpiresIn:    tokens.expiresIn,
+	}, nil
+}
+
+// revokeTokenFamily 吊销令牌家族中所有未吊销的刷新令牌
+func revokeTokenFamily(db *gorm.DB, familyID string) error {
+	return db.Model(&model.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// refreshTokenFixture 一次登录签发的令牌家族
+type refreshTokenFixture struct {
+	db       *gorm.DB
+	user     *model.User
+	familyID string
+	svcCtx   *svc.ServiceContext
+}
+
+func newRefreshTokenFixture(t *testing.T) (*refreshTokenFixture, string) {
+	t.Helper()
+
+	db := testdb.Open(t, &model.RefreshToken{})
+	user := testdb.CreateUser(t, db, &model.RefreshToken{})
+	if err := utils.InitJWT(utils.JWTConfig{
+		Secret:        "0123456789abcdef0123456789abcdef",
+		Expire:        900,
+		RefreshExpire: 3600,
+	}); err != nil {
+		t.Fatalf("初始化JWT失败: %v", err)
+	}
+
+	f := &refreshTokenFixture{
+		db:       db,
+		user:     user,
+		familyID: fmt.Sprintf("family_%d", time.Now().UnixNano()),
+		svcCtx:   &svc.ServiceContext{DB: db},
+	}
+	tokens, err := issueTokenPair(db, user.ID, user.Username, user.Role, f.familyID, nil)
+	if err != nil {
+		t.Fatalf("签发令牌失败: %v", err)
+	}
+	return f, tokens.refreshToken
+}
+
+func (f *refreshTokenFixture) refresh(token string) (*super.RefreshTokenResp, error) {
+	return NewRefreshTokenLogic(context.Background(), f.svcCtx).RefreshToken(&super.RefreshTokenReq{RefreshToken: token})
+}
+
+func (f *refreshTokenFixture) family(t *testing.T) []model.RefreshToken {
+	t.Helper()
+
+	var tokens []model.RefreshToken
+	if err := f.db.Where("family_id = ?", f.familyID).Order("id").Find(&tokens).Error; err != nil {
+		t.Fatalf("查询令牌家族失败: %v", err)
+	}
+	return tokens
+}
+
+// 刷新后签发同一家族的新令牌，旧令牌标记为已使用
+func TestRefreshTokenRotates(t *testing.T) {
+	f, token := newRefreshTokenFixture(t)
+
+	resp, err := f.refresh(token)
+	if err != nil {
+		t.Fatalf("刷新失败: %v", err)
+	}
+	if resp.Token == "" || resp.RefreshToken == "" || resp.RefreshToken == token {
+		t.Fatalf("应签发新的访问Token和刷新令牌: %+v", resp)
+	}
+	claims, err := utils.ParseToken(resp.Token)
+	if err != nil || claims.UserID != f.user.ID {
+		t.Fatalf("新访问Token无效: claims=%+v err=%v", claims, err)
+	}
+
+	tokens := f.family(t)
+	if len(tokens) != 2 {
+		t.Fatalf("家族中应有2个令牌，得到%d", len(tokens))
+	}
+	if tokens[0].UsedAt == nil || tokens[0].RevokedAt != nil {
+		t.Fatalf("旧令牌应标记为已使用: %+v", tokens[0])
+	}
+	if tokens[1].ParentID == nil || *tokens[1].ParentID != tokens[0].ID || tokens[1].UsedAt != nil {
+		t.Fatalf("新令牌应指向旧令牌且未使用: %+v", tokens[1])
+	}
+}
+
+// 已轮换的令牌再次使用时视为泄露，吊销整个家族，包括刚签发的新令牌
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	f, token := newRefreshTokenFixture(t)
+
+	resp, err := f.refresh(token)
+	if err != nil {
+		t.Fatalf("刷新失败: %v", err)
+	}
+
+	if _, err := f.refresh(token); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("重复使用旧令牌应返回Unauthenticated，得到 %v", err)
+	}
+	for _, tok := range f.family(t) {
+		if tok.RevokedAt == nil {
+			t.Fatalf("令牌家族中仍有未吊销的令牌: %+v", tok)
+		}
+	}
+
+	if _, err := f.refresh(resp.RefreshToken); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("家族被吊销后新令牌也应失效，得到 %v", err)
+	}
+	if len(f.family(t)) != 2 {
+		t.Fatal("家族被吊销后不应再签发令牌")
+	}
+}
+
+func TestRefreshTokenRejectsExpiredAndUnknownTokens(t *testing.T) {
+	f, token := newRefreshTokenFixture(t)
+
+	if err := f.db.Model(&model.RefreshToken{}).
+		Where("family_id = ?", f.familyID).
+		Update("expires_at", time.Now().Add(-time.Minute)).Error; err != nil {
+		t.Fatalf("修改过期时间失败: %v", err)
+	}
+	if _, err := f.refresh(token); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("过期令牌应返回Unauthenticated，得到 %v", err)
+	}
+	if tokens := f.family(t); tokens[0].UsedAt != nil || tokens[0].RevokedAt != nil {
+		t.Fatalf("过期令牌不应被使用或吊销: %+v", tokens[0])
+	}
+
+	if _, err := f.refresh("unknown-token"); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("未知令牌应返回Unauthenticated，得到 %v", err)
+	}
+	if _, err := f.refresh(""); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("空令牌应返回InvalidArgument，得到 %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"strconv"
 
 	"backend/rpc/internal/errorx"
 	"backend/rpc/internal/svc"
@@ -9,6 +10,8 @@ import (
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type RegisterLogic struct {
@@ -41,9 +44,20 @@ func (l *RegisterLogic) Register(in *super.RegisterReq) (*super.RegisterResp, er
 	})
 	if err != nil {
 		l.Error("调用AuthService注册失败: ", err)
+		// 用户名或邮箱已被占用、参数无效时直接返回原因
+		if c := status.Code(err); c == codes.AlreadyExists || c == codes.InvalidArgument {
+			return nil, err
+		}
 		return nil, errorx.Internal("注册失败，请稍后重试")
 	}
 
+	// 发送邮箱验证邮件，发送失败不影响注册，用户可以登录后重新发送
+	if userID, err := strconv.ParseUint(authResp.User.Id, 10, 64); err != nil {
+		l.Error("AuthService返回的用户ID无效: ", authResp.User.Id)
+	} else if err := sendVerificationEmail(l.ctx, l.svcCtx, uint(userID), authResp.User.Username, authResp.User.Email); err != nil {
+		l.Error("发送验证邮件失败: ", err)
+	}
+
 	// 将外部服务的响应转换为主服务的响应格式
 	return &super.RegisterResp{
 		User: &super.User{
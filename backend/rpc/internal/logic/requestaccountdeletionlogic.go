@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/userdeletion"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type RequestAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRequestAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestAccountDeletionLogic {
+	return &RequestAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RequestAccountDeletion 用户申请注销账号，冷静期结束后由后台任务彻底清除用户数据
+func (l *RequestAccountDeletionLogic) RequestAccountDeletion(in *super.RequestAccountDeletionReq) (*super.AccountDeletionResp, error) {
+	// 检查AuthClient是否初始化
+	if l.svcCtx.AuthClient == nil {
+		l.Error("AuthClient未初始化")
+		return nil, errorx.Internal("服务器内部错误")
+	}
+
+	// 1. 查找用户
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "username").First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("申请注销失败")
+	}
+
+	// 2. 验证密码，防止登录会话被盗用时直接注销账号
+	if _, err := l.svcCtx.AuthClient.Login(l.ctx, &auth.LoginReq{
+		Username: user.Username,
+		Password: in.Password,
+	}); err != nil {
+		if authUnavailable(err) {
+			l.Error("调用AuthService验证密码失败: ", err)
+			return nil, errorx.Internal("申请注销失败，请稍后重试")
+		}
+		return nil, errorx.InvalidArgument("密码不正确")
+	}
+
+	// 3. 记录注销申请
+	deletion, err := l.svcCtx.UserDeletion.Request(l.ctx, user.ID, time.Now())
+	if errors.Is(err, userdeletion.ErrAlreadyRequested) {
+		return nil, errorx.AlreadyExists(err.Error())
+	}
+	if err != nil {
+		l.Error("记录注销申请失败: ", err)
+		return nil, errorx.Internal("申请注销失败")
+	}
+
+	// 4. 记录审计日志
+	l.Infof("用户申请注销账号: user_id=%d purge_at=%s", user.ID, deletion.PurgeAt.Format("2006-01-02 15:04:05"))
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditDeletionRequested,
+		UserID:  user.ID,
+		ActorID: user.ID,
+		IP:      in.ClientIp,
+		Detail: map[string]any{
+			"purge_at": deletion.PurgeAt.Format("2006-01-02 15:04:05"),
+		},
+	})
+
+	return accountDeletionOf(deletion), nil
+}
@@ -0,0 +1,99 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/usertoken"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errPasswordResetUnsupported 认证后端不支持重置密码
+var errPasswordResetUnsupported = errorx.New(403, "暂不支持通过邮件重置密码，请联系管理员")
+
+type ResetPasswordLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetPasswordLogic {
+	return &ResetPasswordLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ResetPasswordLogic) ResetPassword(in *super.ResetPasswordReq) (*super.ResetPasswordResp, error) {
+	// 检查AuthClient是否初始化
+	if l.svcCtx.AuthClient == nil {
+		l.Error("AuthClient未初始化")
+		return nil, errorx.Internal("服务器内部错误")
+	}
+	if !l.svcCtx.Config.PasswordResetEnabled() {
+		return nil, errPasswordResetUnsupported
+	}
+	if utf8.RuneCountInString(in.NewPassword) < 6 {
+		return nil, errorx.InvalidArgument("密码长度不能少于6位")
+	}
+
+	// 1. 核销令牌，并发使用同一链接只有一个请求成功
+	token, err := l.svcCtx.UserTokens.Consume(l.ctx, model.UserTokenPasswordReset, in.Token)
+	if err != nil {
+		if errors.Is(err, usertoken.ErrInvalid) {
+			return nil, errorx.InvalidArgument("重置链接无效或已过期，请重新申请")
+		}
+		l.Error("核销重置密码令牌失败: ", err)
+		return nil, errorx.Internal("重置密码失败")
+	}
+
+	// 2. 由认证服务设置新密码，失败时恢复令牌，用户可以用同一链接重试
+	userID := strconv.FormatUint(uint64(token.UserID), 10)
+	if _, err := l.svcCtx.AuthClient.ResetUserPassword(l.ctx, &auth.ResetUserPasswordReq{
+		UserId:      userID,
+		NewPassword: in.NewPassword,
+	}); err != nil {
+		if err := l.svcCtx.UserTokens.Release(context.WithoutCancel(l.ctx), token); err != nil {
+			l.Error("恢复重置密码令牌失败: ", err)
+		}
+		if status.Code(err) == codes.Unimplemented {
+			l.Error("认证服务未实现ResetUserPassword，请关闭AuthPasswordReset: ", err)
+			return nil, errPasswordResetUnsupported
+		}
+		l.Error("调用AuthService重置密码失败: ", err)
+		return nil, errorx.Internal("重置密码失败，请稍后重试")
+	}
+
+	// 3. 密码已重置，吊销所有登录会话并解除登录锁定
+	now := time.Now()
+	if err := l.svcCtx.DB.WithContext(l.ctx).Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", token.UserID).
+		Update("revoked_at", now).Error; err != nil {
+		l.Error("吊销刷新令牌失败: ", err)
+	}
+	if _, err := l.svcCtx.LoginGuard.Unlock(l.ctx, token.UserID, now); err != nil {
+		l.Error("解除账号锁定失败: ", err)
+	}
+
+	l.Infof("用户已通过邮件重置密码: user_id=%d", token.UserID)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditPasswordReset,
+		UserID:  token.UserID,
+		ActorID: token.UserID,
+	})
+
+	return &super.ResetPasswordResp{}, nil
+}
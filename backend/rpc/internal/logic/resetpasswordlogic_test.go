@@ -0,0 +1,182 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/config"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/internal/usertoken"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// resetAuthClient 记录重置密码调用的认证服务，其余方法未实现
+type resetAuthClient struct {
+	auth.AuthClient
+	err   error
+	calls []*auth.ResetUserPasswordReq
+}
+
+func (c *resetAuthClient) ResetUserPassword(ctx context.Context, in *auth.ResetUserPasswordReq, opts ...grpc.CallOption) (*auth.ResetUserPasswordResp, error) {
+	c.calls = append(c.calls, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &auth.ResetUserPasswordResp{}, nil
+}
+
+// resetPasswordFixture 重置密码测试的服务和用户
+type resetPasswordFixture struct {
+	db     *gorm.DB
+	user   *model.User
+	auth   *resetAuthClient
+	tokens *usertoken.Issuer
+	svcCtx *svc.ServiceContext
+}
+
+func newResetPasswordFixture(t *testing.T, passwordReset bool) *resetPasswordFixture {
+	t.Helper()
+
+	tables := []any{&model.UserToken{}, &model.RefreshToken{}, &model.LoginThrottle{}, &model.AuditLog{}}
+	db := testdb.Open(t, tables...)
+	user := testdb.CreateUser(t, db, tables...)
+
+	tokens, err := usertoken.New(db, usertoken.Config{
+		Secret:           "0123456789abcdef0123456789abcdef",
+		VerifyEmailTTL:   60,
+		PasswordResetTTL: 60,
+	})
+	if err != nil {
+		t.Fatalf("创建令牌签发器失败: %v", err)
+	}
+	guard, err := loginguard.New(db, loginguard.Config{
+		BackoffAfter:   3,
+		IPBackoffAfter: 20,
+		BaseDelay:      1,
+		MaxDelay:       300,
+		LockThreshold:  10,
+		LockDuration:   900,
+		FailureWindow:  3600,
+	})
+	if err != nil {
+		t.Fatalf("创建登录防护失败: %v", err)
+	}
+
+	authClient := &resetAuthClient{}
+	return &resetPasswordFixture{
+		db:     db,
+		user:   user,
+		auth:   authClient,
+		tokens: tokens,
+		svcCtx: &svc.ServiceContext{
+			Config:     config.Config{AuthBackend: config.AuthBackendRPC, AuthPasswordReset: passwordReset},
+			DB:         db,
+			AuthClient: authClient,
+			UserTokens: tokens,
+			LoginGuard: guard,
+		},
+	}
+}
+
+func (f *resetPasswordFixture) issue(t *testing.T) string {
+	t.Helper()
+	token, err := f.tokens.Issue(context.Background(), f.user.ID, model.UserTokenPasswordReset, f.user.Email)
+	if err != nil {
+		t.Fatalf("签发重置密码令牌失败: %v", err)
+	}
+	return token
+}
+
+func (f *resetPasswordFixture) reset(token string) error {
+	_, err := NewResetPasswordLogic(context.Background(), f.svcCtx).ResetPassword(&super.ResetPasswordReq{
+		Token:       token,
+		NewPassword: "new-password",
+	})
+	return err
+}
+
+func TestResetPasswordConsumesTokenAndRevokesSessions(t *testing.T) {
+	f := newResetPasswordFixture(t, true)
+	token := f.issue(t)
+
+	session := model.RefreshToken{
+		UserID:    f.user.ID,
+		TokenHash: f.user.Username,
+		FamilyID:  f.user.Username,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	if err := f.db.Create(&session).Error; err != nil {
+		t.Fatalf("创建刷新令牌失败: %v", err)
+	}
+
+	if err := f.reset(token); err != nil {
+		t.Fatalf("重置密码失败: %v", err)
+	}
+	if len(f.auth.calls) != 1 {
+		t.Fatalf("调用认证服务%d次，期望1次", len(f.auth.calls))
+	}
+	call := f.auth.calls[0]
+	if call.UserId != strconv.FormatUint(uint64(f.user.ID), 10) || call.NewPassword != "new-password" {
+		t.Fatalf("认证服务收到的请求错误: %+v", call)
+	}
+
+	// 登录会话全部吊销
+	if err := f.db.First(&session, session.ID).Error; err != nil {
+		t.Fatalf("查找刷新令牌失败: %v", err)
+	}
+	if session.RevokedAt == nil {
+		t.Fatal("重置密码后刷新令牌未被吊销")
+	}
+
+	// 同一链接不能再次使用
+	if got := status.Code(f.reset(token)); got != codes.InvalidArgument {
+		t.Fatalf("再次使用令牌返回%v，期望%v", got, codes.InvalidArgument)
+	}
+	if len(f.auth.calls) != 1 {
+		t.Fatal("已核销的令牌再次调用了认证服务")
+	}
+}
+
+func TestResetPasswordReleasesTokenWhenAuthUnimplemented(t *testing.T) {
+	f := newResetPasswordFixture(t, true)
+	token := f.issue(t)
+
+	f.auth.err = status.Error(codes.Unimplemented, "unknown method ResetUserPassword")
+	if got := status.Code(f.reset(token)); got != codes.PermissionDenied {
+		t.Fatalf("认证服务未实现时返回%v，期望%v", got, codes.PermissionDenied)
+	}
+
+	// 令牌已恢复，认证服务可用后用同一链接重试成功
+	f.auth.err = nil
+	if err := f.reset(token); err != nil {
+		t.Fatalf("恢复令牌后重置密码失败: %v", err)
+	}
+}
+
+func TestResetPasswordDisabledKeepsToken(t *testing.T) {
+	f := newResetPasswordFixture(t, false)
+	token := f.issue(t)
+
+	if got := status.Code(f.reset(token)); got != codes.PermissionDenied {
+		t.Fatalf("未开启重置密码时返回%v，期望%v", got, codes.PermissionDenied)
+	}
+	if len(f.auth.calls) != 0 {
+		t.Fatal("未开启重置密码时调用了认证服务")
+	}
+
+	// 未开启时不核销令牌
+	if _, err := f.tokens.Consume(context.Background(), model.UserTokenPasswordReset, token); err != nil {
+		t.Fatalf("令牌被核销: %v", err)
+	}
+}
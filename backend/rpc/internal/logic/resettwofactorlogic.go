@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type ResetTwoFactorLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewResetTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResetTwoFactorLogic {
+	return &ResetTwoFactorLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ResetTwoFactorLogic) ResetTwoFactor(in *super.ResetTwoFactorReq) (*super.ResetTwoFactorResp, error) {
+	// 1. 查找用户
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id").First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("重置两步验证失败")
+	}
+
+	// 2. 删除密钥和恢复码，用户下次登录只需密码，可重新绑定
+	if err := l.svcCtx.TwoFactor.Disable(l.ctx, user.ID); err != nil {
+		l.Error("重置两步验证失败: ", err)
+		return nil, errorx.Internal("重置两步验证失败")
+	}
+
+	// 3. 记录审计日志
+	operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+	l.Infof("用户两步验证已重置: user_id=%d operator_id=%s", user.ID, in.OperatorId)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditTwoFactorReset,
+		UserID:  user.ID,
+		ActorID: uint(operatorID),
+	})
+
+	return &super.ResetTwoFactorResp{}, nil
+}
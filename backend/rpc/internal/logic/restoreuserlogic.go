@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/config"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/userdeletion"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type RestoreUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreUserLogic {
+	return &RestoreUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RestoreUser 恢复已删除、尚未清除的用户，或撤销用户待清除的注销申请
+func (l *RestoreUserLogic) RestoreUser(in *super.RestoreUserReq) (*super.RestoreUserResp, error) {
+	// 检查AuthClient是否初始化
+	if l.svcCtx.AuthClient == nil {
+		l.Error("AuthClient未初始化")
+		return nil, errorx.Internal("服务器内部错误")
+	}
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 1. 使用外部认证服务时，确认用户在认证服务中仍存在；此前直接删除的用户已无法恢复
+	if l.svcCtx.Config.AuthBackend != config.AuthBackendLocal {
+		if _, err := l.svcCtx.AuthClient.GetUser(l.ctx, &auth.GetUserReq{UserId: in.UserId}); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, errorx.InvalidArgument("认证服务中的用户已删除，无法恢复")
+			}
+			l.Error("调用AuthService获取用户失败: ", err)
+			return nil, errorx.Internal("恢复用户失败，请稍后重试")
+		}
+	}
+
+	// 2. 恢复本地数据并撤销注销记录
+	wasDeleted, err := l.svcCtx.UserDeletion.Restore(l.ctx, uint(userID), time.Now())
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errorx.NotFound("用户不存在或已被彻底清除")
+	case errors.Is(err, userdeletion.ErrNotDeleted):
+		return nil, errorx.InvalidArgument(err.Error())
+	case err != nil:
+		l.Error("恢复用户失败: ", err)
+		return nil, errorx.Internal("恢复用户失败")
+	}
+
+	// 3. 记录审计日志
+	operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+	l.Infof("用户已恢复: user_id=%d was_deleted=%t operator_id=%s", userID, wasDeleted, in.OperatorId)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditUserRestored,
+		UserID:  uint(userID),
+		ActorID: uint(operatorID),
+		Detail: map[string]any{
+			"was_deleted": wasDeleted,
+		},
+	})
+
+	return &super.RestoreUserResp{WasDeleted: wasDeleted}, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type SendVerificationEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendVerificationEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendVerificationEmailLogic {
+	return &SendVerificationEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SendVerificationEmailLogic) SendVerificationEmail(in *super.SendVerificationEmailReq) (*super.SendVerificationEmailResp, error) {
+	// 1. 查找用户
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "username", "email", "email_verified_at").First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("发送验证邮件失败")
+	}
+	if user.EmailVerifiedAt != nil {
+		return nil, errorx.New(409, "邮箱已验证")
+	}
+
+	// 2. 发送验证邮件，之前发送的验证链接随之失效
+	if err := sendVerificationEmail(l.ctx, l.svcCtx, user.ID, user.Username, user.Email); err != nil {
+		l.Error("发送验证邮件失败: ", err)
+		return nil, errorx.Internal("发送验证邮件失败，请稍后重试")
+	}
+
+	return &super.SendVerificationEmailResp{}, nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type SetupTwoFactorLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetupTwoFactorLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetupTwoFactorLogic {
+	return &SetupTwoFactorLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SetupTwoFactorLogic) SetupTwoFactor(in *super.SetupTwoFactorReq) (*super.SetupTwoFactorResp, error) {
+	// 1. 查找用户，验证器应用中以用户名区分账号
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "username").First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("生成两步验证密钥失败")
+	}
+
+	// 2. 生成密钥，确认绑定前不影响登录
+	secret, uri, err := l.svcCtx.TwoFactor.Setup(l.ctx, user.ID, user.Username)
+	if err != nil {
+		if errors.Is(err, twofactor.ErrAlreadyEnabled) {
+			return nil, errorx.AlreadyExists(err.Error())
+		}
+		l.Error("生成两步验证密钥失败: ", err)
+		return nil, errorx.Internal("生成两步验证密钥失败")
+	}
+
+	return &super.SetupTwoFactorResp{
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type SetUserRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserRoleLogic {
+	return &SetUserRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 权限管理服务
+func (l *SetUserRoleLogic) SetUserRole(in *super.SetUserRoleReq) (*super.SetUserRoleResp, error) {
+	// 1. 校验参数
+	if !model.IsValidRole(in.Role) {
+		return nil, errorx.InvalidArgument("无效的角色")
+	}
+	// 防止管理员撤销自己的权限导致无人可以管理角色
+	if in.UserId == in.OperatorId && in.Role != model.RoleAdmin {
+		return nil, errorx.New(403, "不能修改自己的角色")
+	}
+
+	// 2. 查找用户
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("设置角色失败")
+	}
+
+	// 3. 更新角色；已签发的访问Token在过期前仍携带旧角色，刷新Token后生效
+	if user.Role != in.Role {
+		oldRole := user.Role
+		if err := l.svcCtx.DB.WithContext(l.ctx).Model(&user).Update("role", in.Role).Error; err != nil {
+			l.Error("更新用户角色失败: ", err)
+			return nil, errorx.Internal("设置角色失败")
+		}
+		l.Infof("用户角色已变更: user_id=%d old_role=%s role=%s operator_id=%s", user.ID, oldRole, in.Role, in.OperatorId)
+
+		operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+		audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+			Action:  model.AuditRoleChanged,
+			UserID:  user.ID,
+			ActorID: uint(operatorID),
+			Detail:  map[string]any{"old_role": oldRole, "new_role": in.Role},
+		})
+	}
+
+	return &super.SetUserRoleResp{
+		UserId: strconv.FormatUint(uint64(user.ID), 10),
+		Role:   in.Role,
+	}, nil
+}
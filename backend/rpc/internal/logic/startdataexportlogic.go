@@ -0,0 +1,91 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/dataexport"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartDataExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStartDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartDataExportLogic {
+	return &StartDataExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// StartDataExport 申请导出个人数据，由后台任务生成导出文件，客户端轮询状态后下载
+func (l *StartDataExportLogic) StartDataExport(in *super.StartDataExportReq) (*super.DataExportResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 1. 已删除或已注销的账号不能导出
+	closed, err := l.svcCtx.UserDeletion.Closed(l.ctx, uint(userID))
+	if err != nil {
+		l.Error("查询账号注销状态失败: ", err)
+		return nil, errorx.Internal("申请导出失败")
+	}
+	if closed {
+		return nil, errorx.New(403, "账号已删除或已注销")
+	}
+
+	// 2. 已有进行中或未过期的导出时直接返回
+	now := time.Now()
+	export, created, err := l.svcCtx.DataExport.Start(l.ctx, uint(userID), in.Fresh, now)
+	if err != nil {
+		l.Error("创建个人数据导出失败: ", err)
+		return nil, errorx.Internal("申请导出失败")
+	}
+
+	if created {
+		operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+		l.Infof("申请导出个人数据: user_id=%d export_id=%d operator_id=%d", userID, export.ID, operatorID)
+		audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+			Action:  model.AuditDataExported,
+			UserID:  uint(userID),
+			ActorID: uint(operatorID),
+			IP:      in.ClientIp,
+			Detail:  map[string]any{"export_id": export.ID},
+		})
+	}
+
+	return dataExportOf(l.svcCtx.DataExport, export, now), nil
+}
+
+// dataExportOf 转换导出任务，已生成时附带新签发的下载链接
+func dataExportOf(service *dataexport.Service, export *model.DataExport, now time.Time) *super.DataExportResp {
+	resp := &super.DataExportResp{
+		Id:        strconv.FormatUint(uint64(export.ID), 10),
+		Status:    export.Status,
+		Size:      export.Size,
+		CreatedAt: export.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if export.CompletedAt != nil {
+		resp.CompletedAt = export.CompletedAt.Format("2006-01-02 15:04:05")
+	}
+	if export.ExpiresAt != nil {
+		resp.ExpiresAt = export.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+	if url, expiresAt := service.Link(export, now); url != "" {
+		resp.DownloadUrl = url
+		resp.DownloadExpiresAt = expiresAt.Format("2006-01-02 15:04:05")
+	}
+	return resp
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type StartOAuthLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStartOAuthLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartOAuthLogic {
+	return &StartOAuthLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *StartOAuthLogic) StartOAuth(in *super.StartOAuthReq) (*super.StartOAuthResp, error) {
+	// 1. 绑定第三方账号时检查用户是否存在
+	var linkUserID uint
+	if in.LinkUserId != "" {
+		userID, err := strconv.ParseUint(in.LinkUserId, 10, 64)
+		if err != nil {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		var user model.User
+		if err := l.svcCtx.DB.WithContext(l.ctx).Select("id").First(&user, userID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errorx.NotFound("用户不存在")
+			}
+			l.Error("查找用户失败: ", err)
+			return nil, errorx.Internal("跳转第三方登录失败")
+		}
+		linkUserID = user.ID
+	}
+
+	// 2. 生成授权状态并返回授权页地址
+	authURL, err := l.svcCtx.OIDC.Begin(l.ctx, in.Provider, linkUserID, time.Now())
+	if err != nil {
+		if errors.Is(err, oidc.ErrUnknownProvider) {
+			return nil, errorx.InvalidArgument(err.Error())
+		}
+		l.Error("生成第三方授权地址失败: ", err)
+		return nil, errorx.Internal("跳转第三方登录失败，请稍后重试")
+	}
+
+	return &super.StartOAuthResp{
+		AuthorizationUrl: authURL,
+	}, nil
+}
@@ -45,7 +45,8 @@ func (l *SyncUserVipStatusLogic) SyncUserVipStatus(in *super.SyncUserVipStatusRe
 		if user.VipEndAt.Before(time.Now()) {
 			isVip = false
 			user.IsVip = false
-			l.svcCtx.DB.Save(&user)
+			// 只更新VIP状态，避免覆盖并发修改的其他字段
+			l.svcCtx.DB.Model(&user).Update("is_vip", false)
 		}
 	}
 
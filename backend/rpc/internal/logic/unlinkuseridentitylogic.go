@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnlinkUserIdentityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnlinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlinkUserIdentityLogic {
+	return &UnlinkUserIdentityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UnlinkUserIdentityLogic) UnlinkUserIdentity(in *super.UnlinkUserIdentityReq) (*super.UnlinkUserIdentityResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 64)
+	if err != nil {
+		return nil, errorx.NotFound("用户不存在")
+	}
+
+	// 解绑后仍可使用密码登录，通过第三方登录注册的用户可先通过忘记密码设置密码
+	result := l.svcCtx.DB.WithContext(l.ctx).
+		Where("user_id = ? AND provider = ?", userID, in.Provider).
+		Delete(&model.UserIdentity{})
+	if result.Error != nil {
+		l.Error("解绑第三方账号失败: ", result.Error)
+		return nil, errorx.Internal("解绑第三方账号失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errorx.NotFound("未绑定该登录方式")
+	}
+
+	l.Infof("用户解绑第三方账号: user_id=%d provider=%s", userID, in.Provider)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditIdentityUnlinked,
+		UserID:  uint(userID),
+		ActorID: uint(userID),
+		Detail:  map[string]any{"provider": in.Provider},
+	})
+
+	return &super.UnlinkUserIdentityResp{}, nil
+}
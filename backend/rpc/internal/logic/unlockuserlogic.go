@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type UnlockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlockUserLogic {
+	return &UnlockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UnlockUserLogic) UnlockUser(in *super.UnlockUserReq) (*super.UnlockUserResp, error) {
+	// 1. 查找用户
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id").First(&user, in.UserId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("解除锁定失败")
+	}
+
+	// 2. 解除锁定并清零失败次数
+	wasLocked, err := l.svcCtx.LoginGuard.Unlock(l.ctx, user.ID, time.Now())
+	if err != nil {
+		l.Error("解除账号锁定失败: ", err)
+		return nil, errorx.Internal("解除锁定失败")
+	}
+
+	// 3. 记录审计日志
+	operatorID, _ := strconv.ParseUint(in.OperatorId, 10, 64)
+	l.Infof("账号登录锁定已解除: user_id=%d was_locked=%t operator_id=%s", user.ID, wasLocked, in.OperatorId)
+	audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+		Action:  model.AuditAccountUnlocked,
+		UserID:  user.ID,
+		ActorID: uint(operatorID),
+		Detail:  map[string]any{"was_locked": wasLocked},
+	})
+
+	return &super.UnlockUserResp{
+		UserId:    strconv.FormatUint(uint64(user.ID), 10),
+		WasLocked: wasLocked,
+	}, nil
+}
@@ -2,13 +2,20 @@ package logic
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
+	"backend/model"
 	"backend/rpc/internal/errorx"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/auth"
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type UpdateUserInfoLogic struct {
@@ -32,6 +39,33 @@ func (l *UpdateUserInfoLogic) UpdateUserInfo(in *super.UpdateUserInfoReq) (*supe
 		return nil, errorx.Internal("服务器内部错误")
 	}
 
+	// 时区用于计算AI额度的重置时间，必须是有效的IANA时区
+	if in.Timezone != "" {
+		if _, err := time.LoadLocation(in.Timezone); err != nil {
+			return nil, errorx.InvalidArgument("无效的时区")
+		}
+	}
+
+	// 修改邮箱后需要重新验证，先记录原邮箱
+	var before model.User
+	if in.Email != "" {
+		if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "email", "email_verified_at").First(&before, in.UserId).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			l.Error("查找用户失败: ", err)
+			return nil, errorx.Internal("更新用户信息失败，请稍后重试")
+		}
+	}
+
+	// 修改邮箱前先清除验证状态，清除失败时不修改邮箱：
+	// 否则新邮箱会沿用原邮箱的验证状态，第三方登录会按未验证的新邮箱自动关联到该账号
+	cleared := false
+	if before.ID != 0 && before.EmailVerifiedAt != nil && strings.TrimSpace(in.Email) != before.Email {
+		if err := l.svcCtx.DB.WithContext(l.ctx).Model(&before).Update("email_verified_at", nil).Error; err != nil {
+			l.Error("清除邮箱验证状态失败: ", err)
+			return nil, errorx.Internal("更新用户信息失败，请稍后重试")
+		}
+		cleared = true
+	}
+
 	// 调用外部AuthService的UpdateUserInfo方法
 	authResp, err := l.svcCtx.AuthClient.UpdateUserInfo(l.ctx, &auth.UpdateUserInfoReq{
 		UserId:   in.UserId,
@@ -41,9 +75,38 @@ func (l *UpdateUserInfoLogic) UpdateUserInfo(in *super.UpdateUserInfoReq) (*supe
 	})
 	if err != nil {
 		l.Error("调用AuthService更新用户信息失败: ", err)
+		// 邮箱未修改，恢复原邮箱的验证状态
+		if cleared {
+			if err := l.svcCtx.DB.WithContext(context.WithoutCancel(l.ctx)).Model(&model.User{}).
+				Where("id = ? AND email = ? AND email_verified_at IS NULL", before.ID, before.Email).
+				Update("email_verified_at", before.EmailVerifiedAt).Error; err != nil {
+				l.Error("恢复邮箱验证状态失败: ", err)
+			}
+		}
+		// 用户名或邮箱已被占用、用户不存在时直接返回原因
+		if c := status.Code(err); c == codes.AlreadyExists || c == codes.NotFound {
+			return nil, err
+		}
 		return nil, errorx.Internal("更新用户信息失败，请稍后重试")
 	}
 
+	// 时区只保存在本地用户表
+	if in.Timezone != "" {
+		if err := l.svcCtx.DB.WithContext(l.ctx).Model(&model.User{}).
+			Where("id = ?", in.UserId).
+			Update("timezone", in.Timezone).Error; err != nil {
+			l.Error("更新用户时区失败: ", err)
+			return nil, errorx.Internal("更新用户信息失败，请稍后重试")
+		}
+	}
+
+	// 邮箱已变更：向新邮箱发送验证邮件
+	if before.ID != 0 && authResp.User.Email != before.Email {
+		if err := sendVerificationEmail(l.ctx, l.svcCtx, before.ID, authResp.User.Username, authResp.User.Email); err != nil {
+			l.Error("发送验证邮件失败: ", err)
+		}
+	}
+
 	// 将外部服务的响应转换为主服务的响应格式
 	return &super.UpdateUserInfoResp{
 		User: &super.User{
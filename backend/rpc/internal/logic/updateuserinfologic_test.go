@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/localauth"
+	"backend/rpc/internal/mailer"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/internal/usertoken"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateUserInfoEmailChangeClearsVerification(t *testing.T) {
+	tables := []any{&model.UserToken{}}
+	db := testdb.Open(t, tables...)
+	user := testdb.CreateUser(t, db, tables...)
+	other := testdb.CreateUser(t, db, tables...)
+
+	verifiedAt := time.Now().Truncate(time.Second)
+	if err := db.Model(user).Update("email_verified_at", verifiedAt).Error; err != nil {
+		t.Fatalf("设置邮箱验证时间失败: %v", err)
+	}
+	tokens, err := usertoken.New(db, usertoken.Config{
+		Secret:           "0123456789abcdef0123456789abcdef",
+		VerifyEmailTTL:   60,
+		PasswordResetTTL: 60,
+	})
+	if err != nil {
+		t.Fatalf("创建令牌签发器失败: %v", err)
+	}
+	mails := mailer.NewMemoryMailer()
+	l := NewUpdateUserInfoLogic(context.Background(), &svc.ServiceContext{
+		DB:         db,
+		AuthClient: localauth.New(db),
+		UserTokens: tokens,
+		Mailer:     mails,
+	})
+	update := func(email string) error {
+		_, err := l.UpdateUserInfo(&super.UpdateUserInfoReq{UserId: strconv.FormatUint(uint64(user.ID), 10), Email: email})
+		return err
+	}
+	verified := func() *time.Time {
+		var current model.User
+		if err := db.Select("id", "email_verified_at").First(&current, user.ID).Error; err != nil {
+			t.Fatalf("查找用户失败: %v", err)
+		}
+		return current.EmailVerifiedAt
+	}
+
+	// 修改失败时邮箱未变，保留验证状态
+	if got := status.Code(update(other.Email)); got != codes.AlreadyExists {
+		t.Fatalf("改为其他用户的邮箱返回%v，期望%v", got, codes.AlreadyExists)
+	}
+	if verified() == nil {
+		t.Fatal("修改邮箱失败后验证状态被清除")
+	}
+
+	// 修改成功后清除验证状态，并向新邮箱发送验证邮件
+	email := "changed_" + user.Email
+	if err := update(email); err != nil {
+		t.Fatalf("修改邮箱失败: %v", err)
+	}
+	if verified() != nil {
+		t.Fatal("修改邮箱后仍保留原邮箱的验证状态")
+	}
+	if _, ok := mails.Last(email); !ok {
+		t.Fatal("未向新邮箱发送验证邮件")
+	}
+}
@@ -2,16 +2,18 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"time"
 
 	"backend/model"
+	"backend/rpc/internal/entitlement"
 	"backend/rpc/internal/errorx"
 	"backend/rpc/internal/svc"
-	"backend/rpc/pb/auth"
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
 type UpdateUserVipLogic struct {
@@ -28,120 +30,73 @@ func NewUpdateUserVipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Upd
 	}
 }
 
+// UpdateUserVip 管理员开通或取消用户VIP
+// 开通时按套餐时长计算有效期，用户已有VIP时有效期顺延；取消时所有VIP记录失效
 func (l *UpdateUserVipLogic) UpdateUserVip(in *super.UpdateUserVipReq) (*super.UpdateUserVipResp, error) {
-	// 检查AuthClient是否初始化
-	if l.svcCtx.AuthClient == nil {
-		l.Error("AuthClient未初始化")
-		return nil, errorx.Internal("服务器内部错误")
-	}
-
-	// 1. 调用外部AuthService的UpdateUserVip方法
-	authResp, err := l.svcCtx.AuthClient.UpdateUserVip(l.ctx, &auth.UpdateUserVipReq{
-		UserId:     in.UserId,
-		IsVip:      in.IsVip,
-		VipExpires: in.VipExpires,
-	})
-	if err != nil {
-		l.Error("调用AuthService更新用户VIP状态失败: ", err)
-		return nil, errorx.Internal("更新用户VIP状态失败，请稍后重试")
-	}
-
-	// 2. 同步更新本地数据库中的用户VIP状态
-	// 查找用户
-	var user model.User
 	userID, err := strconv.ParseUint(in.UserId, 10, 64)
 	if err != nil {
-		l.Error("解析用户ID失败: ", err)
 		return nil, errorx.NotFound("用户不存在")
 	}
 
-	result := l.svcCtx.DB.First(&user, userID)
-	if result.Error != nil {
-		l.Error("查找用户失败: ", result.Error)
-		// 外部服务已更新成功，本地同步失败不影响主流程
-		return &super.UpdateUserVipResp{
-			User: &super.User{
-				Id:           authResp.User.Id,
-				Username:     authResp.User.Username,
-				Email:        authResp.User.Email,
-				Avatar:       authResp.User.Avatar,
-				CreatedAt:    authResp.User.CreatedAt,
-				UpdatedAt:    authResp.User.UpdatedAt,
-				IsVip:        authResp.User.IsVip,
-				VipExpiresAt: authResp.User.VipExpiresAt,
-				AutoRenew:    authResp.User.AutoRenew,
-			},
-		}, nil
-	}
-
-	// 3. 更新本地用户VIP状态和记录
+	// 1. 开通VIP时需要指定套餐
+	var plan model.VipPlan
 	if in.IsVip {
-		// 激活VIP，创建VIP记录
-		// 解析VIP过期时间
-		vipExpires, err := time.Parse("2006-01-02 15:04:05", in.VipExpires)
-		if err != nil {
-			l.Error("解析VIP过期时间失败: ", err)
-			// 外部服务已更新成功，本地同步失败不影响主流程
-			return &super.UpdateUserVipResp{
-				User: &super.User{
-					Id:           authResp.User.Id,
-					Username:     authResp.User.Username,
-					Email:        authResp.User.Email,
-					Avatar:       authResp.User.Avatar,
-					CreatedAt:    authResp.User.CreatedAt,
-					UpdatedAt:    authResp.User.UpdatedAt,
-					IsVip:        authResp.User.IsVip,
-					VipExpiresAt: authResp.User.VipExpiresAt,
-					AutoRenew:    authResp.User.AutoRenew,
-				},
-			}, nil
+		if in.PlanId == "" {
+			return nil, errorx.InvalidArgument("开通VIP需要指定套餐")
 		}
-
-		// 将用户所有VIP记录设置为非激活
-		l.svcCtx.DB.Model(&model.VipRecord{}).Where("user_id = ?", user.ID).Update("is_active", false)
-
-		// 创建新的VIP记录
-		vipStart := time.Now()
-		vipRecord := model.VipRecord{
-			UserID:   user.ID,
-			PlanID:   1, // 默认套餐ID，后续可以根据实际情况修改
-			IsActive: true,
-			StartAt:  vipStart,
-			EndAt:    vipExpires,
+		if err := l.svcCtx.DB.WithContext(l.ctx).First(&plan, in.PlanId).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errorx.NotFound("VIP套餐不存在")
+			}
+			l.Error("查找VIP套餐失败: ", err)
+			return nil, errorx.Internal("更新用户VIP状态失败")
 		}
-
-		// 保存VIP记录
-		l.svcCtx.DB.Create(&vipRecord)
-
-		// 更新用户VIP状态
-		user.IsVip = true
-		user.VipStartAt = &vipStart
-		user.VipEndAt = &vipExpires
-	} else {
-		// 非激活状态，将用户VIP状态设置为false
-		user.IsVip = false
-		user.VipStartAt = nil
-		user.VipEndAt = nil
-
-		// 将用户所有VIP记录设置为非激活
-		l.svcCtx.DB.Model(&model.VipRecord{}).Where("user_id = ?", user.ID).Update("is_active", false)
 	}
 
-	// 保存用户信息
-	l.svcCtx.DB.Save(&user)
+	// 2. 在同一事务中更新VIP记录和用户VIP状态，并记录同步认证服务的操作
+	var user *model.User
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if in.IsVip {
+			user, _, err = entitlement.Activate(tx, uint(userID), &plan, 0, time.Now())
+		} else {
+			user, err = entitlement.Revoke(tx, uint(userID))
+		}
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		if errors.Is(err, entitlement.ErrInvalidPlanDuration) {
+			return nil, errorx.InvalidArgument(err.Error())
+		}
+		l.Error("更新用户VIP状态失败: ", err)
+		return nil, errorx.Internal("更新用户VIP状态失败，请稍后重试")
+	}
 
-	// 构建响应
-	return &super.UpdateUserVipResp{
+	vipExpiresAt := ""
+	if user.VipEndAt != nil {
+		vipExpiresAt = user.VipEndAt.Format("2006-01-02 15:04:05")
+	}
+	resp := &super.UpdateUserVipResp{
 		User: &super.User{
-			Id:           authResp.User.Id,
-			Username:     authResp.User.Username,
-			Email:        authResp.User.Email,
-			Avatar:       authResp.User.Avatar,
-			CreatedAt:    authResp.User.CreatedAt,
-			UpdatedAt:    authResp.User.UpdatedAt,
-			IsVip:        authResp.User.IsVip,
-			VipExpiresAt: authResp.User.VipExpiresAt,
-			AutoRenew:    authResp.User.AutoRenew,
+			Id:           strconv.FormatUint(uint64(user.ID), 10),
+			Username:     user.Username,
+			Email:        user.Email,
+			Avatar:       user.Avatar,
+			CreatedAt:    user.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt:    user.UpdatedAt.Format("2006-01-02 15:04:05"),
+			IsVip:        user.IsVip,
+			VipExpiresAt: vipExpiresAt,
+			Role:         user.Role,
 		},
-	}, nil
+	}
+
+	// 3. 立即同步认证服务的VIP状态，失败不影响本地结果，由后台任务重试
+	if err := l.svcCtx.Outbox.DeliverNew(l.ctx, user.ID, time.Now()); err != nil {
+		l.Error("同步AuthService用户VIP状态失败，稍后重试: ", err)
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/mailer"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/usertoken"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type VerifyEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyEmailLogic {
+	return &VerifyEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *VerifyEmailLogic) VerifyEmail(in *super.VerifyEmailReq) (*super.VerifyEmailResp, error) {
+	// 1. 核销令牌
+	token, err := l.svcCtx.UserTokens.Consume(l.ctx, model.UserTokenVerifyEmail, in.Token)
+	if err != nil {
+		if errors.Is(err, usertoken.ErrInvalid) {
+			return nil, errorx.InvalidArgument("验证链接无效或已过期，请重新发送验证邮件")
+		}
+		l.Error("核销邮箱验证令牌失败: ", err)
+		return nil, errorx.Internal("验证邮箱失败")
+	}
+
+	// 2. 令牌签发后用户修改过邮箱时，旧邮箱的验证链接不再有效
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "email", "email_verified_at").First(&user, token.UserID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("验证邮箱失败")
+	}
+	if user.Email != token.Email {
+		return nil, errorx.InvalidArgument("邮箱已变更，请重新发送验证邮件")
+	}
+
+	// 3. 标记邮箱已验证
+	if user.EmailVerifiedAt == nil {
+		if err := l.svcCtx.DB.WithContext(l.ctx).Model(&user).Update("email_verified_at", time.Now()).Error; err != nil {
+			l.Error("更新邮箱验证状态失败: ", err)
+			return nil, errorx.Internal("验证邮箱失败")
+		}
+	}
+
+	return &super.VerifyEmailResp{
+		UserId: strconv.FormatUint(uint64(user.ID), 10),
+		Email:  user.Email,
+	}, nil
+}
+
+// sendVerificationEmail 签发邮箱验证令牌并发送验证邮件
+func sendVerificationEmail(ctx context.Context, svcCtx *svc.ServiceContext, userID uint, username, email string) error {
+	token, err := svcCtx.UserTokens.Issue(ctx, userID, model.UserTokenVerifyEmail, email)
+	if err != nil {
+		return fmt.Errorf("签发邮箱验证令牌失败: %w", err)
+	}
+
+	link := svcCtx.Config.WebBaseURL + "/verify-email?token=" + url.QueryEscape(token)
+	return svcCtx.Mailer.Send(ctx, &mailer.Message{
+		To:      email,
+		Subject: "验证您的邮箱",
+		Body: fmt.Sprintf("%s，您好：\n\n请在%s内打开以下链接完成邮箱验证：\n%s\n\n如果这不是您的操作，请忽略本邮件。\n",
+			username, formatTTL(svcCtx.UserTokens.TTL(model.UserTokenVerifyEmail)), link),
+	})
+}
+
+// formatTTL 将令牌有效期格式化为邮件中的文案
+func formatTTL(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "小时"
+	}
+	return strconv.FormatInt(int64((d+time.Minute-1)/time.Minute), 10) + "分钟"
+}
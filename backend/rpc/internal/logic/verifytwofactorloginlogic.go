@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/audit"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyTwoFactorLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyTwoFactorLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyTwoFactorLoginLogic {
+	return &VerifyTwoFactorLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *VerifyTwoFactorLoginLogic) VerifyTwoFactorLogin(in *super.VerifyTwoFactorLoginReq) (*super.LoginResp, error) {
+	// 检查AuthClient是否初始化
+	if l.svcCtx.AuthClient == nil {
+		l.Error("AuthClient未初始化")
+		return nil, errorx.New(500, "服务未初始化")
+	}
+
+	// 1. 查找密码验证通过时签发的挑战
+	now := time.Now()
+	challenge, err := l.svcCtx.TwoFactor.ResolveChallenge(l.ctx, in.ChallengeToken, now)
+	if err != nil {
+		if errors.Is(err, twofactor.ErrInvalidChallenge) {
+			return nil, errorx.Unauthenticated(err.Error())
+		}
+		l.Error("查找两步验证挑战失败: ", err)
+		return nil, errorx.Internal("登录失败")
+	}
+
+	// 2. 验证码错误与密码错误共用失败计数，同样触发退避和账号锁定
+	attempt := loginguard.Attempt{UserID: challenge.UserID, IP: in.ClientIp}
+	if err := checkLoginAttempt(l.ctx, l.svcCtx, attempt, now); err != nil {
+		return nil, err
+	}
+
+	// 3. 校验验证码或恢复码
+	usedRecovery, err := l.svcCtx.TwoFactor.Verify(l.ctx, challenge.UserID, in.Code, now)
+	if err != nil {
+		switch {
+		case errors.Is(err, twofactor.ErrInvalidCode):
+			message := "验证码错误"
+			exhausted, err := l.svcCtx.TwoFactor.FailChallenge(l.ctx, challenge, now)
+			if err != nil {
+				l.Error("记录验证码错误次数失败: ", err)
+			}
+			if exhausted {
+				message = "验证码错误次数过多，请重新登录"
+			}
+			return nil, recordLoginFailure(l.ctx, l.svcCtx, attempt, now, message)
+		case errors.Is(err, twofactor.ErrNotEnabled):
+			// 挑战签发后两步验证已被关闭或重置
+			return nil, errorx.Unauthenticated(twofactor.ErrInvalidChallenge.Error())
+		default:
+			l.Error("校验两步验证码失败: ", err)
+			return nil, errorx.Internal("登录失败")
+		}
+	}
+
+	// 4. 作废挑战，同一挑战只能完成一次登录
+	if err := l.svcCtx.TwoFactor.CompleteChallenge(l.ctx, challenge, now); err != nil {
+		if errors.Is(err, twofactor.ErrInvalidChallenge) {
+			return nil, errorx.Unauthenticated(err.Error())
+		}
+		l.Error("完成两步验证挑战失败: ", err)
+		return nil, errorx.Internal("登录失败")
+	}
+	if err := l.svcCtx.LoginGuard.Succeed(l.ctx, attempt); err != nil {
+		l.Error("清零登录失败次数失败: ", err)
+	}
+	if usedRecovery {
+		l.Infof("用户使用恢复码完成两步验证: user_id=%d", challenge.UserID)
+		audit.Record(l.ctx, l.svcCtx.DB, audit.Event{
+			Action:  model.AuditRecoveryCodeUsed,
+			UserID:  challenge.UserID,
+			ActorID: challenge.UserID,
+			IP:      in.ClientIp,
+		})
+	}
+
+	// 5. 获取用户信息并签发Token
+	userResp, err := l.svcCtx.AuthClient.GetUser(l.ctx, &auth.GetUserReq{
+		UserId: strconv.FormatUint(uint64(challenge.UserID), 10),
+	})
+	if err != nil {
+		l.Error("调用AuthService获取用户失败: ", err)
+		return nil, errorx.Internal("登录失败")
+	}
+	return completeLogin(l.ctx, l.svcCtx, userResp.User)
+}
@@ -0,0 +1,247 @@
+// Package loginguard 防止登录暴力破解：按账号和来源IP记录连续失败，指数退避拒绝后续尝试，账号失败过多时临时锁定
+// 密码校验前调用Check，失败后调用Fail，成功后调用Succeed；管理员可通过Unlock解除锁定
+package loginguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Config 登录防暴力破解配置
+type Config struct {
+	BackoffAfter   int   `json:",default=3"`    // 同一账号连续失败多少次后开始退避
+	IPBackoffAfter int   `json:",default=20"`   // 同一IP连续失败多少次后开始退避，多个用户可能共用出口IP，阈值应高于账号
+	BaseDelay      int64 `json:",default=1"`    // 首次退避时长（秒），之后每失败一次翻倍
+	MaxDelay       int64 `json:",default=300"`  // 退避时长上限（秒）
+	LockThreshold  int   `json:",default=10"`   // 同一账号连续失败多少次后锁定，0表示不锁定
+	LockDuration   int64 `json:",default=900"`  // 账号锁定时长（秒）
+	FailureWindow  int64 `json:",default=3600"` // 距最后一次失败超过该时长（秒）后失败次数清零
+}
+
+// Attempt 一次登录尝试
+type Attempt struct {
+	Login  string // 登录名（用户名或邮箱）
+	UserID uint   // 登录名对应的用户ID，用户不存在时为0
+	IP     string // 来源IP，未知时为空
+}
+
+// BlockedError 登录尝试被拒绝
+type BlockedError struct {
+	Locked     bool          // 账号已锁定，否则为退避中
+	RetryAfter time.Duration // 距可以再次尝试的时长
+}
+
+func (e *BlockedError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("登录失败次数过多，账号已被临时锁定，请%s后重试或联系管理员", formatWait(e.RetryAfter))
+	}
+	return fmt.Sprintf("登录尝试过于频繁，请%s后重试", formatWait(e.RetryAfter))
+}
+
+// Failure 一次失败登录的记录结果
+type Failure struct {
+	Failures    int        // 账号连续失败次数
+	LockedUntil *time.Time // 本次失败使账号被锁定时为锁定截止时间
+}
+
+// Guard 按账号和来源IP记录连续登录失败，失败越多下次尝试需要等待越久，账号失败次数达到阈值后临时锁定
+// 计数保存在数据库中，多个实例共享
+type Guard struct {
+	db *gorm.DB
+	c  Config
+}
+
+// New 创建登录防护
+func New(db *gorm.DB, c Config) (*Guard, error) {
+	if c.BackoffAfter <= 0 || c.IPBackoffAfter <= 0 {
+		return nil, errors.New("登录防护的退避阈值必须大于0")
+	}
+	if c.BaseDelay <= 0 || c.MaxDelay < c.BaseDelay {
+		return nil, errors.New("登录防护的退避时长无效")
+	}
+	if c.LockThreshold < 0 || (c.LockThreshold > 0 && c.LockDuration <= 0) {
+		return nil, errors.New("登录防护的锁定配置无效")
+	}
+	if c.FailureWindow <= 0 {
+		return nil, errors.New("登录防护的失败计数窗口必须大于0")
+	}
+	return &Guard{db: db, c: c}, nil
+}
+
+// Check 在校验密码前检查是否允许本次尝试，账号锁定或退避中时返回*BlockedError
+func (g *Guard) Check(ctx context.Context, a Attempt, now time.Time) error {
+	var rows []model.LoginThrottle
+	if err := g.db.WithContext(ctx).Where(g.keysOf(a)).Find(&rows).Error; err != nil {
+		return err
+	}
+
+	var wait time.Duration
+	for _, row := range rows {
+		if g.stale(&row, now) {
+			continue
+		}
+		if row.LockedUntil != nil {
+			return &BlockedError{Locked: true, RetryAfter: row.LockedUntil.Sub(now)}
+		}
+		if row.LastFailedAt == nil {
+			continue
+		}
+		if w := row.LastFailedAt.Add(g.delay(&row)).Sub(now); w > wait {
+			wait = w
+		}
+	}
+	if wait > 0 {
+		return &BlockedError{RetryAfter: wait}
+	}
+	return nil
+}
+
+// Fail 记录一次密码错误，账号失败次数达到阈值时锁定账号
+func (g *Guard) Fail(ctx context.Context, a Attempt, now time.Time) (*Failure, error) {
+	var failure Failure
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		account, err := g.fail(tx, model.LoginThrottleAccount, g.accountOf(a), a.UserID, now)
+		if err != nil {
+			return err
+		}
+		failure.Failures = account.Failures
+		if g.c.LockThreshold > 0 && account.LockedUntil == nil && account.Failures >= g.c.LockThreshold {
+			lockedUntil := now.Add(time.Duration(g.c.LockDuration) * time.Second)
+			if err := tx.Model(account).Update("locked_until", lockedUntil).Error; err != nil {
+				return err
+			}
+			failure.LockedUntil = &lockedUntil
+		}
+
+		if a.IP != "" {
+			if _, err := g.fail(tx, model.LoginThrottleIP, a.IP, 0, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &failure, nil
+}
+
+// Succeed 登录成功后清零账号的失败次数；来源IP的计数不清零，避免用一个已知账号为其他账号的猜测解除退避
+func (g *Guard) Succeed(ctx context.Context, a Attempt) error {
+	return g.db.WithContext(ctx).
+		Where("scope = ? AND identifier = ?", model.LoginThrottleAccount, g.accountOf(a)).
+		Delete(&model.LoginThrottle{}).Error
+}
+
+// Unlock 解除用户的账号锁定并清零失败次数，返回解除前是否处于锁定中
+func (g *Guard) Unlock(ctx context.Context, userID uint, now time.Time) (bool, error) {
+	identifier := g.accountOf(Attempt{UserID: userID})
+
+	var locked int64
+	if err := g.db.WithContext(ctx).Model(&model.LoginThrottle{}).
+		Where("scope = ? AND identifier = ? AND locked_until > ?", model.LoginThrottleAccount, identifier, now).
+		Count(&locked).Error; err != nil {
+		return false, err
+	}
+	if err := g.db.WithContext(ctx).
+		Where("scope = ? AND identifier = ?", model.LoginThrottleAccount, identifier).
+		Delete(&model.LoginThrottle{}).Error; err != nil {
+		return false, err
+	}
+	return locked > 0, nil
+}
+
+// fail 行锁读取计数并加一，已过期的计数先清零
+func (g *Guard) fail(tx *gorm.DB, scope, identifier string, userID uint, now time.Time) (*model.LoginThrottle, error) {
+	row := model.LoginThrottle{Scope: scope, Identifier: identifier}
+	if userID != 0 {
+		row.UserID = &userID
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&row).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("scope = ? AND identifier = ?", scope, identifier).
+		First(&row).Error; err != nil {
+		return nil, err
+	}
+
+	if g.stale(&row, now) {
+		row.Failures = 0
+		row.LockedUntil = nil
+	}
+	row.Failures++
+	row.LastFailedAt = &now
+	if err := tx.Model(&row).Select("failures", "last_failed_at", "locked_until").Updates(&row).Error; err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+// stale 计数是否已失效：锁定已到期，或距最后一次失败已超过计数窗口
+func (g *Guard) stale(row *model.LoginThrottle, now time.Time) bool {
+	if row.LockedUntil != nil {
+		return !now.Before(*row.LockedUntil)
+	}
+	return row.LastFailedAt == nil || now.Sub(*row.LastFailedAt) >= time.Duration(g.c.FailureWindow)*time.Second
+}
+
+// delay 连续失败后下次尝试前需要等待的时长，未达到退避阈值时为0
+func (g *Guard) delay(row *model.LoginThrottle) time.Duration {
+	after := g.c.BackoffAfter
+	if row.Scope == model.LoginThrottleIP {
+		after = g.c.IPBackoffAfter
+	}
+	if row.Failures < after {
+		return 0
+	}
+
+	// 达到上限后不再翻倍，避免失败次数很大时溢出
+	delay := g.c.BaseDelay
+	for i := after; i < row.Failures && delay < g.c.MaxDelay; i++ {
+		delay *= 2
+	}
+	return time.Duration(min(delay, g.c.MaxDelay)) * time.Second
+}
+
+// keysOf 尝试涉及的账号和IP计数行
+func (g *Guard) keysOf(a Attempt) clause.Expression {
+	account := clause.And(
+		clause.Eq{Column: "scope", Value: model.LoginThrottleAccount},
+		clause.Eq{Column: "identifier", Value: g.accountOf(a)},
+	)
+	if a.IP == "" {
+		return account
+	}
+	return clause.Or(account, clause.And(
+		clause.Eq{Column: "scope", Value: model.LoginThrottleIP},
+		clause.Eq{Column: "identifier", Value: a.IP},
+	))
+}
+
+// accountOf 账号的计数标识：用户存在时按用户ID计数，使用户名和邮箱登录共享计数；
+// 用户不存在时按登录名计数，使不存在的账号与存在的账号表现一致
+func (g *Guard) accountOf(a Attempt) string {
+	if a.UserID != 0 {
+		return "id:" + strconv.FormatUint(uint64(a.UserID), 10)
+	}
+	return "login:" + strings.ToLower(strings.TrimSpace(a.Login))
+}
+
+// formatWait 将等待时长格式化为提示文案，不足一秒按一秒计
+func formatWait(d time.Duration) string {
+	seconds := int64((d + time.Second - 1) / time.Second)
+	if seconds < 60 {
+		return strconv.FormatInt(max(seconds, 1), 10) + "秒"
+	}
+	return strconv.FormatInt((seconds+59)/60, 10) + "分钟"
+}
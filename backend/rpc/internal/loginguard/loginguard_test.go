@@ -0,0 +1,282 @@
+package loginguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+var testConfig = Config{
+	BackoffAfter:   3,
+	IPBackoffAfter: 5,
+	BaseDelay:      1,
+	MaxDelay:       10,
+	LockThreshold:  6,
+	LockDuration:   900,
+	FailureWindow:  3600,
+}
+
+// 达到退避阈值后每多失败一次等待时长翻倍，达到MaxDelay后不再增加
+func TestDelayDoublesAndCapsAtMaxDelay(t *testing.T) {
+	g := &Guard{c: testConfig}
+
+	tests := []struct {
+		scope    string
+		failures int
+		want     time.Duration
+	}{
+		{model.LoginThrottleAccount, 2, 0},
+		{model.LoginThrottleAccount, 3, 1 * time.Second},
+		{model.LoginThrottleAccount, 4, 2 * time.Second},
+		{model.LoginThrottleAccount, 5, 4 * time.Second},
+		{model.LoginThrottleAccount, 6, 8 * time.Second},
+		{model.LoginThrottleAccount, 7, 10 * time.Second},
+		{model.LoginThrottleAccount, 1000, 10 * time.Second},
+		// IP使用更高的退避阈值
+		{model.LoginThrottleIP, 4, 0},
+		{model.LoginThrottleIP, 5, 1 * time.Second},
+		{model.LoginThrottleIP, 6, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		row := &model.LoginThrottle{Scope: tt.scope, Failures: tt.failures}
+		if got := g.delay(row); got != tt.want {
+			t.Errorf("%s失败%d次的退避时长 = %v，期望 %v", tt.scope, tt.failures, got, tt.want)
+		}
+	}
+}
+
+// 锁定到期或距最后一次失败超过计数窗口后，计数失效
+func TestStale(t *testing.T) {
+	g := &Guard{c: testConfig}
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(d)
+		return &v
+	}
+
+	tests := []struct {
+		name string
+		row  model.LoginThrottle
+		want bool
+	}{
+		{"从未失败", model.LoginThrottle{}, true},
+		{"窗口内的失败", model.LoginThrottle{LastFailedAt: at(-59 * time.Minute)}, false},
+		{"超过窗口的失败", model.LoginThrottle{LastFailedAt: at(-time.Hour)}, true},
+		{"锁定中", model.LoginThrottle{LastFailedAt: at(-2 * time.Hour), LockedUntil: at(time.Minute)}, false},
+		{"锁定已到期", model.LoginThrottle{LastFailedAt: at(-time.Minute), LockedUntil: at(0)}, true},
+	}
+
+	for _, tt := range tests {
+		if got := g.stale(&tt.row, now); got != tt.want {
+			t.Errorf("%s: stale = %v，期望 %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewRejectsInvalidConfig(t *testing.T) {
+	invalid := []func(c *Config){
+		func(c *Config) { c.BackoffAfter = 0 },
+		func(c *Config) { c.IPBackoffAfter = 0 },
+		func(c *Config) { c.BaseDelay = 0 },
+		func(c *Config) { c.MaxDelay = c.BaseDelay - 1 },
+		func(c *Config) { c.LockThreshold = -1 },
+		func(c *Config) { c.LockDuration = 0 },
+		func(c *Config) { c.FailureWindow = 0 },
+	}
+
+	for i, mutate := range invalid {
+		c := testConfig
+		mutate(&c)
+		if _, err := New(nil, c); err == nil {
+			t.Errorf("第%d个无效配置未被拒绝", i)
+		}
+	}
+	if _, err := New(nil, testConfig); err != nil {
+		t.Fatalf("有效配置被拒绝: %v", err)
+	}
+}
+
+func openTestGuard(t *testing.T) (*Guard, *gorm.DB) {
+	t.Helper()
+
+	db := testdb.Open(t, &model.LoginThrottle{})
+	g, err := New(db, testConfig)
+	if err != nil {
+		t.Fatalf("创建登录防护失败: %v", err)
+	}
+	return g, db
+}
+
+// newAttempt 不存在的账号和独立的来源IP，测试结束时删除计数
+func newAttempt(t *testing.T, db *gorm.DB) Attempt {
+	t.Helper()
+
+	id := time.Now().UnixNano()
+	a := Attempt{Login: fmt.Sprintf("guard_%d", id), IP: fmt.Sprintf("test-%d", id)}
+	t.Cleanup(func() {
+		db.Where("identifier IN ?", []string{"login:" + a.Login, a.IP}).Delete(&model.LoginThrottle{})
+	})
+	return a
+}
+
+func blockedError(t *testing.T, err error) *BlockedError {
+	t.Helper()
+
+	var blocked *BlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("期望BlockedError，得到: %v", err)
+	}
+	return blocked
+}
+
+// 失败次数达到LockThreshold时锁定账号，锁定前只做退避
+func TestFailLocksAccountAtThreshold(t *testing.T) {
+	g, db := openTestGuard(t)
+	ctx := context.Background()
+	a := newAttempt(t, db)
+	now := time.Now().Truncate(time.Second)
+
+	for i := 1; i < testConfig.LockThreshold; i++ {
+		failure, err := g.Fail(ctx, a, now)
+		if err != nil {
+			t.Fatalf("记录失败: %v", err)
+		}
+		if failure.Failures != i || failure.LockedUntil != nil {
+			t.Fatalf("第%d次失败: %+v，期望未锁定", i, failure)
+		}
+	}
+	if blocked := blockedError(t, g.Check(ctx, a, now)); blocked.Locked {
+		t.Fatal("未达到锁定阈值时不应锁定")
+	}
+
+	failure, err := g.Fail(ctx, a, now)
+	if err != nil {
+		t.Fatalf("记录失败: %v", err)
+	}
+	wantUntil := now.Add(time.Duration(testConfig.LockDuration) * time.Second)
+	if failure.LockedUntil == nil || !failure.LockedUntil.Equal(wantUntil) {
+		t.Fatalf("达到阈值后应锁定到 %v，得到 %+v", wantUntil, failure.LockedUntil)
+	}
+
+	blocked := blockedError(t, g.Check(ctx, a, now.Add(time.Minute)))
+	if !blocked.Locked || blocked.RetryAfter != wantUntil.Sub(now.Add(time.Minute)) {
+		t.Fatalf("锁定中的检查结果: %+v", blocked)
+	}
+
+	// 锁定到期后允许再次尝试
+	if err := g.Check(ctx, a, wantUntil); err != nil {
+		t.Fatalf("锁定到期后应允许登录: %v", err)
+	}
+}
+
+// 退避期间拒绝尝试，等待时长过后允许再次尝试
+func TestCheckBacksOffAfterRepeatedFailures(t *testing.T) {
+	g, db := openTestGuard(t)
+	ctx := context.Background()
+	a := newAttempt(t, db)
+	now := time.Now().Truncate(time.Second)
+
+	for i := 0; i < testConfig.BackoffAfter+1; i++ {
+		if _, err := g.Fail(ctx, a, now); err != nil {
+			t.Fatalf("记录失败: %v", err)
+		}
+	}
+
+	// 失败4次，退避2秒
+	blocked := blockedError(t, g.Check(ctx, a, now.Add(time.Second)))
+	if blocked.Locked || blocked.RetryAfter != time.Second {
+		t.Fatalf("退避中的检查结果: %+v", blocked)
+	}
+	if err := g.Check(ctx, a, now.Add(2*time.Second)); err != nil {
+		t.Fatalf("退避结束后应允许登录: %v", err)
+	}
+}
+
+// 距最后一次失败超过计数窗口后，下一次失败从1重新计数
+func TestFailResetsStaleCounter(t *testing.T) {
+	g, db := openTestGuard(t)
+	ctx := context.Background()
+	a := newAttempt(t, db)
+	now := time.Now().Truncate(time.Second)
+
+	for i := 0; i < testConfig.BackoffAfter+2; i++ {
+		if _, err := g.Fail(ctx, a, now); err != nil {
+			t.Fatalf("记录失败: %v", err)
+		}
+	}
+
+	later := now.Add(time.Duration(testConfig.FailureWindow) * time.Second)
+	if err := g.Check(ctx, a, later); err != nil {
+		t.Fatalf("计数过期后应允许登录: %v", err)
+	}
+	failure, err := g.Fail(ctx, a, later)
+	if err != nil {
+		t.Fatalf("记录失败: %v", err)
+	}
+	if failure.Failures != 1 {
+		t.Fatalf("过期计数应清零后重新计数，得到%d", failure.Failures)
+	}
+}
+
+// 登录成功只清零账号计数，来源IP的计数保留
+func TestSucceedKeepsIPCounter(t *testing.T) {
+	g, db := openTestGuard(t)
+	ctx := context.Background()
+	a := newAttempt(t, db)
+	now := time.Now().Truncate(time.Second)
+
+	for i := 0; i < testConfig.IPBackoffAfter; i++ {
+		if _, err := g.Fail(ctx, a, now); err != nil {
+			t.Fatalf("记录失败: %v", err)
+		}
+	}
+	if err := g.Succeed(ctx, a); err != nil {
+		t.Fatalf("记录成功: %v", err)
+	}
+
+	// 同一IP尝试其他账号仍处于退避中
+	other := Attempt{Login: a.Login + "_other", IP: a.IP}
+	if blocked := blockedError(t, g.Check(ctx, other, now)); blocked.Locked {
+		t.Fatal("IP计数不应锁定账号")
+	}
+	// 账号本身不带IP时不再退避
+	if err := g.Check(ctx, Attempt{Login: a.Login}, now); err != nil {
+		t.Fatalf("登录成功后账号计数应清零: %v", err)
+	}
+}
+
+// 管理员解除锁定后立即允许登录，再次解除时报告未锁定
+func TestUnlock(t *testing.T) {
+	g, db := openTestGuard(t)
+	ctx := context.Background()
+	user := testdb.CreateUser(t, db, &model.LoginThrottle{})
+	a := Attempt{Login: user.Username, UserID: user.ID}
+	now := time.Now().Truncate(time.Second)
+
+	for i := 0; i < testConfig.LockThreshold; i++ {
+		if _, err := g.Fail(ctx, a, now); err != nil {
+			t.Fatalf("记录失败: %v", err)
+		}
+	}
+
+	unlocked, err := g.Unlock(ctx, user.ID, now)
+	if err != nil || !unlocked {
+		t.Fatalf("解除锁定: unlocked=%v err=%v", unlocked, err)
+	}
+	if err := g.Check(ctx, a, now); err != nil {
+		t.Fatalf("解除锁定后应允许登录: %v", err)
+	}
+
+	unlocked, err = g.Unlock(ctx, user.ID, now)
+	if err != nil || unlocked {
+		t.Fatalf("未锁定时解除: unlocked=%v err=%v", unlocked, err)
+	}
+}
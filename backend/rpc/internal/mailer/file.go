@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// FileMailer 将邮件写入本地目录，每封邮件一个.eml文件，用于没有SMTP服务器的开发环境
+type FileMailer struct {
+	dir  string
+	from string
+	seq  atomic.Uint64
+}
+
+// NewFileMailer 创建文件发送方，目录不存在时自动创建
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("创建邮件目录失败: %w", err)
+	}
+	return &FileMailer{dir: dir, from: from}, nil
+}
+
+// Send 写入邮件文件，文件名包含发送时间和收件人，便于查找
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := msg.validate(); err != nil {
+		return err
+	}
+	to := strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(msg.To)
+	name := fmt.Sprintf("%s-%d-%s.eml", time.Now().Format("20060102T150405"), m.seq.Add(1), to)
+	return os.WriteFile(filepath.Join(m.dir, name), encode(m.from, msg), 0o600)
+}
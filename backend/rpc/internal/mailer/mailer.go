@@ -0,0 +1,68 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// 支持的发信方式
+const (
+	TypeSMTP   = "smtp"
+	TypeFile   = "file"
+	TypeMemory = "memory"
+)
+
+// Config 邮件发送配置
+type Config struct {
+	Type     string `json:",default=file,options=smtp|file|memory"` // 发信方式：smtp 通过SMTP服务器发送；file 写入本地目录（开发环境）；memory 保存在内存中（测试）
+	From     string `json:",default=no-reply@localhost"`            // 发件人地址
+	Host     string `json:",optional"`                              // SMTP服务器地址
+	Port     int    `json:",default=587"`                           // SMTP服务器端口，服务器支持时自动启用STARTTLS
+	Username string `json:",optional"`                              // SMTP登录用户名，为空时不认证
+	Password string `json:",optional,env=SMTP_PASSWORD"`            // SMTP登录密码
+	Dir      string `json:",default=data/mail"`                     // file方式的邮件保存目录
+}
+
+// Message 一封纯文本邮件
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// validate 收件人必须是单个有效地址，标题不能换行，防止邮件头注入
+func (msg *Message) validate() error {
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return errors.New("邮件头不能包含换行")
+	}
+	if _, err := mail.ParseAddress(msg.To); err != nil {
+		return fmt.Errorf("无效的收件人地址: %w", err)
+	}
+	return nil
+}
+
+// Mailer 邮件发送接口
+type Mailer interface {
+	// Send 发送一封邮件
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New 根据配置创建邮件发送方
+func New(c Config) (Mailer, error) {
+	switch c.Type {
+	case TypeSMTP:
+		if c.Host == "" {
+			return nil, fmt.Errorf("smtp发信方式缺少Host配置")
+		}
+		return NewSMTPMailer(c.Host, c.Port, c.Username, c.Password, c.From), nil
+	case "", TypeFile:
+		return NewFileMailer(c.Dir, c.From)
+	case TypeMemory:
+		return NewMemoryMailer(), nil
+	default:
+		return nil, fmt.Errorf("不支持的发信方式: %s", c.Type)
+	}
+}
@@ -0,0 +1,384 @@
+package metering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/credits"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrUnknownFeature 无效的功能计量项
+	ErrUnknownFeature = errors.New("无效的使用类型，支持的类型：chat、content、analysis")
+	// ErrTokenBudgetExceeded 本周期的token预算已用完
+	ErrTokenBudgetExceeded = errors.New("本周期AI token额度已用完")
+)
+
+// QuotaExceededError 功能用量已达上限
+type QuotaExceededError struct {
+	Feature string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return featureNames[e.Feature] + "使用次数已达上限"
+}
+
+// featureNames 功能的展示名称
+var featureNames = map[string]string{
+	model.AIFeatureChat:     "AI聊天",
+	model.AIFeatureContent:  "AI内容生成",
+	model.AIFeatureAnalysis: "AI数据分析",
+}
+
+// FreeTierConfig 未开通VIP用户的AI权益，VIP用户的权益由所购套餐决定
+type FreeTierConfig struct {
+	Quotas      map[string]int // 每个周期各AI功能的使用次数
+	ModelTiers  []string       // 可使用的模型等级
+	MaxTokens   int            `json:",default=1024"` // 单次请求的最大输出token数
+	TokenBudget int            `json:",default=0"`    // 每个周期的token预算，0表示不限制
+	ResetPolicy string         `json:",optional"`     // 额度重置策略，为空时按自然月重置
+}
+
+// Meter 单个功能当前周期的用量
+type Meter struct {
+	Used  int
+	Limit int
+}
+
+// Usage 用户当前周期的AI用量
+type Usage struct {
+	IsVip       bool
+	PlanID      uint                // 生效中的套餐ID，免费用户为0
+	OrderID     *uint               // 生效中的VIP记录的来源订单ID
+	Features    *model.PlanFeatures // 生效中的AI权益
+	Meters      map[string]Meter
+	TokensUsed  int64     // 当前周期已使用的token数，由调用流水汇总
+	Credits     int64     // 可用的加油包额度，周期额度用完后抵扣
+	ResetPolicy string    // 额度重置策略
+	PeriodStart time.Time // 当前周期开始时间，使用用户时区
+	ResetAt     time.Time // 下次重置时间，使用用户时区
+}
+
+// TokenBudgetExceeded token预算是否已用完
+func (u *Usage) TokenBudgetExceeded() bool {
+	return u.Features.TokenBudget > 0 && u.TokensUsed >= int64(u.Features.TokenBudget)
+}
+
+// allowanceExhausted 功能的周期额度（次数或token预算）是否已用完
+func (u *Usage) allowanceExhausted(feature string) bool {
+	m := u.Meters[feature]
+	return m.Used >= m.Limit || u.TokenBudgetExceeded()
+}
+
+// exhaustedError 周期额度用完且没有加油包额度时返回的错误
+func (u *Usage) exhaustedError(feature string) error {
+	if m := u.Meters[feature]; m.Used >= m.Limit {
+		return &QuotaExceededError{Feature: feature}
+	}
+	return ErrTokenBudgetExceeded
+}
+
+// Service AI用量计量服务，所有AI功能的额度查询和扣减都通过该服务
+type Service struct {
+	db        *gorm.DB
+	free      model.PlanFeatures
+	defaultTZ *time.Location // 用户未设置时区时使用的时区
+	locations sync.Map       // 已加载的时区，key为时区名称
+}
+
+func NewService(db *gorm.DB, free FreeTierConfig, defaultTZ *time.Location) (*Service, error) {
+	features := model.PlanFeatures{
+		Quotas:      free.Quotas,
+		ModelTiers:  free.ModelTiers,
+		MaxTokens:   free.MaxTokens,
+		TokenBudget: free.TokenBudget,
+		ResetPolicy: free.ResetPolicy,
+	}
+	if err := features.Validate(); err != nil {
+		return nil, fmt.Errorf("FreeTier配置无效: %w", err)
+	}
+
+	return &Service{db: db, free: features, defaultTZ: defaultTZ}, nil
+}
+
+// Get 获取用户当前周期的用量，周期结束时自动重置
+func (s *Service) Get(ctx context.Context, userID uint) (*Usage, error) {
+	db := s.db.WithContext(ctx)
+
+	var user model.User
+	if err := db.First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+
+	return s.load(db, &user, time.Now())
+}
+
+// Check 检查功能是否还有剩余次数和token预算，周期额度用完时检查加油包额度，不扣减
+func (s *Service) Check(ctx context.Context, userID uint, feature string) (*Usage, error) {
+	if !model.IsValidAIFeature(feature) {
+		return nil, ErrUnknownFeature
+	}
+
+	usage, err := s.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if usage.allowanceExhausted(feature) && usage.Credits <= 0 {
+		return usage, usage.exhaustedError(feature)
+	}
+
+	return usage, nil
+}
+
+// Record 追加一条AI调用流水
+func (s *Service) Record(ctx context.Context, entry *model.AIUsageLedger) error {
+	entry.TotalTokens = entry.PromptTokens + entry.CompletionTokens
+	return s.db.WithContext(ctx).Create(entry).Error
+}
+
+// History 分页查询用户的AI调用流水，按时间倒序，from/to为零值时不限制
+func (s *Service) History(ctx context.Context, userID uint, from, to time.Time, page, pageSize int) ([]model.AIUsageLedger, int64, error) {
+	db := s.db.WithContext(ctx).Model(&model.AIUsageLedger{}).Where("user_id = ?", userID)
+	if !from.IsZero() {
+		db = db.Where("created_at >= ?", from)
+	}
+	if !to.IsZero() {
+		db = db.Where("created_at < ?", to)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []model.AIUsageLedger
+	if err := db.Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return entries, total, nil
+}
+
+// Consume 扣减一次功能用量，周期额度用完后改为消耗一次加油包额度，都用完时返回QuotaExceededError或ErrTokenBudgetExceeded
+// 扣减是一条带上限条件的UPDATE，并发请求不会使用量超过上限，也不会覆盖其他字段
+func (s *Service) Consume(ctx context.Context, userID uint, feature string) (*Usage, error) {
+	if !model.IsValidAIFeature(feature) {
+		return nil, ErrUnknownFeature
+	}
+	db := s.db.WithContext(ctx)
+
+	// 周期恰好在两步之间结束时，重新加载后再扣减一次
+	for attempt := 0; attempt < 2; attempt++ {
+		// 1. 加载用量，确保计量行存在且周期已重置
+		usage, err := s.Get(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		m := usage.Meters[feature]
+		now := time.Now()
+
+		// 2. 条件扣减：只有未达上限且仍在当前周期内才会更新
+		if !usage.allowanceExhausted(feature) {
+			result := db.Model(&model.AIMeter{}).
+				Where("user_id = ? AND feature = ? AND used < ? AND reset_at > ?", userID, feature, m.Limit, now).
+				Updates(map[string]interface{}{
+					"used":         gorm.Expr("used + 1"),
+					"last_used_at": now,
+				})
+			if result.Error != nil {
+				return nil, result.Error
+			}
+			if result.RowsAffected == 1 {
+				// 读取扣减后的次数
+				var meter model.AIMeter
+				if err := db.Where("user_id = ? AND feature = ?", userID, feature).First(&meter).Error; err != nil {
+					return nil, err
+				}
+				m.Used = meter.Used
+				usage.Meters[feature] = m
+				return usage, nil
+			}
+			if !now.Before(usage.ResetAt) {
+				continue
+			}
+			// 周期额度刚被并发请求用完
+			m.Used = m.Limit
+			usage.Meters[feature] = m
+		}
+
+		// 3. 周期额度已用完，消耗一次加油包额度
+		if _, err := credits.Spend(db, userID, feature, now); err != nil {
+			if errors.Is(err, credits.ErrInsufficient) {
+				usage.Credits = 0
+				return usage, usage.exhaustedError(feature)
+			}
+			return nil, err
+		}
+		usage.Credits--
+		return usage, nil
+	}
+
+	return nil, errors.New("AI用量周期重置冲突")
+}
+
+// load 加载用户所有功能的计量行，缺失的行自动创建，周期按生效中权益的重置策略对齐
+func (s *Service) load(db *gorm.DB, user *model.User, now time.Time) (*Usage, error) {
+	// 1. 确定生效中的权益和重置策略
+	features, record, err := s.entitlementOf(db, user.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	policy := features.EffectiveResetPolicy()
+	loc := s.locationOf(user)
+	anchor := user.CreatedAt
+	if record != nil {
+		anchor = record.StartAt
+	}
+
+	// 2. 补齐缺失的计量行，并发创建时以先创建的为准
+	var meters []model.AIMeter
+	if err := db.Where("user_id = ?", user.ID).Order("id").Find(&meters).Error; err != nil {
+		return nil, err
+	}
+	if len(meters) < len(model.AIFeatures) {
+		var current *period
+		if len(meters) > 0 {
+			current = &period{Start: meters[0].PeriodStart, End: meters[0].ResetAt}
+		}
+		p := periodFor(policy, loc, anchor, current, now)
+
+		rows := make([]model.AIMeter, 0, len(model.AIFeatures))
+		for _, feature := range model.AIFeatures {
+			rows = append(rows, model.AIMeter{
+				UserID:      user.ID,
+				Feature:     feature,
+				PeriodStart: p.Start,
+				ResetAt:     p.End,
+			})
+		}
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error; err != nil {
+			return nil, err
+		}
+		if err := db.Where("user_id = ?", user.ID).Order("id").Find(&meters).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. 将计量行对齐到当前周期：进入新周期时用量清零，策略或时区变化使周期提前开始时保留用量
+	// 所有功能共用第一行的周期计算，条件更新保证并发时只对齐一次
+	p := periodFor(policy, loc, anchor, &period{Start: meters[0].PeriodStart, End: meters[0].ResetAt}, now)
+	aligned := false
+	for i := range meters {
+		if meters[i].PeriodStart.Equal(p.Start) && meters[i].ResetAt.Equal(p.End) {
+			continue
+		}
+
+		updates := map[string]interface{}{
+			"period_start": p.Start,
+			"reset_at":     p.End,
+		}
+		if p.Start.After(meters[i].PeriodStart) || !now.Before(meters[i].ResetAt) {
+			updates["used"] = 0
+		}
+		if err := db.Model(&model.AIMeter{}).
+			Where("id = ? AND period_start = ? AND reset_at = ?", meters[i].ID, meters[i].PeriodStart, meters[i].ResetAt).
+			Updates(updates).Error; err != nil {
+			return nil, err
+		}
+		aligned = true
+	}
+	if aligned {
+		if err := db.Where("user_id = ?", user.ID).Order("id").Find(&meters).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. 由调用流水汇总本周期的token用量
+	var tokensUsed int64
+	if err := db.Model(&model.AIUsageLedger{}).
+		Where("user_id = ? AND created_at >= ?", user.ID, meters[0].PeriodStart).
+		Select("COALESCE(SUM(total_tokens), 0)").
+		Scan(&tokensUsed).Error; err != nil {
+		return nil, err
+	}
+
+	// 5. 可用的加油包额度
+	creditBalance, err := credits.Balance(db, user.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. 按生效中的权益汇总用量和上限
+	usage := &Usage{
+		IsVip:       record != nil,
+		Features:    features,
+		TokensUsed:  tokensUsed,
+		Credits:     creditBalance,
+		ResetPolicy: policy,
+		Meters:      make(map[string]Meter, len(model.AIFeatures)),
+		PeriodStart: meters[0].PeriodStart.In(loc),
+		ResetAt:     meters[0].ResetAt.In(loc),
+	}
+	for _, m := range meters {
+		if !model.IsValidAIFeature(m.Feature) {
+			continue
+		}
+		usage.Meters[m.Feature] = Meter{Used: m.Used, Limit: features.Quotas[m.Feature]}
+	}
+	if record != nil {
+		usage.PlanID = record.PlanID
+		usage.OrderID = record.OrderID
+	}
+
+	return usage, nil
+}
+
+// locationOf 用户的时区，未设置或无效时使用默认时区
+func (s *Service) locationOf(user *model.User) *time.Location {
+	if user.Timezone == "" {
+		return s.defaultTZ
+	}
+	if loc, ok := s.locations.Load(user.Timezone); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		return s.defaultTZ
+	}
+	s.locations.Store(user.Timezone, loc)
+	return loc
+}
+
+// entitlementOf 用户当前生效的AI权益和VIP记录
+// 有生效中的VIP记录时使用该记录套餐的权益，否则使用免费权益，记录为nil
+func (s *Service) entitlementOf(db *gorm.DB, userID uint, now time.Time) (*model.PlanFeatures, *model.VipRecord, error) {
+	var record model.VipRecord
+	err := db.Where("user_id = ? AND is_active = ? AND start_at <= ? AND end_at > ?", userID, true, now, now).
+		Order("start_at DESC").
+		First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &s.free, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 套餐下架后已购买的用户仍按原套餐享受权益
+	var plan model.VipPlan
+	if err := db.Unscoped().First(&plan, record.PlanID).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return &plan.Features, &record, nil
+}
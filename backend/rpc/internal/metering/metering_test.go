@@ -0,0 +1,140 @@
+package metering
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+// meteringTables 计量测试用到的表，测试用户的数据在测试结束时删除
+var meteringTables = []any{&model.AIMeter{}, &model.AIUsageLedger{}, &model.AICreditLedger{}, &model.AICreditGrant{}}
+
+// openTestDB 并发测试需要真实的MySQL
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	return testdb.Open(t, append([]any{&model.VipPlan{}, &model.VipRecord{}}, meteringTables...)...)
+}
+
+func TestConsumeConcurrentNeverExceedsLimit(t *testing.T) {
+	db := openTestDB(t)
+	user := testdb.CreateUser(t, db, meteringTables...)
+
+	const limit = 5
+	const workers = 50
+	svc, err := NewService(db, FreeTierConfig{
+		Quotas: map[string]int{
+			model.AIFeatureChat:     limit,
+			model.AIFeatureContent:  1,
+			model.AIFeatureAnalysis: 1,
+		},
+		ModelTiers: []string{model.ModelTierBasic},
+		MaxTokens:  1024,
+	}, time.UTC)
+	if err != nil {
+		t.Fatalf("创建计量服务失败: %v", err)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+		exceeded  int
+		failures  []error
+	)
+	start := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			usage, err := svc.Consume(context.Background(), user.ID, model.AIFeatureChat)
+
+			mu.Lock()
+			defer mu.Unlock()
+			var quotaErr *QuotaExceededError
+			switch {
+			case err == nil:
+				succeeded++
+				if used := usage.Meters[model.AIFeatureChat].Used; used > limit {
+					failures = append(failures, fmt.Errorf("扣减后次数%d超过上限%d", used, limit))
+				}
+			case errors.As(err, &quotaErr):
+				exceeded++
+			default:
+				failures = append(failures, err)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for _, err := range failures {
+		t.Error(err)
+	}
+	if succeeded != limit {
+		t.Errorf("成功扣减%d次，期望%d次", succeeded, limit)
+	}
+	if exceeded != workers-limit {
+		t.Errorf("超限%d次，期望%d次", exceeded, workers-limit)
+	}
+
+	var meter model.AIMeter
+	if err := db.Where("user_id = ? AND feature = ?", user.ID, model.AIFeatureChat).First(&meter).Error; err != nil {
+		t.Fatalf("查询计量行失败: %v", err)
+	}
+	if meter.Used != limit {
+		t.Errorf("计量行次数为%d，期望%d", meter.Used, limit)
+	}
+}
+
+func TestConsumeDoesNotOverwriteUserColumns(t *testing.T) {
+	db := openTestDB(t)
+	user := testdb.CreateUser(t, db, meteringTables...)
+
+	svc, err := NewService(db, FreeTierConfig{
+		Quotas: map[string]int{
+			model.AIFeatureChat:     10,
+			model.AIFeatureContent:  10,
+			model.AIFeatureAnalysis: 10,
+		},
+		ModelTiers: []string{model.ModelTierBasic},
+		MaxTokens:  1024,
+	}, time.UTC)
+	if err != nil {
+		t.Fatalf("创建计量服务失败: %v", err)
+	}
+
+	// 扣减的同时修改用户的其他字段，扣减不能把它改回旧值
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := svc.Consume(context.Background(), user.ID, model.AIFeatureContent); err != nil {
+			t.Errorf("扣减失败: %v", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if err := db.Model(&model.User{}).Where("id = ?", user.ID).Update("email", "changed_"+user.Email).Error; err != nil {
+			t.Errorf("修改邮箱失败: %v", err)
+		}
+	}()
+	wg.Wait()
+
+	var reloaded model.User
+	if err := db.First(&reloaded, user.ID).Error; err != nil {
+		t.Fatalf("查询用户失败: %v", err)
+	}
+	if reloaded.Email != "changed_"+user.Email {
+		t.Errorf("用户邮箱被覆盖: %s", reloaded.Email)
+	}
+}
@@ -0,0 +1,57 @@
+package metering
+
+import (
+	"time"
+
+	"backend/model"
+)
+
+// fixedPeriod 固定30天策略的周期长度
+const fixedPeriod = 30 * 24 * time.Hour
+
+// period 计量周期，[Start, End)
+type period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// periodFor 按重置策略计算now所在的计量周期
+// anchor为账单锚点（生效中的VIP记录开始时间），current为计量行当前的周期
+// 固定30天策略的周期在上个周期结束后首次使用时开始，到期前沿用当前周期，不是按最近30天滑动统计
+func periodFor(policy string, loc *time.Location, anchor time.Time, current *period, now time.Time) period {
+	switch policy {
+	case model.ResetPolicyBillingAnchor:
+		return billingPeriod(anchor.In(loc), now)
+	case model.ResetPolicyFixed30d:
+		if current != nil && now.Before(current.End) {
+			return *current
+		}
+		start := now.Truncate(time.Second)
+		return period{Start: start, End: start.Add(fixedPeriod)}
+	default:
+		local := now.In(loc)
+		start := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+		return period{Start: start, End: start.AddDate(0, 1, 0)}
+	}
+}
+
+// billingPeriod 从锚点起每满一个月为一个周期，锚点在月末时按各月最后一天计算
+func billingPeriod(anchor, now time.Time) period {
+	if now.Before(anchor) {
+		return period{Start: anchor, End: addMonths(anchor, 1)}
+	}
+
+	local := now.In(anchor.Location())
+	months := (local.Year()-anchor.Year())*12 + int(local.Month()) - int(anchor.Month())
+	if addMonths(anchor, months).After(now) {
+		months--
+	}
+	return period{Start: addMonths(anchor, months), End: addMonths(anchor, months+1)}
+}
+
+// addMonths 增加月数，目标月份没有对应日期时取该月最后一天（1月31日加一个月为2月28日）
+func addMonths(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	return firstOfMonth.AddDate(0, 0, min(t.Day(), lastDay)-1)
+}
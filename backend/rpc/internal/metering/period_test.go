@@ -0,0 +1,46 @@
+package metering
+
+import (
+	"testing"
+	"time"
+
+	"backend/model"
+)
+
+// 固定30天周期在到期前沿用当前周期，不随每次使用向后滑动
+func TestFixed30dKeepsCurrentPeriodUntilItEnds(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	current := &period{Start: start, End: start.Add(fixedPeriod)}
+
+	now := start.Add(29 * 24 * time.Hour)
+	p := periodFor(model.ResetPolicyFixed30d, time.UTC, time.Time{}, current, now)
+	if !p.Start.Equal(current.Start) || !p.End.Equal(current.End) {
+		t.Fatalf("到期前周期应保持不变，得到 %v - %v", p.Start, p.End)
+	}
+}
+
+// 上个周期结束后，新周期从本次使用时开始，而不是紧接上个周期
+func TestFixed30dStartsNewPeriodOnFirstUseAfterEnd(t *testing.T) {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	current := &period{Start: start, End: start.Add(fixedPeriod)}
+
+	now := current.End.Add(5*24*time.Hour + 1500*time.Millisecond)
+	p := periodFor(model.ResetPolicyFixed30d, time.UTC, time.Time{}, current, now)
+	wantStart := now.Truncate(time.Second)
+	if !p.Start.Equal(wantStart) || !p.End.Equal(wantStart.Add(fixedPeriod)) {
+		t.Fatalf("新周期应为 %v 起30天，得到 %v - %v", wantStart, p.Start, p.End)
+	}
+}
+
+// 锚点在月末时，短月份的周期按该月最后一天计算
+func TestBillingAnchorClampsToMonthEnd(t *testing.T) {
+	anchor := time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	p := periodFor(model.ResetPolicyBillingAnchor, time.UTC, anchor, nil, now)
+	wantStart := time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC)
+	wantEnd := time.Date(2026, 3, 31, 10, 0, 0, 0, time.UTC)
+	if !p.Start.Equal(wantStart) || !p.End.Equal(wantEnd) {
+		t.Fatalf("期望 %v - %v，得到 %v - %v", wantStart, wantEnd, p.Start, p.End)
+	}
+}
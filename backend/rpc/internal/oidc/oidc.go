@@ -0,0 +1,207 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/utils"
+
+	"golang.org/x/oauth2"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrUnknownProvider 未配置的登录提供方
+	ErrUnknownProvider = errors.New("不支持的登录方式")
+	// ErrInvalidState 授权状态无效、已过期或已使用
+	ErrInvalidState = errors.New("登录已过期，请重新登录")
+)
+
+// Config 第三方登录配置
+type Config struct {
+	StateTTL  int64            `json:",default=600"`   // 跳转到提供方授权页后完成登录的有效期（秒）
+	Timeout   int64            `json:",default=10000"` // 请求提供方接口的超时时间（毫秒）
+	Providers []ProviderConfig `json:",optional"`      // 登录提供方，未配置时不开放第三方登录
+}
+
+// ProviderConfig 一个OIDC登录提供方
+type ProviderConfig struct {
+	Name         string   // 标识，只能包含小写字母、数字、连字符，用于接口路径和回调地址
+	DisplayName  string   `json:",optional"` // 登录按钮上显示的名称，默认为Name
+	Issuer       string   // 提供方的issuer地址，从 {Issuer}/.well-known/openid-configuration 获取接口地址
+	ClientID     string   // 在提供方注册的客户端ID
+	ClientSecret string   `json:",optional"` // 客户端密钥，公开客户端可不填；为空时读取环境变量 OIDC_{NAME}_CLIENT_SECRET
+	RedirectURL  string   `json:",optional"` // 授权后的回调地址，默认为 {WebBaseURL}/oauth/callback/{Name}
+	Scopes       []string `json:",optional"` // 申请的scope，默认为 openid email profile
+}
+
+// Identity 提供方验证通过的用户身份
+type Identity struct {
+	Provider      string // 提供方标识
+	Subject       string // 用户在提供方的唯一ID（sub）
+	Email         string // 邮箱，提供方未返回时为空
+	EmailVerified bool   // 提供方是否已验证该邮箱
+	Name          string // 显示名称
+	Username      string // 提供方建议的用户名（preferred_username）
+	Picture       string // 头像地址
+}
+
+// Provider 第三方登录提供方，授权码模式并使用PKCE
+type Provider interface {
+	// Name 提供方标识
+	Name() string
+	// DisplayName 登录按钮上显示的名称
+	DisplayName() string
+	// AuthCodeURL 授权页地址，verifier为PKCE校验码，nonce写入ID Token防止重放
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	// Exchange 用授权码换取Token并校验ID Token，返回用户身份
+	Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error)
+}
+
+// Service 管理登录提供方和授权状态
+type Service struct {
+	db        *gorm.DB
+	stateTTL  time.Duration
+	providers map[string]Provider
+	names     []string // 按配置顺序排列的提供方标识
+}
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// New 根据配置创建第三方登录服务，webBaseURL用于拼接默认回调地址
+// 未配置提供方时不开放第三方登录
+func New(db *gorm.DB, c Config, webBaseURL string) (*Service, error) {
+	if len(c.Providers) > 0 && (c.StateTTL <= 0 || c.Timeout <= 0) {
+		return nil, errors.New("第三方登录的状态有效期和超时时间必须大于0")
+	}
+
+	s := &Service{
+		db:        db,
+		stateTTL:  time.Duration(c.StateTTL) * time.Second,
+		providers: make(map[string]Provider),
+	}
+	client := &http.Client{Timeout: time.Duration(c.Timeout) * time.Millisecond}
+	for _, pc := range c.Providers {
+		if pc.RedirectURL == "" {
+			pc.RedirectURL = strings.TrimRight(webBaseURL, "/") + "/oauth/callback/" + pc.Name
+		}
+		if pc.ClientSecret == "" {
+			pc.ClientSecret = os.Getenv("OIDC_" + strings.ToUpper(strings.ReplaceAll(pc.Name, "-", "_")) + "_CLIENT_SECRET")
+		}
+		provider, err := NewProvider(pc, client)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.Register(provider); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Register 注册登录提供方，用于接入非标准OIDC的提供方
+func (s *Service) Register(p Provider) error {
+	if !namePattern.MatchString(p.Name()) {
+		return fmt.Errorf("登录提供方标识无效: %q", p.Name())
+	}
+	if _, ok := s.providers[p.Name()]; ok {
+		return fmt.Errorf("登录提供方重复: %s", p.Name())
+	}
+	s.providers[p.Name()] = p
+	s.names = append(s.names, p.Name())
+	return nil
+}
+
+// Providers 已配置的登录提供方，按配置顺序排列
+func (s *Service) Providers() []Provider {
+	providers := make([]Provider, 0, len(s.names))
+	for _, name := range s.names {
+		providers = append(providers, s.providers[name])
+	}
+	return providers
+}
+
+// Begin 生成授权状态、nonce和PKCE校验码并返回授权页地址
+// linkUserID不为0时表示已登录用户绑定第三方账号，否则为第三方登录
+func (s *Service) Begin(ctx context.Context, name string, linkUserID uint, now time.Time) (string, error) {
+	provider, ok := s.providers[name]
+	if !ok {
+		return "", ErrUnknownProvider
+	}
+
+	state, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := utils.GenerateRandomToken(16)
+	if err != nil {
+		return "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", err
+	}
+	record := model.OAuthState{
+		StateHash:    utils.HashToken(state),
+		Provider:     name,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    now.Add(s.stateTTL),
+	}
+	if linkUserID != 0 {
+		record.LinkUserID = &linkUserID
+	}
+	if err := s.db.WithContext(ctx).Create(&record).Error; err != nil {
+		return "", err
+	}
+	return authURL, nil
+}
+
+// Finish 核销授权状态并用授权码换取用户身份，返回发起时的绑定用户ID（第三方登录时为0）
+// 同一授权状态只能使用一次，换取失败后同样作废
+func (s *Service) Finish(ctx context.Context, name, code, state string, now time.Time) (*Identity, uint, error) {
+	provider, ok := s.providers[name]
+	if !ok {
+		return nil, 0, ErrUnknownProvider
+	}
+
+	var record model.OAuthState
+	err := s.db.WithContext(ctx).Where("state_hash = ?", utils.HashToken(state)).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, 0, ErrInvalidState
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if record.Provider != name || record.UsedAt != nil || !now.Before(record.ExpiresAt) {
+		return nil, 0, ErrInvalidState
+	}
+	result := s.db.WithContext(ctx).Model(&model.OAuthState{}).
+		Where("id = ? AND used_at IS NULL", record.ID).
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, 0, ErrInvalidState
+	}
+
+	identity, err := provider.Exchange(ctx, code, record.CodeVerifier, record.Nonce)
+	if err != nil {
+		return nil, 0, err
+	}
+	var linkUserID uint
+	if record.LinkUserID != nil {
+		linkUserID = *record.LinkUserID
+	}
+	return identity, linkUserID, nil
+}
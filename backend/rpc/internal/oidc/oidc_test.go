@@ -0,0 +1,232 @@
+package oidc
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/rpc/internal/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	testClientID     = "super-test"
+	testClientSecret = "super-test-secret"
+	testRedirectURL  = "http://localhost:5173/oauth/callback/mock"
+)
+
+func newTestProvider(t *testing.T) (*oidctest.Issuer, *genericProvider) {
+	t.Helper()
+
+	issuer := oidctest.NewIssuer(testClientID, testClientSecret)
+	t.Cleanup(issuer.Close)
+
+	provider, err := NewProvider(ProviderConfig{
+		Name:         "mock",
+		Issuer:       issuer.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+	}, nil)
+	if err != nil {
+		t.Fatalf("创建提供方失败: %v", err)
+	}
+	return issuer, provider.(*genericProvider)
+}
+
+// login 走一遍授权码流程：生成授权地址、模拟用户同意、用授权码换取身份
+func login(t *testing.T, issuer *oidctest.Issuer, provider Provider, verifier, nonce string) (*Identity, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", nonce, verifier)
+	if err != nil {
+		t.Fatalf("生成授权地址失败: %v", err)
+	}
+	code, state, err := issuer.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("授权失败: %v", err)
+	}
+	if state != "state-1" {
+		t.Fatalf("回调state = %q, 期望 state-1", state)
+	}
+	return provider.Exchange(ctx, code, verifier, nonce)
+}
+
+func TestAuthCodeURLUsesPKCE(t *testing.T) {
+	_, provider := newTestProvider(t)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", oauth2.GenerateVerifier())
+	if err != nil {
+		t.Fatalf("生成授权地址失败: %v", err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatalf("解析授权地址失败: %v", err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		t.Errorf("授权地址缺少S256的PKCE参数: %s", authURL)
+	}
+	if q.Get("nonce") != "nonce-1" || q.Get("redirect_uri") != testRedirectURL {
+		t.Errorf("授权地址的nonce或redirect_uri不正确: %s", authURL)
+	}
+	if !strings.Contains(q.Get("scope"), "openid") {
+		t.Errorf("授权地址缺少openid scope: %s", authURL)
+	}
+}
+
+func TestExchangeReturnsIdentity(t *testing.T) {
+	issuer, provider := newTestProvider(t)
+	issuer.SetUser(oidctest.User{
+		Subject:       "sub-42",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "Alice",
+		Username:      "alice",
+	})
+
+	identity, err := login(t, issuer, provider, oauth2.GenerateVerifier(), "nonce-1")
+	if err != nil {
+		t.Fatalf("换取身份失败: %v", err)
+	}
+	want := Identity{
+		Provider:      "mock",
+		Subject:       "sub-42",
+		Email:         "alice@example.com",
+		EmailVerified: true,
+		Name:          "Alice",
+		Username:      "alice",
+	}
+	if *identity != want {
+		t.Errorf("身份 = %+v, 期望 %+v", *identity, want)
+	}
+}
+
+func TestExchangeUnverifiedEmail(t *testing.T) {
+	issuer, provider := newTestProvider(t)
+	issuer.SetUser(oidctest.User{Subject: "sub-1", Email: "bob@example.com"})
+
+	identity, err := login(t, issuer, provider, oauth2.GenerateVerifier(), "nonce-1")
+	if err != nil {
+		t.Fatalf("换取身份失败: %v", err)
+	}
+	if identity.EmailVerified {
+		t.Error("提供方未验证的邮箱不应标记为已验证")
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	issuer, provider := newTestProvider(t)
+
+	ctx := context.Background()
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", oauth2.GenerateVerifier())
+	if err != nil {
+		t.Fatalf("生成授权地址失败: %v", err)
+	}
+	code, _, err := issuer.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("授权失败: %v", err)
+	}
+	if _, err := provider.Exchange(ctx, code, oauth2.GenerateVerifier(), "nonce-1"); err == nil {
+		t.Fatal("PKCE校验码不匹配时应换取失败")
+	}
+}
+
+func TestExchangeRejectsReusedCode(t *testing.T) {
+	issuer, provider := newTestProvider(t)
+
+	ctx := context.Background()
+	verifier := oauth2.GenerateVerifier()
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", verifier)
+	if err != nil {
+		t.Fatalf("生成授权地址失败: %v", err)
+	}
+	code, _, err := issuer.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("授权失败: %v", err)
+	}
+	if _, err := provider.Exchange(ctx, code, verifier, "nonce-1"); err != nil {
+		t.Fatalf("换取身份失败: %v", err)
+	}
+	if _, err := provider.Exchange(ctx, code, verifier, "nonce-1"); err == nil {
+		t.Fatal("授权码重复使用时应换取失败")
+	}
+}
+
+func TestExchangeRejectsInvalidIDToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		nonce  string
+		mutate func(claims jwt.MapClaims)
+	}{
+		{name: "nonce不匹配", nonce: "other-nonce"},
+		{name: "受众不匹配", nonce: "nonce-1", mutate: func(c jwt.MapClaims) { c["aud"] = "other-client" }},
+		{name: "签发方不匹配", nonce: "nonce-1", mutate: func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{name: "已过期", nonce: "nonce-1", mutate: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{name: "多个受众且azp不匹配", nonce: "nonce-1", mutate: func(c jwt.MapClaims) { c["aud"] = []string{testClientID, "other-client"} }},
+		{name: "缺少sub", nonce: "nonce-1", mutate: func(c jwt.MapClaims) { delete(c, "sub") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer, provider := newTestProvider(t)
+			issuer.Mutate = tt.mutate
+
+			ctx := context.Background()
+			verifier := oauth2.GenerateVerifier()
+			authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", verifier)
+			if err != nil {
+				t.Fatalf("生成授权地址失败: %v", err)
+			}
+			code, _, err := issuer.Authorize(authURL)
+			if err != nil {
+				t.Fatalf("授权失败: %v", err)
+			}
+			if _, err := provider.Exchange(ctx, code, verifier, tt.nonce); err == nil {
+				t.Fatal("无效的ID Token应校验失败")
+			}
+		})
+	}
+}
+
+func TestExchangeAfterKeyRotation(t *testing.T) {
+	issuer, provider := newTestProvider(t)
+
+	if _, err := login(t, issuer, provider, oauth2.GenerateVerifier(), "nonce-1"); err != nil {
+		t.Fatalf("换取身份失败: %v", err)
+	}
+
+	// 刚获取过公钥时不会因为未知kid立即重新获取
+	issuer.RotateKey()
+	if _, err := login(t, issuer, provider, oauth2.GenerateVerifier(), "nonce-2"); err == nil {
+		t.Fatal("公钥刚获取过时，未知kid应校验失败")
+	}
+
+	// 超过重新获取间隔后获取新公钥
+	provider.fetchedAt = time.Now().Add(-2 * keyRefreshInterval)
+	if _, err := login(t, issuer, provider, oauth2.GenerateVerifier(), "nonce-3"); err != nil {
+		t.Fatalf("密钥轮换后换取身份失败: %v", err)
+	}
+}
+
+func TestDiscoveryRejectsIssuerMismatch(t *testing.T) {
+	issuer := oidctest.NewIssuer(testClientID, testClientSecret)
+	t.Cleanup(issuer.Close)
+
+	provider, err := NewProvider(ProviderConfig{
+		Name:        "mock",
+		Issuer:      issuer.URL + "/",
+		ClientID:    testClientID,
+		RedirectURL: testRedirectURL,
+	}, nil)
+	if err != nil {
+		t.Fatalf("创建提供方失败: %v", err)
+	}
+	if _, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", oauth2.GenerateVerifier()); err == nil {
+		t.Fatal("发现文档的issuer与配置不一致时应失败")
+	}
+}
@@ -0,0 +1,280 @@
+// Package oidctest 提供本地运行的OIDC签发方，用于测试第三方登录，不依赖外部服务
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// User 签发方当前登录的用户，授权时写入ID Token
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Username      string
+}
+
+// grant 已签发、尚未换取Token的授权码
+type grant struct {
+	redirectURI string
+	challenge   string
+	nonce       string
+	user        User
+	expiresAt   time.Time
+}
+
+// signingKey 签名密钥，轮换后旧公钥仍在JWKS中保留
+type signingKey struct {
+	kid string
+	key *rsa.PrivateKey
+}
+
+// Issuer 本地OIDC签发方，支持发现文档、授权码模式（只接受S256的PKCE）和JWKS
+type Issuer struct {
+	URL          string // 签发方地址，即ID Token中的iss
+	ClientID     string
+	ClientSecret string
+
+	// Mutate 签发ID Token前修改声明，用于构造无效的ID Token
+	Mutate func(claims jwt.MapClaims)
+
+	server *httptest.Server
+
+	mu    sync.Mutex
+	keys  []signingKey // 最后一个用于签名
+	user  User
+	codes map[string]*grant
+}
+
+// NewIssuer 启动签发方，测试结束时调用Close
+func NewIssuer(clientID, clientSecret string) *Issuer {
+	i := &Issuer{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		codes:        make(map[string]*grant),
+		user: User{
+			Subject:       "user-1",
+			Email:         "user1@example.com",
+			EmailVerified: true,
+			Name:          "Test User",
+			Username:      "user1",
+		},
+	}
+	i.RotateKey()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", i.discovery)
+	mux.HandleFunc("GET /authorize", i.authorize)
+	mux.HandleFunc("POST /token", i.token)
+	mux.HandleFunc("GET /jwks", i.jwks)
+	i.server = httptest.NewServer(mux)
+	i.URL = i.server.URL
+	return i
+}
+
+// Close 关闭签发方
+func (i *Issuer) Close() {
+	i.server.Close()
+}
+
+// SetUser 设置之后授权时登录的用户
+func (i *Issuer) SetUser(u User) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.user = u
+}
+
+// RotateKey 生成新的签名密钥，之后签发的ID Token使用新密钥
+func (i *Issuer) RotateKey() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.keys = append(i.keys, signingKey{kid: "key-" + strconv.Itoa(len(i.keys)+1), key: key})
+}
+
+// Authorize 模拟用户在授权页同意授权：请求授权地址，返回回调地址中的code和state
+func (i *Issuer) Authorize(authURL string) (code, state string, err error) {
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		return "", "", fmt.Errorf("授权失败: HTTP %d", resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return "", "", err
+	}
+	return location.Query().Get("code"), location.Query().Get("state"), nil
+}
+
+func (i *Issuer) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                i.URL,
+		"authorization_endpoint":                i.URL + "/authorize",
+		"token_endpoint":                        i.URL + "/token",
+		"jwks_uri":                              i.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (i *Issuer) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != i.ClientID || q.Get("response_type") != "code" {
+		http.Error(w, "invalid client or response_type", http.StatusBadRequest)
+		return
+	}
+	if q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE with S256 is required", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirectURI.Scheme == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	code := rand.Text()
+	i.mu.Lock()
+	i.codes[code] = &grant{
+		redirectURI: q.Get("redirect_uri"),
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+		user:        i.user,
+		expiresAt:   time.Now().Add(time.Minute),
+	}
+	i.mu.Unlock()
+
+	params := redirectURI.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirectURI.RawQuery = params.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (i *Issuer) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, "invalid_request")
+		return
+	}
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != i.ClientID || subtle.ConstantTimeCompare([]byte(clientSecret), []byte(i.ClientSecret)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Basic")
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, "unsupported_grant_type")
+		return
+	}
+
+	// 授权码只能使用一次
+	i.mu.Lock()
+	g := i.codes[r.PostForm.Get("code")]
+	delete(i.codes, r.PostForm.Get("code"))
+	i.mu.Unlock()
+	if g == nil || time.Now().After(g.expiresAt) || g.redirectURI != r.PostForm.Get("redirect_uri") {
+		tokenError(w, "invalid_grant")
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != g.challenge {
+		tokenError(w, "invalid_grant")
+		return
+	}
+
+	idToken, err := i.signIDToken(g)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (i *Issuer) jwks(w http.ResponseWriter, r *http.Request) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	keys := make([]map[string]string, 0, len(i.keys))
+	for _, k := range i.keys {
+		keys = append(keys, map[string]string{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": k.kid,
+			"n":   base64.RawURLEncoding.EncodeToString(k.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.key.E)).Bytes()),
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"keys": keys})
+}
+
+func (i *Issuer) signIDToken(g *grant) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":                i.URL,
+		"sub":                g.user.Subject,
+		"aud":                i.ClientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Hour).Unix(),
+		"nonce":              g.nonce,
+		"email":              g.user.Email,
+		"email_verified":     g.user.EmailVerified,
+		"name":               g.user.Name,
+		"preferred_username": g.user.Username,
+	}
+	if i.Mutate != nil {
+		i.Mutate(claims)
+	}
+
+	i.mu.Lock()
+	key := i.keys[len(i.keys)-1]
+	i.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.key)
+}
+
+func tokenError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
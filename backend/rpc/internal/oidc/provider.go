@@ -0,0 +1,310 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// keyRefreshInterval 遇到未知kid时重新获取公钥的最短间隔，避免伪造的kid导致频繁请求提供方
+const keyRefreshInterval = time.Minute
+
+// metadata 提供方的OIDC发现文档
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims ID Token中用到的声明
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	AuthorizedParty   string `json:"azp"`
+	Email             string `json:"email"`
+	EmailVerified     any    `json:"email_verified"` // 部分提供方返回字符串"true"
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// genericProvider 标准OIDC提供方，接口地址和签名公钥从发现文档获取并缓存
+type genericProvider struct {
+	c      ProviderConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	meta      *metadata
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time // 最后一次获取公钥的时间
+}
+
+// NewProvider 创建标准OIDC提供方，首次使用时才请求发现文档
+func NewProvider(c ProviderConfig, client *http.Client) (Provider, error) {
+	if !namePattern.MatchString(c.Name) {
+		return nil, fmt.Errorf("登录提供方标识无效: %q", c.Name)
+	}
+	if c.Issuer == "" || c.ClientID == "" || c.RedirectURL == "" {
+		return nil, fmt.Errorf("登录提供方%s缺少Issuer、ClientID或RedirectURL配置", c.Name)
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "email", "profile"}
+	}
+	if !slices.Contains(c.Scopes, "openid") {
+		c.Scopes = append([]string{"openid"}, c.Scopes...)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &genericProvider{c: c, client: client}, nil
+}
+
+func (p *genericProvider) Name() string {
+	return p.c.Name
+}
+
+func (p *genericProvider) DisplayName() string {
+	if p.c.DisplayName != "" {
+		return p.c.DisplayName
+	}
+	return p.c.Name
+}
+
+func (p *genericProvider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.oauthConfig(meta).AuthCodeURL(state,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+func (p *genericProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 用授权码和PKCE校验码换取Token
+	token, err := p.oauthConfig(meta).Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, fmt.Errorf("换取Token失败: %w", err)
+	}
+	raw, _ := token.Extra("id_token").(string)
+	if raw == "" {
+		return nil, errors.New("提供方未返回ID Token")
+	}
+
+	// 2. 校验ID Token的签名、签发方、受众、有效期和nonce
+	var claims idTokenClaims
+	if _, err := jwt.ParseWithClaims(raw, &claims, p.keyFunc(ctx),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.c.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	); err != nil {
+		return nil, fmt.Errorf("ID Token无效: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, errors.New("ID Token无效: nonce不匹配")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.c.ClientID {
+		return nil, errors.New("ID Token无效: azp不匹配")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("ID Token无效: 缺少sub")
+	}
+
+	return &Identity{
+		Provider:      p.c.Name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.Email != "" && verified(claims.EmailVerified),
+		Name:          claims.Name,
+		Username:      claims.PreferredUsername,
+		Picture:       claims.Picture,
+	}, nil
+}
+
+func (p *genericProvider) oauthConfig(meta *metadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.c.ClientID,
+		ClientSecret: p.c.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+		RedirectURL: p.c.RedirectURL,
+		Scopes:      p.c.Scopes,
+	}
+}
+
+// metadata 获取发现文档，成功后缓存
+func (p *genericProvider) metadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	var meta metadata
+	if err := p.getJSON(ctx, p.c.Issuer+"/.well-known/openid-configuration", &meta); err != nil {
+		return nil, fmt.Errorf("获取%s的发现文档失败: %w", p.c.Name, err)
+	}
+	// 发现文档中的issuer必须与配置一致，防止被替换为其他签发方
+	if meta.Issuer != p.c.Issuer {
+		return nil, fmt.Errorf("%s的发现文档issuer不匹配: %s", p.c.Name, meta.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("%s的发现文档缺少必要的接口地址", p.c.Name)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// keyFunc 按ID Token头部的kid查找签名公钥，找不到时重新获取一次，以支持提供方轮换密钥
+func (p *genericProvider) keyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if key, ok := p.lookupKey(kid); ok {
+			return key, nil
+		}
+		if !p.fetchedAt.IsZero() && time.Since(p.fetchedAt) < keyRefreshInterval {
+			return nil, fmt.Errorf("未知的签名公钥: %s", kid)
+		}
+
+		var set jsonWebKeySet
+		if err := p.getJSON(ctx, p.meta.JWKSURI, &set); err != nil {
+			return nil, fmt.Errorf("获取签名公钥失败: %w", err)
+		}
+		p.keys = set.publicKeys()
+		p.fetchedAt = time.Now()
+		if key, ok := p.lookupKey(kid); ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("未知的签名公钥: %s", kid)
+	}
+}
+
+// lookupKey 查找公钥，ID Token未指定kid时只有一个公钥才能确定
+func (p *genericProvider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *genericProvider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// jsonWebKeySet JWKS文档
+type jsonWebKeySet struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	} `json:"keys"`
+}
+
+// publicKeys 解析签名公钥，跳过加密用途和不支持的密钥
+func (s *jsonWebKeySet) publicKeys() map[string]crypto.PublicKey {
+	keys := make(map[string]crypto.PublicKey, len(s.Keys))
+	for _, k := range s.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			n, errN := base64.RawURLEncoding.DecodeString(k.N)
+			e, errE := base64.RawURLEncoding.DecodeString(k.E)
+			if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+				continue
+			}
+			keys[k.Kid] = &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			}
+		case "EC":
+			var curve elliptic.Curve
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				continue
+			}
+			x, errX := base64.RawURLEncoding.DecodeString(k.X)
+			y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+			if errX != nil || errY != nil {
+				continue
+			}
+			key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+			// 校验点在曲线上
+			if _, err := key.ECDH(); err != nil {
+				continue
+			}
+			keys[k.Kid] = key
+		}
+	}
+	return keys
+}
+
+// verified 解析email_verified，兼容布尔值和字符串
+func verified(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case string:
+		ok, _ := strconv.ParseBool(v)
+		return ok
+	default:
+		return false
+	}
+}
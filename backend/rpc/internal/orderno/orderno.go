@@ -0,0 +1,76 @@
+package orderno
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// 雪花算法各部分的位数：41位毫秒时间戳 + 10位节点ID + 12位序列号
+const (
+	nodeBits     = 10
+	sequenceBits = 12
+
+	MaxNodeID   = 1<<nodeBits - 1
+	maxSequence = 1<<sequenceBits - 1
+
+	nodeShift = sequenceBits
+	timeShift = sequenceBits + nodeBits
+)
+
+// epoch 时间戳起点（2024-01-01 00:00:00 UTC）
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+
+// prefix 订单号前缀
+const prefix = "ORD"
+
+// Generator 雪花算法订单号生成器
+// 同一节点内单调递增且不重复，多实例部署时每个实例必须配置不同的节点ID
+type Generator struct {
+	mu       sync.Mutex
+	nodeID   int64
+	lastTime int64
+	sequence int64
+}
+
+// NewGenerator 创建订单号生成器，nodeID取值范围为 0 ~ MaxNodeID
+func NewGenerator(nodeID int64) (*Generator, error) {
+	if nodeID < 0 || nodeID > MaxNodeID {
+		return nil, fmt.Errorf("订单号节点ID必须在0到%d之间: %d", MaxNodeID, nodeID)
+	}
+	return &Generator{nodeID: nodeID}, nil
+}
+
+// NextID 生成下一个ID
+func (g *Generator) NextID() int64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now().UnixMilli() - epoch
+	// 时钟回拨时沿用上次的时间戳，避免生成重复ID
+	if now < g.lastTime {
+		now = g.lastTime
+	}
+
+	if now == g.lastTime {
+		g.sequence = (g.sequence + 1) & maxSequence
+		if g.sequence == 0 {
+			// 当前毫秒的序列号已用完，等待下一毫秒
+			for now <= g.lastTime {
+				time.Sleep(100 * time.Microsecond)
+				now = time.Now().UnixMilli() - epoch
+			}
+		}
+	} else {
+		g.sequence = 0
+	}
+	g.lastTime = now
+
+	return now<<timeShift | g.nodeID<<nodeShift | g.sequence
+}
+
+// Next 生成下一个订单号，如 ORD123456789012345678
+func (g *Generator) Next() string {
+	return prefix + strconv.FormatInt(g.NextID(), 10)
+}
@@ -0,0 +1,93 @@
+package orderno
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNewGeneratorValidatesNodeID(t *testing.T) {
+	for _, nodeID := range []int64{-1, MaxNodeID + 1} {
+		if _, err := NewGenerator(nodeID); err == nil {
+			t.Errorf("节点ID %d 应被拒绝", nodeID)
+		}
+	}
+	for _, nodeID := range []int64{0, MaxNodeID} {
+		if _, err := NewGenerator(nodeID); err != nil {
+			t.Errorf("节点ID %d 应有效: %v", nodeID, err)
+		}
+	}
+}
+
+// 超过单毫秒序列号上限时等待下一毫秒，ID仍严格递增
+func TestNextIDIsStrictlyIncreasing(t *testing.T) {
+	g, err := NewGenerator(1)
+	if err != nil {
+		t.Fatalf("创建生成器失败: %v", err)
+	}
+
+	last := g.NextID()
+	for i := 0; i < 3*(maxSequence+1); i++ {
+		id := g.NextID()
+		if id <= last {
+			t.Fatalf("第%d个ID %d 不大于上一个 %d", i, id, last)
+		}
+		last = id
+	}
+}
+
+// 节点ID写入对应的位，不同节点生成的ID不会重复
+func TestNextIDEncodesNodeID(t *testing.T) {
+	for _, nodeID := range []int64{0, 5, MaxNodeID} {
+		g, err := NewGenerator(nodeID)
+		if err != nil {
+			t.Fatalf("创建生成器失败: %v", err)
+		}
+		if got := g.NextID() >> nodeShift & MaxNodeID; got != nodeID {
+			t.Errorf("ID中的节点ID = %d，期望 %d", got, nodeID)
+		}
+	}
+}
+
+func TestNextIsUniqueUnderConcurrency(t *testing.T) {
+	g, err := NewGenerator(7)
+	if err != nil {
+		t.Fatalf("创建生成器失败: %v", err)
+	}
+
+	const workers, perWorker = 8, 2000
+	var (
+		mu   sync.Mutex
+		seen = make(map[string]bool, workers*perWorker)
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nos := make([]string, 0, perWorker)
+			for i := 0; i < perWorker; i++ {
+				nos = append(nos, g.Next())
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, no := range nos {
+				seen[no] = true
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != workers*perWorker {
+		t.Fatalf("生成了%d个订单号，其中不重复的只有%d个", workers*perWorker, len(seen))
+	}
+	for no := range seen {
+		if !strings.HasPrefix(no, prefix) {
+			t.Fatalf("订单号缺少前缀: %s", no)
+		}
+		if _, err := strconv.ParseInt(strings.TrimPrefix(no, prefix), 10, 64); err != nil {
+			t.Fatalf("订单号格式错误: %s", no)
+		}
+	}
+}
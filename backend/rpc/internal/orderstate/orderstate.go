@@ -0,0 +1,85 @@
+package orderstate
+
+import (
+	"errors"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrInvalidTransition 当前状态不允许变更为目标状态
+	ErrInvalidTransition = errors.New("订单状态不允许此操作")
+	// ErrConflict 订单已被其他请求修改
+	ErrConflict = errors.New("订单已被修改，请刷新后重试")
+)
+
+// transitions 允许的状态流转，其他状态均为终态：
+// pending → paid / cancelled / expired
+// 暂不支持退款，已支付的订单不能再变更状态
+var transitions = map[string][]string{
+	model.VipOrderStatusPending: {model.VipOrderStatusPaid, model.VipOrderStatusCancelled, model.VipOrderStatusExpired},
+}
+
+// CanTransition 检查订单状态能否从from变更为to
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition 使用乐观锁变更订单状态，fields为同时更新的其他字段
+// 订单的状态或版本号与数据库不一致时返回 ErrConflict，成功后同步更新order
+func Transition(db *gorm.DB, order *model.VipOrder, to string, fields map[string]interface{}) error {
+	if !CanTransition(order.Status, to) {
+		return ErrInvalidTransition
+	}
+
+	updates := make(map[string]interface{}, len(fields)+2)
+	for k, v := range fields {
+		updates[k] = v
+	}
+	updates["status"] = to
+	updates["version"] = gorm.Expr("version + 1")
+
+	result := db.Model(&model.VipOrder{}).
+		Where("id = ? AND status = ? AND version = ?", order.ID, order.Status, order.Version).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrConflict
+	}
+
+	order.Status = to
+	order.Version++
+	return nil
+}
+
+// Update 使用乐观锁更新订单的其他字段，不变更状态
+// 订单的状态或版本号与数据库不一致时返回 ErrConflict，成功后同步更新order的版本号
+func Update(db *gorm.DB, order *model.VipOrder, fields map[string]interface{}) error {
+	updates := make(map[string]interface{}, len(fields)+1)
+	for k, v := range fields {
+		updates[k] = v
+	}
+	updates["version"] = gorm.Expr("version + 1")
+
+	result := db.Model(&model.VipOrder{}).
+		Where("id = ? AND status = ? AND version = ?", order.ID, order.Status, order.Version).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrConflict
+	}
+
+	order.Version++
+	return nil
+}
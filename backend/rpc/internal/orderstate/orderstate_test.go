@@ -0,0 +1,31 @@
+package orderstate
+
+import (
+	"testing"
+
+	"backend/model"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{model.VipOrderStatusPending, model.VipOrderStatusPaid, true},
+		{model.VipOrderStatusPending, model.VipOrderStatusCancelled, true},
+		{model.VipOrderStatusPending, model.VipOrderStatusExpired, true},
+		// 已支付的订单为终态，不能取消或重新进入待支付
+		{model.VipOrderStatusPaid, model.VipOrderStatusCancelled, false},
+		{model.VipOrderStatusPaid, model.VipOrderStatusPending, false},
+		{model.VipOrderStatusPaid, "refunded", false},
+		// 已取消和已过期的订单不能再支付
+		{model.VipOrderStatusCancelled, model.VipOrderStatusPaid, false},
+		{model.VipOrderStatusExpired, model.VipOrderStatusPaid, false},
+	}
+
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%s, %s) = %v, 期望 %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/pb/auth"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// RegisterAuth 注册同步认证服务的操作，client为nil表示未配置认证服务，操作直接完成
+func RegisterAuth(o *Outbox, db *gorm.DB, client auth.AuthClient) {
+	o.Handle(model.OutboxAuthSyncVip, func(ctx context.Context, event *model.OutboxEvent) error {
+		if client == nil {
+			return nil
+		}
+		return syncVip(ctx, db, client, event.UserID)
+	})
+	o.Handle(model.OutboxAuthDeleteUser, func(ctx context.Context, event *model.OutboxEvent) error {
+		if client == nil {
+			return nil
+		}
+		return deleteUser(ctx, db, client, event.UserID)
+	})
+}
+
+// syncVip 将本地当前的VIP状态写入认证服务
+func syncVip(ctx context.Context, db *gorm.DB, client auth.AuthClient, userID uint) error {
+	var user model.User
+	err := db.WithContext(ctx).Select("id", "is_vip", "vip_end_at").First(&user, userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 用户已在本地删除，无需同步
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	vipExpires := ""
+	if user.VipEndAt != nil {
+		vipExpires = user.VipEndAt.Format("2006-01-02 15:04:05")
+	}
+	_, err = client.UpdateUserVip(ctx, &auth.UpdateUserVipReq{
+		UserId:     strconv.FormatUint(uint64(user.ID), 10),
+		IsVip:      user.IsVip,
+		VipExpires: vipExpires,
+	})
+	if status.Code(err) == codes.NotFound {
+		// 认证服务中没有该用户，重试无效，由对账修复
+		logx.WithContext(ctx).Errorf("同步VIP状态时认证服务中不存在用户: user_id=%d", userID)
+		return nil
+	}
+	return err
+}
+
+// deleteUser 在认证服务中删除用户，本地用户已恢复时跳过
+func deleteUser(ctx context.Context, db *gorm.DB, client auth.AuthClient, userID uint) error {
+	var user model.User
+	err := db.WithContext(ctx).Unscoped().Select("id", "deleted_at").First(&user, userID).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil && !user.DeletedAt.Valid {
+		return nil
+	}
+
+	_, err = client.DeleteUser(ctx, &auth.DeleteUserReq{
+		UserId: strconv.FormatUint(uint64(userID), 10),
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,203 @@
+// Package outbox 保证本地数据变更后，对外部服务的操作最终被执行
+// 操作与本地变更在同一事务中写入outbox_events，提交后立即尝试投递，失败时由后台任务按指数退避重试
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/lease"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// Config 跨服务操作投递配置
+type Config struct {
+	Interval    int64 `json:",default=5"`      // 后台投递的扫描间隔（秒）
+	BatchSize   int   `json:",default=100"`    // 每批投递的操作数
+	MaxAttempts int   `json:",default=10"`     // 最多尝试次数，超过后标记为失败，等待对账修复
+	BaseDelay   int64 `json:",default=5"`      // 首次重试的等待时长（秒），之后每失败一次翻倍
+	MaxDelay    int64 `json:",default=3600"`   // 重试等待时长上限（秒）
+	Lease       int64 `json:",default=60"`     // 投递中的操作的租约（秒），实例中途退出时租约到期后由其他实例重新投递
+	Retention   int64 `json:",default=604800"` // 已投递的操作保留时长（秒）
+}
+
+// Handler 执行一种操作，必须是幂等的；返回错误时稍后重试
+type Handler func(ctx context.Context, event *model.OutboxEvent) error
+
+// Outbox 跨服务操作的投递
+type Outbox struct {
+	db       *gorm.DB
+	c        Config
+	events   *lease.Table
+	handlers map[string]Handler
+}
+
+// New 创建跨服务操作投递
+func New(db *gorm.DB, c Config) (*Outbox, error) {
+	if c.Interval <= 0 || c.BatchSize <= 0 || c.Lease <= 0 || c.Retention <= 0 {
+		return nil, errors.New("跨服务操作的扫描间隔、批大小、租约和保留时长必须大于0")
+	}
+	if c.MaxAttempts <= 0 {
+		return nil, errors.New("跨服务操作的最多尝试次数必须大于0")
+	}
+	if c.BaseDelay <= 0 || c.MaxDelay < c.BaseDelay {
+		return nil, errors.New("跨服务操作的重试等待时长无效")
+	}
+	// 投递中的操作仍为待投递状态，租约到期后可被重新领取
+	events := lease.New(db, &model.OutboxEvent{}, model.OutboxStatusPending, time.Duration(c.Lease)*time.Second)
+	return &Outbox{db: db, c: c, events: events, handlers: make(map[string]Handler)}, nil
+}
+
+// Interval 后台投递的扫描间隔
+func (o *Outbox) Interval() time.Duration {
+	return time.Duration(o.c.Interval) * time.Second
+}
+
+// Handle 注册一种操作的执行方法
+func (o *Outbox) Handle(kind string, h Handler) {
+	o.handlers[kind] = h
+}
+
+// Enqueue 记录一个待执行的操作，需与本地数据变更在同一事务中调用
+func Enqueue(tx *gorm.DB, kind string, userID uint, now time.Time) (*model.OutboxEvent, error) {
+	event := model.OutboxEvent{
+		Kind:          kind,
+		UserID:        userID,
+		Status:        model.OutboxStatusPending,
+		NextAttemptAt: now,
+	}
+	if err := tx.Create(&event).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// DeliverNew 立即投递用户尚未尝试过的操作，在本地事务提交后调用以尽快同步
+// 失败的操作留给后台任务按退避重试，返回第一个失败原因
+func (o *Outbox) DeliverNew(ctx context.Context, userID uint, now time.Time) error {
+	var events []model.OutboxEvent
+	if err := o.db.WithContext(ctx).
+		Where("user_id = ? AND status = ? AND attempts = 0", userID, model.OutboxStatusPending).
+		Order("id").
+		Find(&events).Error; err != nil {
+		return err
+	}
+
+	var firstErr error
+	for i := range events {
+		claimed, err := o.claim(ctx, &events[i], now)
+		if err == nil && claimed {
+			err = o.run(ctx, &events[i], now)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Dispatch 投递一批到期的操作，返回成功投递的数量
+func (o *Outbox) Dispatch(ctx context.Context, now time.Time) (int, error) {
+	var events []model.OutboxEvent
+	if err := o.db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", model.OutboxStatusPending, now).
+		Order("next_attempt_at, id").
+		Limit(o.c.BatchSize).
+		Find(&events).Error; err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for i := range events {
+		claimed, err := o.claim(ctx, &events[i], now)
+		if err != nil {
+			return delivered, err
+		}
+		if !claimed {
+			continue
+		}
+		if err := o.run(ctx, &events[i], now); err == nil {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// Purge 删除超过保留时长的已投递操作
+func (o *Outbox) Purge(ctx context.Context, now time.Time) (int64, error) {
+	result := o.db.WithContext(ctx).
+		Where("status = ? AND delivered_at < ?", model.OutboxStatusDelivered, now.Add(-time.Duration(o.c.Retention)*time.Second)).
+		Delete(&model.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
+
+// PendingUsers 返回仍有未投递操作的用户，对账时跳过这些用户，避免与进行中的操作冲突
+func (o *Outbox) PendingUsers(ctx context.Context) (map[uint]bool, error) {
+	var ids []uint
+	if err := o.db.WithContext(ctx).Model(&model.OutboxEvent{}).
+		Where("status = ?", model.OutboxStatusPending).
+		Distinct().Pluck("user_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	pending := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		pending[id] = true
+	}
+	return pending, nil
+}
+
+// claim 领取操作，多个实例同时领取时只有一个成功
+func (o *Outbox) claim(ctx context.Context, event *model.OutboxEvent, now time.Time) (bool, error) {
+	leaseUntil, claimed, err := o.events.Claim(ctx, event.ID, model.OutboxStatusPending, event.Attempts, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+	event.NextAttemptAt = leaseUntil
+	event.Attempts++
+	return true, nil
+}
+
+// run 执行已领取的操作并记录结果
+func (o *Outbox) run(ctx context.Context, event *model.OutboxEvent, now time.Time) error {
+	logger := logx.WithContext(ctx)
+
+	err := fmt.Errorf("未注册的操作类型: %s", event.Kind)
+	if handler, ok := o.handlers[event.Kind]; ok {
+		err = handler(ctx, event)
+	}
+
+	updates := map[string]interface{}{}
+	switch {
+	case err == nil:
+		updates["status"] = model.OutboxStatusDelivered
+		updates["delivered_at"] = now
+		updates["last_error"] = ""
+	case event.Attempts >= o.c.MaxAttempts:
+		logger.Errorf("跨服务操作多次失败，已放弃: id=%d kind=%s user_id=%d err=%v", event.ID, event.Kind, event.UserID, err)
+		updates["status"] = model.OutboxStatusFailed
+		updates["last_error"] = lease.Error(err)
+	default:
+		logger.Errorf("跨服务操作失败，稍后重试: id=%d kind=%s user_id=%d attempts=%d err=%v", event.ID, event.Kind, event.UserID, event.Attempts, err)
+		updates["next_attempt_at"] = now.Add(o.backoff(event.Attempts))
+		updates["last_error"] = lease.Error(err)
+	}
+
+	if _, updateErr := o.events.Finish(ctx, event.ID, event.Attempts, updates); updateErr != nil {
+		logger.Errorf("记录跨服务操作结果失败: id=%d err=%v", event.ID, updateErr)
+	}
+	return err
+}
+
+// backoff 第attempts次失败后的等待时长
+func (o *Outbox) backoff(attempts int) time.Duration {
+	delay := o.c.BaseDelay
+	for i := 1; i < attempts && delay < o.c.MaxDelay; i++ {
+		delay *= 2
+	}
+	return time.Duration(min(delay, o.c.MaxDelay)) * time.Second
+}
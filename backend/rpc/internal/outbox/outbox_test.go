@@ -0,0 +1,211 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+var testConfig = Config{
+	Interval:    5,
+	BatchSize:   100,
+	MaxAttempts: 3,
+	BaseDelay:   5,
+	MaxDelay:    60,
+	Lease:       30,
+	Retention:   3600,
+}
+
+// 每多失败一次等待时长翻倍，达到MaxDelay后不再增加
+func TestBackoffDoublesAndCapsAtMaxDelay(t *testing.T) {
+	o := &Outbox{c: testConfig}
+
+	want := map[int]time.Duration{
+		1:   5 * time.Second,
+		2:   10 * time.Second,
+		3:   20 * time.Second,
+		4:   40 * time.Second,
+		5:   60 * time.Second,
+		100: 60 * time.Second,
+	}
+	for attempts, delay := range want {
+		if got := o.backoff(attempts); got != delay {
+			t.Errorf("第%d次失败后的等待时长 = %v，期望 %v", attempts, got, delay)
+		}
+	}
+}
+
+// syncHandler 可控制结果的操作执行方法
+type syncHandler struct {
+	err   error
+	calls int
+}
+
+func (h *syncHandler) handle(ctx context.Context, event *model.OutboxEvent) error {
+	h.calls++
+	return h.err
+}
+
+func newTestOutbox(t *testing.T) (*Outbox, *gorm.DB, *syncHandler, *model.OutboxEvent, time.Time) {
+	t.Helper()
+
+	db := testdb.Open(t, &model.OutboxEvent{})
+	user := testdb.CreateUser(t, db, &model.OutboxEvent{})
+	o, err := New(db, testConfig)
+	if err != nil {
+		t.Fatalf("创建跨服务操作投递失败: %v", err)
+	}
+	h := &syncHandler{}
+	o.Handle(model.OutboxAuthSyncVip, h.handle)
+
+	now := time.Now().Truncate(time.Second)
+	event, err := Enqueue(db, model.OutboxAuthSyncVip, user.ID, now)
+	if err != nil {
+		t.Fatalf("记录操作失败: %v", err)
+	}
+	return o, db, h, event, now
+}
+
+func reloadEvent(t *testing.T, db *gorm.DB, id uint) *model.OutboxEvent {
+	t.Helper()
+
+	var event model.OutboxEvent
+	if err := db.First(&event, id).Error; err != nil {
+		t.Fatalf("查询操作失败: %v", err)
+	}
+	return &event
+}
+
+// claimAndRun 领取并执行一次操作，模拟后台任务在now时刻的一次投递
+func claimAndRun(t *testing.T, o *Outbox, event *model.OutboxEvent, now time.Time) error {
+	t.Helper()
+
+	claimed, err := o.claim(context.Background(), event, now)
+	if err != nil || !claimed {
+		t.Fatalf("领取操作: claimed=%v err=%v", claimed, err)
+	}
+	return o.run(context.Background(), event, now)
+}
+
+func TestDeliverNewMarksDelivered(t *testing.T) {
+	o, db, h, event, now := newTestOutbox(t)
+
+	if err := o.DeliverNew(context.Background(), event.UserID, now); err != nil {
+		t.Fatalf("投递失败: %v", err)
+	}
+	got := reloadEvent(t, db, event.ID)
+	if got.Status != model.OutboxStatusDelivered || got.Attempts != 1 || got.DeliveredAt == nil || h.calls != 1 {
+		t.Fatalf("投递结果错误: %+v calls=%d", got, h.calls)
+	}
+
+	// 已投递的操作不会再次执行
+	if err := o.DeliverNew(context.Background(), event.UserID, now); err != nil || h.calls != 1 {
+		t.Fatalf("重复投递: calls=%d err=%v", h.calls, err)
+	}
+}
+
+// 失败后按退避推迟下次投递，立即投递只处理未尝试过的操作，重试成功后标记为已投递
+func TestFailedDeliveryRetriesWithBackoff(t *testing.T) {
+	o, db, h, event, now := newTestOutbox(t)
+	h.err = errors.New("认证服务不可用")
+
+	if err := o.DeliverNew(context.Background(), event.UserID, now); err == nil {
+		t.Fatal("执行失败时应返回错误")
+	}
+	got := reloadEvent(t, db, event.ID)
+	if got.Status != model.OutboxStatusPending || got.Attempts != 1 || got.LastError != h.err.Error() {
+		t.Fatalf("失败后的状态错误: %+v", got)
+	}
+	if !got.NextAttemptAt.Equal(now.Add(5 * time.Second)) {
+		t.Fatalf("下次投递时间 = %v，期望 %v", got.NextAttemptAt, now.Add(5*time.Second))
+	}
+
+	if err := o.DeliverNew(context.Background(), event.UserID, now); err != nil || h.calls != 1 {
+		t.Fatalf("已尝试过的操作应留给后台任务重试: calls=%d err=%v", h.calls, err)
+	}
+
+	h.err = nil
+	if err := claimAndRun(t, o, got, got.NextAttemptAt); err != nil {
+		t.Fatalf("重试失败: %v", err)
+	}
+	got = reloadEvent(t, db, event.ID)
+	if got.Status != model.OutboxStatusDelivered || got.Attempts != 2 || got.LastError != "" {
+		t.Fatalf("重试成功后的状态错误: %+v", got)
+	}
+}
+
+// 达到最多尝试次数后标记为失败，不再重试
+func TestDeliveryGivesUpAfterMaxAttempts(t *testing.T) {
+	o, db, h, event, now := newTestOutbox(t)
+	h.err = errors.New("认证服务不可用")
+
+	at := now
+	for i := 1; i <= testConfig.MaxAttempts; i++ {
+		current := reloadEvent(t, db, event.ID)
+		if err := claimAndRun(t, o, current, at); err == nil {
+			t.Fatalf("第%d次执行应失败", i)
+		}
+		at = reloadEvent(t, db, event.ID).NextAttemptAt
+	}
+
+	got := reloadEvent(t, db, event.ID)
+	if got.Status != model.OutboxStatusFailed || got.Attempts != testConfig.MaxAttempts {
+		t.Fatalf("超过尝试次数后应标记为失败: %+v", got)
+	}
+	if claimed, err := o.claim(context.Background(), got, at); err != nil || claimed {
+		t.Fatalf("失败的操作不应再被领取: claimed=%v err=%v", claimed, err)
+	}
+}
+
+// 多个实例同时领取同一操作时只有一个成功
+func TestClaimSucceedsOnce(t *testing.T) {
+	o, _, _, event, now := newTestOutbox(t)
+
+	first, second := *event, *event
+	claimed, err := o.claim(context.Background(), &first, now)
+	if err != nil || !claimed {
+		t.Fatalf("第一次领取: claimed=%v err=%v", claimed, err)
+	}
+	claimed, err = o.claim(context.Background(), &second, now)
+	if err != nil || claimed {
+		t.Fatalf("并发的第二次领取应失败: claimed=%v err=%v", claimed, err)
+	}
+}
+
+// 租约到期后其他实例可重新领取，先前领取者迟到的结果不会覆盖
+func TestExpiredLeaseIsReclaimedAndStaleResultIsDropped(t *testing.T) {
+	o, db, h, event, now := newTestOutbox(t)
+
+	stale := *event
+	if claimed, err := o.claim(context.Background(), &stale, now); err != nil || !claimed {
+		t.Fatalf("领取操作: claimed=%v err=%v", claimed, err)
+	}
+
+	// 领取后下次投递时间推迟到租约到期
+	current := reloadEvent(t, db, event.ID)
+	if !current.NextAttemptAt.Equal(now.Add(time.Duration(testConfig.Lease) * time.Second)) {
+		t.Fatalf("租约到期时间 = %v", current.NextAttemptAt)
+	}
+
+	// 租约到期后由其他实例重新领取并投递成功
+	leaseEnd := current.NextAttemptAt
+	if err := claimAndRun(t, o, current, leaseEnd); err != nil {
+		t.Fatalf("重新投递失败: %v", err)
+	}
+
+	// 先前领取者此时才执行失败，结果不应写入
+	h.err = errors.New("迟到的失败")
+	if err := o.run(context.Background(), &stale, now); err == nil {
+		t.Fatal("执行应失败")
+	}
+	got := reloadEvent(t, db, event.ID)
+	if got.Status != model.OutboxStatusDelivered || got.Attempts != 2 || got.LastError != "" {
+		t.Fatalf("迟到的结果覆盖了重新投递的结果: %+v", got)
+	}
+}
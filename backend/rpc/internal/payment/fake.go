@@ -0,0 +1,132 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// fake提供方回调请求头
+const (
+	FakeSignatureHeader = "X-Fake-Signature" // HMAC-SHA256(secret, timestamp + "." + body) 的十六进制
+	FakeTimestampHeader = "X-Fake-Timestamp" // 回调时间（Unix秒）
+)
+
+// fake提供方交易状态
+const (
+	FakeStatusSuccess = "SUCCESS"
+	FakeStatusClosed  = "CLOSED"
+)
+
+// FakeProvider 本地模拟支付提供方，用于开发和测试
+// 回调使用HMAC-SHA256签名，可通过 Sign 构造合法的回调请求
+type FakeProvider struct {
+	secret    []byte
+	payURL    string
+	notifyURL string
+	maxSkew   time.Duration
+}
+
+// FakeNotifyBody fake提供方回调请求体
+type FakeNotifyBody struct {
+	OrderNo string  `json:"order_no"`
+	TradeNo string  `json:"trade_no"`
+	Amount  float64 `json:"amount"`
+	Status  string  `json:"status"`  // SUCCESS, CLOSED
+	PaidAt  int64   `json:"paid_at"` // 支付时间（Unix秒）
+}
+
+// NewFakeProvider 创建模拟支付提供方
+func NewFakeProvider(secret, payURL, notifyURL string, maxSkew time.Duration) *FakeProvider {
+	return &FakeProvider{
+		secret:    []byte(secret),
+		payURL:    payURL,
+		notifyURL: notifyURL,
+		maxSkew:   maxSkew,
+	}
+}
+
+// Name 提供方名称
+func (p *FakeProvider) Name() string {
+	return TypeFake
+}
+
+// CreatePayment 返回模拟收银台链接，二维码内容与链接相同
+func (p *FakeProvider) CreatePayment(ctx context.Context, req *PaymentRequest) (*PaymentResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("order_no", req.OrderNo)
+	query.Set("amount", strconv.FormatFloat(req.Amount, 'f', 2, 64))
+	query.Set("subject", req.Subject)
+	if p.notifyURL != "" {
+		query.Set("notify_url", p.notifyURL)
+	}
+	payURL := p.payURL + "?" + query.Encode()
+
+	return &PaymentResult{
+		PayURL: payURL,
+		QRCode: payURL,
+	}, nil
+}
+
+// VerifyNotify 校验签名和时间戳后解析回调内容
+func (p *FakeProvider) VerifyNotify(ctx context.Context, notify *Notify) (*NotifyResult, error) {
+	timestamp := notify.Headers[FakeTimestampHeader]
+	signature := notify.Headers[FakeSignatureHeader]
+	if timestamp == "" || signature == "" {
+		return nil, ErrInvalidSignature
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	if skew := time.Since(time.Unix(ts, 0)); skew > p.maxSkew || skew < -p.maxSkew {
+		return nil, ErrInvalidSignature
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.sign(timestamp, notify.Body)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var body FakeNotifyBody
+	if err := json.Unmarshal(notify.Body, &body); err != nil {
+		return nil, fmt.Errorf("解析支付回调失败: %w", err)
+	}
+
+	return &NotifyResult{
+		OrderNo: body.OrderNo,
+		TradeNo: body.TradeNo,
+		Amount:  body.Amount,
+		Paid:    body.Status == FakeStatusSuccess,
+		PaidAt:  time.Unix(body.PaidAt, 0),
+	}, nil
+}
+
+// NotifyAck 处理成功的应答
+func (p *FakeProvider) NotifyAck() string {
+	return "success"
+}
+
+// Sign 计算回调签名，返回签名请求头的值
+func (p *FakeProvider) Sign(timestamp string, body []byte) string {
+	return hex.EncodeToString(p.sign(timestamp, body))
+}
+
+func (p *FakeProvider) sign(timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
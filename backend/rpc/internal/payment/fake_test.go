@@ -0,0 +1,121 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const testSecret = "payment-test-secret"
+
+func newTestProvider() *FakeProvider {
+	return NewFakeProvider(testSecret, "http://localhost/pay", "", 5*time.Minute)
+}
+
+// signedNotify 使用provider的密钥为body签名的回调
+func signedNotify(p *FakeProvider, body string, at time.Time) *Notify {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	return &Notify{
+		Headers: map[string]string{
+			FakeTimestampHeader: timestamp,
+			FakeSignatureHeader: p.Sign(timestamp, []byte(body)),
+		},
+		Body: []byte(body),
+	}
+}
+
+const testNotifyBody = `{"order_no":"SA1","trade_no":"T1","amount":19.9,"status":"SUCCESS","paid_at":1790000000}`
+
+func TestVerifyNotifyAcceptsValidSignature(t *testing.T) {
+	p := newTestProvider()
+
+	result, err := p.VerifyNotify(context.Background(), signedNotify(p, testNotifyBody, time.Now()))
+	if err != nil {
+		t.Fatalf("合法回调校验失败: %v", err)
+	}
+	if result.OrderNo != "SA1" || result.TradeNo != "T1" || ToCents(result.Amount) != 1990 || !result.Paid {
+		t.Fatalf("回调解析结果错误: %+v", result)
+	}
+	if !result.PaidAt.Equal(time.Unix(1790000000, 0)) {
+		t.Fatalf("支付时间 = %v", result.PaidAt)
+	}
+}
+
+func TestVerifyNotifyRejectsInvalidSignature(t *testing.T) {
+	p := newTestProvider()
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		notify func() *Notify
+	}{
+		{"篡改请求体", func() *Notify {
+			n := signedNotify(p, testNotifyBody, now)
+			n.Body = []byte(`{"order_no":"SA1","trade_no":"T1","amount":0.01,"status":"SUCCESS","paid_at":1790000000}`)
+			return n
+		}},
+		{"其他密钥签名", func() *Notify {
+			other := NewFakeProvider("other-secret", "", "", 5*time.Minute)
+			return signedNotify(other, testNotifyBody, now)
+		}},
+		{"篡改时间戳", func() *Notify {
+			n := signedNotify(p, testNotifyBody, now)
+			n.Headers[FakeTimestampHeader] = strconv.FormatInt(now.Unix()+1, 10)
+			return n
+		}},
+		{"签名不是十六进制", func() *Notify {
+			n := signedNotify(p, testNotifyBody, now)
+			n.Headers[FakeSignatureHeader] = "not-hex"
+			return n
+		}},
+		{"缺少签名", func() *Notify {
+			n := signedNotify(p, testNotifyBody, now)
+			delete(n.Headers, FakeSignatureHeader)
+			return n
+		}},
+		{"缺少时间戳", func() *Notify {
+			n := signedNotify(p, testNotifyBody, now)
+			delete(n.Headers, FakeTimestampHeader)
+			return n
+		}},
+		// 超过允许偏差的旧回调即使签名正确也拒绝，防止截获后重放
+		{"时间戳过旧", func() *Notify { return signedNotify(p, testNotifyBody, now.Add(-6*time.Minute)) }},
+		{"时间戳超前", func() *Notify { return signedNotify(p, testNotifyBody, now.Add(6*time.Minute)) }},
+	}
+
+	for _, tt := range tests {
+		if _, err := p.VerifyNotify(context.Background(), tt.notify()); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("%s: 期望ErrInvalidSignature，得到 %v", tt.name, err)
+		}
+	}
+}
+
+// 签名正确但内容不是JSON时返回解析错误，而不是签名错误
+func TestVerifyNotifyRejectsMalformedBody(t *testing.T) {
+	p := newTestProvider()
+
+	_, err := p.VerifyNotify(context.Background(), signedNotify(p, "not json", time.Now()))
+	if err == nil || errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("期望解析错误，得到 %v", err)
+	}
+}
+
+func TestNewRequiresSecret(t *testing.T) {
+	if _, err := New(Config{Type: TypeFake}); err == nil {
+		t.Fatal("缺少Secret时应返回错误")
+	}
+	if _, err := New(Config{Type: "unknown", Secret: testSecret}); err == nil {
+		t.Fatal("不支持的提供方类型应返回错误")
+	}
+}
+
+func TestToCents(t *testing.T) {
+	tests := map[float64]int64{0.1 + 0.2: 30, 19.9: 1990, 99.99: 9999, 0: 0}
+	for amount, want := range tests {
+		if got := ToCents(amount); got != want {
+			t.Errorf("ToCents(%v) = %d，期望 %d", amount, got, want)
+		}
+	}
+}
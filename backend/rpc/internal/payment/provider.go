@@ -0,0 +1,85 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// 支持的支付提供方类型
+const (
+	TypeFake = "fake"
+)
+
+// ErrInvalidSignature 支付回调签名校验失败
+var ErrInvalidSignature = errors.New("支付回调签名无效")
+
+// Config 支付提供方配置
+type Config struct {
+	Type      string `json:",default=fake,options=fake"`         // 提供方类型
+	Secret    string `json:",optional,env=PAYMENT_SECRET"`       // 回调签名密钥
+	PayURL    string `json:",default=http://localhost:5173/pay"` // fake提供方的收银台地址
+	NotifyURL string `json:",optional"`                          // 支付结果回调地址，即网关的 /api/payment/notify/{provider}
+	MaxSkew   int64  `json:",default=300"`                       // 回调时间戳允许的最大偏差（秒），防止重放
+}
+
+// PaymentRequest 创建支付请求
+type PaymentRequest struct {
+	OrderNo   string  // 商户订单号
+	Amount    float64 // 支付金额（元）
+	Subject   string  // 商品描述
+	PayMethod string  // 支付方式，如 wechat, alipay
+}
+
+// PaymentResult 创建支付结果，PayURL 和 QRCode 至少返回一个
+type PaymentResult struct {
+	PayURL string // 支付跳转链接
+	QRCode string // 二维码内容
+}
+
+// Notify 支付回调原始内容
+type Notify struct {
+	Headers map[string]string // 请求头，键为规范化后的名称（如 X-Fake-Signature）
+	Body    []byte            // 原始请求体，签名基于原始字节计算
+}
+
+// NotifyResult 校验通过的支付回调
+type NotifyResult struct {
+	OrderNo string    // 商户订单号
+	TradeNo string    // 支付平台交易号
+	Amount  float64   // 实际支付金额（元）
+	Paid    bool      // 是否支付成功
+	PaidAt  time.Time // 支付时间
+}
+
+// PaymentProvider 支付提供方接口
+type PaymentProvider interface {
+	// Name 提供方名称，与回调地址中的 {provider} 对应
+	Name() string
+	// CreatePayment 创建支付，返回支付链接或二维码内容
+	CreatePayment(ctx context.Context, req *PaymentRequest) (*PaymentResult, error)
+	// VerifyNotify 校验回调签名并解析回调内容，签名无效时返回 ErrInvalidSignature
+	VerifyNotify(ctx context.Context, notify *Notify) (*NotifyResult, error)
+	// NotifyAck 处理成功后返回给支付平台的应答内容
+	NotifyAck() string
+}
+
+// New 根据配置创建支付提供方
+func New(c Config) (PaymentProvider, error) {
+	switch c.Type {
+	case "", TypeFake:
+		if c.Secret == "" {
+			return nil, fmt.Errorf("fake支付提供方缺少Secret配置")
+		}
+		return NewFakeProvider(c.Secret, c.PayURL, c.NotifyURL, time.Duration(c.MaxSkew)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("不支持的支付提供方类型: %s", c.Type)
+	}
+}
+
+// ToCents 将金额（元）转换为分，用于金额比较
+func ToCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
@@ -34,6 +34,11 @@ func (s *SuperServer) Login(ctx context.Context, in *super.LoginReq) (*super.Log
 	return l.Login(in)
 }
 
+func (s *SuperServer) VerifyTwoFactorLogin(ctx context.Context, in *super.VerifyTwoFactorLoginReq) (*super.LoginResp, error) {
+	l := logic.NewVerifyTwoFactorLoginLogic(ctx, s.svcCtx)
+	return l.VerifyTwoFactorLogin(in)
+}
+
 func (s *SuperServer) GetUserInfo(ctx context.Context, in *super.GetUserInfoReq) (*super.GetUserInfoResp, error) {
 	l := logic.NewGetUserInfoLogic(ctx, s.svcCtx)
 	return l.GetUserInfo(in)
@@ -54,6 +59,26 @@ func (s *SuperServer) UpdateUserPassword(ctx context.Context, in *super.UpdateUs
 	return l.UpdateUserPassword(in)
 }
 
+func (s *SuperServer) VerifyEmail(ctx context.Context, in *super.VerifyEmailReq) (*super.VerifyEmailResp, error) {
+	l := logic.NewVerifyEmailLogic(ctx, s.svcCtx)
+	return l.VerifyEmail(in)
+}
+
+func (s *SuperServer) SendVerificationEmail(ctx context.Context, in *super.SendVerificationEmailReq) (*super.SendVerificationEmailResp, error) {
+	l := logic.NewSendVerificationEmailLogic(ctx, s.svcCtx)
+	return l.SendVerificationEmail(in)
+}
+
+func (s *SuperServer) ForgotPassword(ctx context.Context, in *super.ForgotPasswordReq) (*super.ForgotPasswordResp, error) {
+	l := logic.NewForgotPasswordLogic(ctx, s.svcCtx)
+	return l.ForgotPassword(in)
+}
+
+func (s *SuperServer) ResetPassword(ctx context.Context, in *super.ResetPasswordReq) (*super.ResetPasswordResp, error) {
+	l := logic.NewResetPasswordLogic(ctx, s.svcCtx)
+	return l.ResetPassword(in)
+}
+
 func (s *SuperServer) DeleteUser(ctx context.Context, in *super.DeleteUserReq) (*super.DeleteUserResp, error) {
 	l := logic.NewDeleteUserLogic(ctx, s.svcCtx)
 	return l.DeleteUser(in)
@@ -74,6 +99,135 @@ func (s *SuperServer) GetUserCount(ctx context.Context, in *super.GetUserCountRe
 	return l.GetUserCount(in)
 }
 
+func (s *SuperServer) GetAccountDeletion(ctx context.Context, in *super.GetAccountDeletionReq) (*super.AccountDeletionResp, error) {
+	l := logic.NewGetAccountDeletionLogic(ctx, s.svcCtx)
+	return l.GetAccountDeletion(in)
+}
+
+func (s *SuperServer) RequestAccountDeletion(ctx context.Context, in *super.RequestAccountDeletionReq) (*super.AccountDeletionResp, error) {
+	l := logic.NewRequestAccountDeletionLogic(ctx, s.svcCtx)
+	return l.RequestAccountDeletion(in)
+}
+
+func (s *SuperServer) CancelAccountDeletion(ctx context.Context, in *super.CancelAccountDeletionReq) (*super.CancelAccountDeletionResp, error) {
+	l := logic.NewCancelAccountDeletionLogic(ctx, s.svcCtx)
+	return l.CancelAccountDeletion(in)
+}
+
+func (s *SuperServer) StartDataExport(ctx context.Context, in *super.StartDataExportReq) (*super.DataExportResp, error) {
+	l := logic.NewStartDataExportLogic(ctx, s.svcCtx)
+	return l.StartDataExport(in)
+}
+
+func (s *SuperServer) GetDataExport(ctx context.Context, in *super.GetDataExportReq) (*super.DataExportResp, error) {
+	l := logic.NewGetDataExportLogic(ctx, s.svcCtx)
+	return l.GetDataExport(in)
+}
+
+func (s *SuperServer) DownloadDataExport(in *super.DownloadDataExportReq, stream super.Super_DownloadDataExportServer) error {
+	l := logic.NewDownloadDataExportLogic(stream.Context(), s.svcCtx)
+	return l.DownloadDataExport(in, stream)
+}
+
+// 认证相关服务
+func (s *SuperServer) RefreshToken(ctx context.Context, in *super.RefreshTokenReq) (*super.RefreshTokenResp, error) {
+	l := logic.NewRefreshTokenLogic(ctx, s.svcCtx)
+	return l.RefreshToken(in)
+}
+
+func (s *SuperServer) Logout(ctx context.Context, in *super.LogoutReq) (*super.LogoutResp, error) {
+	l := logic.NewLogoutLogic(ctx, s.svcCtx)
+	return l.Logout(in)
+}
+
+func (s *SuperServer) CheckTokenRevoked(ctx context.Context, in *super.CheckTokenRevokedReq) (*super.CheckTokenRevokedResp, error) {
+	l := logic.NewCheckTokenRevokedLogic(ctx, s.svcCtx)
+	return l.CheckTokenRevoked(in)
+}
+
+// 权限管理服务
+func (s *SuperServer) SetUserRole(ctx context.Context, in *super.SetUserRoleReq) (*super.SetUserRoleResp, error) {
+	l := logic.NewSetUserRoleLogic(ctx, s.svcCtx)
+	return l.SetUserRole(in)
+}
+
+func (s *SuperServer) UnlockUser(ctx context.Context, in *super.UnlockUserReq) (*super.UnlockUserResp, error) {
+	l := logic.NewUnlockUserLogic(ctx, s.svcCtx)
+	return l.UnlockUser(in)
+}
+
+func (s *SuperServer) ResetTwoFactor(ctx context.Context, in *super.ResetTwoFactorReq) (*super.ResetTwoFactorResp, error) {
+	l := logic.NewResetTwoFactorLogic(ctx, s.svcCtx)
+	return l.ResetTwoFactor(in)
+}
+
+func (s *SuperServer) ReconcileUsers(ctx context.Context, in *super.ReconcileUsersReq) (*super.ReconcileUsersResp, error) {
+	l := logic.NewReconcileUsersLogic(ctx, s.svcCtx)
+	return l.ReconcileUsers(in)
+}
+
+func (s *SuperServer) RestoreUser(ctx context.Context, in *super.RestoreUserReq) (*super.RestoreUserResp, error) {
+	l := logic.NewRestoreUserLogic(ctx, s.svcCtx)
+	return l.RestoreUser(in)
+}
+
+func (s *SuperServer) GetDeletedUsers(ctx context.Context, in *super.GetDeletedUsersReq) (*super.GetDeletedUsersResp, error) {
+	l := logic.NewGetDeletedUsersLogic(ctx, s.svcCtx)
+	return l.GetDeletedUsers(in)
+}
+
+// 两步验证相关服务
+func (s *SuperServer) GetTwoFactorStatus(ctx context.Context, in *super.GetTwoFactorStatusReq) (*super.GetTwoFactorStatusResp, error) {
+	l := logic.NewGetTwoFactorStatusLogic(ctx, s.svcCtx)
+	return l.GetTwoFactorStatus(in)
+}
+
+func (s *SuperServer) SetupTwoFactor(ctx context.Context, in *super.SetupTwoFactorReq) (*super.SetupTwoFactorResp, error) {
+	l := logic.NewSetupTwoFactorLogic(ctx, s.svcCtx)
+	return l.SetupTwoFactor(in)
+}
+
+func (s *SuperServer) EnableTwoFactor(ctx context.Context, in *super.EnableTwoFactorReq) (*super.EnableTwoFactorResp, error) {
+	l := logic.NewEnableTwoFactorLogic(ctx, s.svcCtx)
+	return l.EnableTwoFactor(in)
+}
+
+func (s *SuperServer) DisableTwoFactor(ctx context.Context, in *super.DisableTwoFactorReq) (*super.DisableTwoFactorResp, error) {
+	l := logic.NewDisableTwoFactorLogic(ctx, s.svcCtx)
+	return l.DisableTwoFactor(in)
+}
+
+// 第三方登录相关服务
+func (s *SuperServer) GetOAuthProviders(ctx context.Context, in *super.GetOAuthProvidersReq) (*super.GetOAuthProvidersResp, error) {
+	l := logic.NewGetOAuthProvidersLogic(ctx, s.svcCtx)
+	return l.GetOAuthProviders(in)
+}
+
+func (s *SuperServer) StartOAuth(ctx context.Context, in *super.StartOAuthReq) (*super.StartOAuthResp, error) {
+	l := logic.NewStartOAuthLogic(ctx, s.svcCtx)
+	return l.StartOAuth(in)
+}
+
+func (s *SuperServer) OAuthLogin(ctx context.Context, in *super.OAuthLoginReq) (*super.LoginResp, error) {
+	l := logic.NewOAuthLoginLogic(ctx, s.svcCtx)
+	return l.OAuthLogin(in)
+}
+
+func (s *SuperServer) LinkOAuthIdentity(ctx context.Context, in *super.LinkOAuthIdentityReq) (*super.LinkOAuthIdentityResp, error) {
+	l := logic.NewLinkOAuthIdentityLogic(ctx, s.svcCtx)
+	return l.LinkOAuthIdentity(in)
+}
+
+func (s *SuperServer) GetUserIdentities(ctx context.Context, in *super.GetUserIdentitiesReq) (*super.GetUserIdentitiesResp, error) {
+	l := logic.NewGetUserIdentitiesLogic(ctx, s.svcCtx)
+	return l.GetUserIdentities(in)
+}
+
+func (s *SuperServer) UnlinkUserIdentity(ctx context.Context, in *super.UnlinkUserIdentityReq) (*super.UnlinkUserIdentityResp, error) {
+	l := logic.NewUnlinkUserIdentityLogic(ctx, s.svcCtx)
+	return l.UnlinkUserIdentity(in)
+}
+
 // VIP套餐相关服务
 func (s *SuperServer) GetVipPlans(ctx context.Context, in *super.GetVipPlansReq) (*super.GetVipPlansResp, error) {
 	l := logic.NewGetVipPlansLogic(ctx, s.svcCtx)
@@ -90,6 +244,17 @@ func (s *SuperServer) CreateVipPlan(ctx context.Context, in *super.CreateVipPlan
 	return l.CreateVipPlan(in)
 }
 
+// AI额度加油包相关服务
+func (s *SuperServer) GetCreditPacks(ctx context.Context, in *super.GetCreditPacksReq) (*super.GetCreditPacksResp, error) {
+	l := logic.NewGetCreditPacksLogic(ctx, s.svcCtx)
+	return l.GetCreditPacks(in)
+}
+
+func (s *SuperServer) CreateCreditPack(ctx context.Context, in *super.CreateCreditPackReq) (*super.CreateCreditPackResp, error) {
+	l := logic.NewCreateCreditPackLogic(ctx, s.svcCtx)
+	return l.CreateCreditPack(in)
+}
+
 // VIP订单相关服务
 func (s *SuperServer) CreateVipOrder(ctx context.Context, in *super.CreateVipOrderReq) (*super.CreateVipOrderResp, error) {
 	l := logic.NewCreateVipOrderLogic(ctx, s.svcCtx)
@@ -101,6 +266,21 @@ func (s *SuperServer) GetVipOrders(ctx context.Context, in *super.GetVipOrdersRe
 	return l.GetVipOrders(in)
 }
 
+func (s *SuperServer) PayVipOrder(ctx context.Context, in *super.PayVipOrderReq) (*super.PayVipOrderResp, error) {
+	l := logic.NewPayVipOrderLogic(ctx, s.svcCtx)
+	return l.PayVipOrder(in)
+}
+
+func (s *SuperServer) CancelVipOrder(ctx context.Context, in *super.CancelVipOrderReq) (*super.CancelVipOrderResp, error) {
+	l := logic.NewCancelVipOrderLogic(ctx, s.svcCtx)
+	return l.CancelVipOrder(in)
+}
+
+func (s *SuperServer) PaymentNotify(ctx context.Context, in *super.PaymentNotifyReq) (*super.PaymentNotifyResp, error) {
+	l := logic.NewPaymentNotifyLogic(ctx, s.svcCtx)
+	return l.PaymentNotify(in)
+}
+
 // VIP记录相关服务
 func (s *SuperServer) GetVipRecords(ctx context.Context, in *super.GetVipRecordsReq) (*super.GetVipRecordsResp, error) {
 	l := logic.NewGetVipRecordsLogic(ctx, s.svcCtx)
@@ -143,3 +323,29 @@ func (s *SuperServer) UpdateAIUsage(ctx context.Context, in *super.UpdateAIUsage
 	l := logic.NewUpdateAIUsageLogic(ctx, s.svcCtx)
 	return l.UpdateAIUsage(in)
 }
+
+func (s *SuperServer) GetAIUsageHistory(ctx context.Context, in *super.GetAIUsageHistoryReq) (*super.GetAIUsageHistoryResp, error) {
+	l := logic.NewGetAIUsageHistoryLogic(ctx, s.svcCtx)
+	return l.GetAIUsageHistory(in)
+}
+
+func (s *SuperServer) GetAICredits(ctx context.Context, in *super.GetAICreditsReq) (*super.GetAICreditsResp, error) {
+	l := logic.NewGetAICreditsLogic(ctx, s.svcCtx)
+	return l.GetAICredits(in)
+}
+
+func (s *SuperServer) GetAICreditLedger(ctx context.Context, in *super.GetAICreditLedgerReq) (*super.GetAICreditLedgerResp, error) {
+	l := logic.NewGetAICreditLedgerLogic(ctx, s.svcCtx)
+	return l.GetAICreditLedger(in)
+}
+
+// AI请求相关服务
+func (s *SuperServer) AIRequest(ctx context.Context, in *super.AIRequestReq) (*super.AIRequestResp, error) {
+	l := logic.NewAIRequestLogic(ctx, s.svcCtx)
+	return l.AIRequest(in)
+}
+
+func (s *SuperServer) AIRequestStream(in *super.AIRequestReq, stream super.Super_AIRequestStreamServer) error {
+	l := logic.NewAIRequestStreamLogic(stream.Context(), s.svcCtx)
+	return l.AIRequestStream(in, stream)
+}
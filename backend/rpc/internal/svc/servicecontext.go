@@ -1,8 +1,27 @@
 package svc
 
 import (
+	"fmt"
+	"time"
+
+	"backend/migration"
+	"backend/model"
+	"backend/rpc/internal/aiprovider"
 	"backend/rpc/internal/config"
+	"backend/rpc/internal/dataexport"
+	"backend/rpc/internal/localauth"
+	"backend/rpc/internal/loginguard"
+	"backend/rpc/internal/mailer"
+	"backend/rpc/internal/metering"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/orderno"
+	"backend/rpc/internal/outbox"
+	"backend/rpc/internal/payment"
+	"backend/rpc/internal/twofactor"
+	"backend/rpc/internal/userdeletion"
+	"backend/rpc/internal/usertoken"
 	"backend/rpc/pb/auth"
+	"backend/rpc/pb/super"
 	"backend/utils"
 
 	"github.com/zeromicro/go-zero/zrpc"
@@ -10,9 +29,22 @@ import (
 )
 
 type ServiceContext struct {
-	Config     config.Config
-	DB         *gorm.DB
-	AuthClient auth.AuthClient // 外部认证服务客户端
+	Config       config.Config
+	DB           *gorm.DB
+	AuthClient   auth.AuthClient       // 认证服务客户端，外部服务或本地实现
+	Outbox       *outbox.Outbox        // 同步认证服务的操作
+	UserDeletion *userdeletion.Service // 账号注销
+	DataExport   *dataexport.Service   // 个人数据导出
+	LoginGuard   *loginguard.Guard     // 登录失败退避和账号锁定
+	TwoFactor    *twofactor.Service    // TOTP两步验证
+	OIDC         *oidc.Service         // 第三方登录
+	Mailer       mailer.Mailer         // 邮件发送
+	UserTokens   *usertoken.Issuer     // 邮箱验证和重置密码令牌
+	AIProvider   aiprovider.Provider   // AI模型提供方
+	Metering     *metering.Service     // AI用量计量
+
+	PaymentProvider payment.PaymentProvider // 支付提供方
+	OrderNo         *orderno.Generator      // 订单号生成器
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -26,18 +58,130 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
-	// 初始化外部服务客户端
+	// 执行数据迁移
+	if err := migration.Run(utils.GetDB()); err != nil {
+		panic(err)
+	}
+
+	// 初始化管理员账号
+	if len(c.BootstrapAdminUserIds) > 0 {
+		if err := utils.GetDB().Model(&model.User{}).
+			Where("id IN ?", c.BootstrapAdminUserIds).
+			Update("role", model.RoleAdmin).Error; err != nil {
+			panic(err)
+		}
+	}
+
+	// 初始化JWT密钥
+	utils.MustInitJWT(c.JWT)
+
+	// 初始化认证服务
 	var authClient auth.AuthClient
-	// 如果配置了AuthRpc，则初始化AuthClient
+	// 使用本地认证时直接读写本地用户表；否则如果配置了AuthRpc，则初始化AuthClient
 	// 支持两种方式：直接指定Endpoints或使用Etcd服务发现
-	if len(c.AuthRpc.Endpoints) > 0 || len(c.AuthRpc.Etcd.Hosts) > 0 {
+	if c.AuthBackend == config.AuthBackendLocal {
+		authClient = localauth.New(utils.GetDB())
+	} else if len(c.AuthRpc.Endpoints) > 0 || len(c.AuthRpc.Etcd.Hosts) > 0 {
 		authConn := zrpc.MustNewClient(c.AuthRpc)
 		authClient = auth.NewAuthClient(authConn.Conn())
 	}
 
+	// 初始化同步认证服务的操作投递
+	outboxService, err := outbox.New(utils.GetDB(), c.Outbox)
+	if err != nil {
+		panic(err)
+	}
+	outbox.RegisterAuth(outboxService, utils.GetDB(), authClient)
+
+	// 初始化账号注销
+	userDeletion, err := userdeletion.New(utils.GetDB(), c.UserDeletion)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化个人数据导出
+	dataExport, err := dataexport.New(utils.GetDB(), c.DataExport, dataexport.AuthProfile(authClient))
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化登录防护
+	loginGuard, err := loginguard.New(utils.GetDB(), c.LoginGuard)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化两步验证
+	twoFactor, err := twofactor.New(utils.GetDB(), c.TwoFactor)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化第三方登录
+	oidcService, err := oidc.New(utils.GetDB(), c.OIDC, c.WebBaseURL)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化邮件发送和邮件令牌
+	mailSender, err := mailer.New(c.Mail)
+	if err != nil {
+		panic(err)
+	}
+	userTokens, err := usertoken.New(utils.GetDB(), c.UserToken)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化AI模型提供方
+	aiProvider, err := aiprovider.New(c.AI)
+	if err != nil {
+		panic(err)
+	}
+	// 单独配置的AI同步请求RPC超时必须大于模型调用的超时，否则模型已计费，用户却收到超时错误
+	if timeout := c.AIRequestTimeout(); timeout > 0 && timeout <= time.Duration(c.AI.Timeout)*time.Millisecond {
+		panic(fmt.Sprintf("AIRequest的RPC超时（%s）必须大于AI.Timeout（%dms），请在MethodTimeouts中为%s配置更长的超时",
+			timeout, c.AI.Timeout, super.Super_AIRequest_FullMethodName))
+	}
+
+	// 初始化AI用量计量
+	defaultTZ, err := time.LoadLocation(c.DefaultTimezone)
+	if err != nil {
+		panic(err)
+	}
+	meteringService, err := metering.NewService(utils.GetDB(), c.FreeTier, defaultTZ)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化支付提供方
+	paymentProvider, err := payment.New(c.Payment)
+	if err != nil {
+		panic(err)
+	}
+
+	// 初始化订单号生成器
+	orderNoGenerator, err := orderno.NewGenerator(c.Order.NodeID)
+	if err != nil {
+		panic(err)
+	}
+
 	return &ServiceContext{
-		Config:     c,
-		DB:         utils.GetDB(),
-		AuthClient: authClient,
+		Config:       c,
+		DB:           utils.GetDB(),
+		AuthClient:   authClient,
+		Outbox:       outboxService,
+		UserDeletion: userDeletion,
+		DataExport:   dataExport,
+		LoginGuard:   loginGuard,
+		TwoFactor:    twoFactor,
+		OIDC:         oidcService,
+		Mailer:       mailSender,
+		UserTokens:   userTokens,
+		AIProvider:   aiProvider,
+		Metering:     meteringService,
+
+		PaymentProvider: paymentProvider,
+		OrderNo:         orderNoGenerator,
 	}
 }
@@ -0,0 +1,67 @@
+// Package testdb 需要真实MySQL的测试共用的数据库和测试数据，只应在测试中使用
+// 通过环境变量提供DSN，未设置时跳过测试，例如：
+// SUPER_TEST_MYSQL_DSN="root:password@tcp(127.0.0.1:3306)/super_test?parseTime=true&loc=Local"
+package testdb
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"backend/model"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// DSNEnv 测试数据库DSN的环境变量
+const DSNEnv = "SUPER_TEST_MYSQL_DSN"
+
+// seq 同一纳秒内创建多个用户时区分用户名
+var seq atomic.Int64
+
+// Open 连接测试数据库并迁移用户表和models的表结构，未设置DSN时跳过测试
+func Open(t testing.TB, models ...any) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv(DSNEnv)
+	if dsn == "" {
+		t.Skipf("未设置%s，跳过需要MySQL的测试", DSNEnv)
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("连接数据库失败: %v", err)
+	}
+	if err := db.AutoMigrate(append([]any{&model.User{}}, models...)...); err != nil {
+		t.Fatalf("迁移表结构失败: %v", err)
+	}
+
+	return db
+}
+
+// CreateUser 创建测试用户，测试结束时删除用户及其在tables中按user_id关联的数据
+func CreateUser(t testing.TB, db *gorm.DB, tables ...any) *model.User {
+	t.Helper()
+
+	name := fmt.Sprintf("test_%d_%d", time.Now().UnixNano(), seq.Add(1))
+	user := &model.User{
+		Username: name,
+		Email:    name + "@example.com",
+		Password: "password",
+	}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("创建用户失败: %v", err)
+	}
+	t.Cleanup(func() {
+		for _, table := range tables {
+			db.Unscoped().Where("user_id = ?", user.ID).Delete(table)
+		}
+		db.Unscoped().Delete(user)
+	})
+
+	return user
+}
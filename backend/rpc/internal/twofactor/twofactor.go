@@ -0,0 +1,356 @@
+package twofactor
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/totp"
+	"backend/utils"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrAlreadyEnabled 两步验证已启用
+	ErrAlreadyEnabled = errors.New("两步验证已启用")
+	// ErrNotEnabled 未启用两步验证
+	ErrNotEnabled = errors.New("未启用两步验证")
+	// ErrNotSetup 尚未生成密钥
+	ErrNotSetup = errors.New("请先生成两步验证密钥")
+	// ErrInvalidCode 验证码或恢复码错误
+	ErrInvalidCode = errors.New("验证码错误")
+	// ErrInvalidChallenge 挑战令牌无效、已过期或已使用
+	ErrInvalidChallenge = errors.New("两步验证已过期，请重新登录")
+)
+
+// Config 两步验证配置
+type Config struct {
+	Issuer        string `json:",default=SuperAI"`                  // 验证器应用中显示的发行方
+	EncryptionKey string `json:",optional,env=TOTP_ENCRYPTION_KEY"` // 加密保存TOTP密钥的密钥，至少32字节
+	ChallengeTTL  int64  `json:",default=300"`                      // 登录挑战有效期（秒）
+	MaxAttempts   int    `json:",default=5"`                        // 每个登录挑战允许的验证码错误次数
+	RecoveryCodes int    `json:",default=10"`                       // 每次生成的恢复码数量
+}
+
+// Service 管理用户的TOTP绑定、恢复码和登录挑战
+type Service struct {
+	db   *gorm.DB
+	c    Config
+	aead cipher.AEAD
+}
+
+// New 创建两步验证服务
+func New(db *gorm.DB, c Config) (*Service, error) {
+	if len(c.EncryptionKey) < 32 {
+		return nil, errors.New("两步验证加密密钥至少需要32字节，请配置TwoFactor.EncryptionKey或环境变量TOTP_ENCRYPTION_KEY")
+	}
+	if c.ChallengeTTL <= 0 || c.MaxAttempts <= 0 || c.RecoveryCodes <= 0 {
+		return nil, errors.New("两步验证的挑战有效期、错误次数和恢复码数量必须大于0")
+	}
+
+	key := sha256.Sum256([]byte(c.EncryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{db: db, c: c, aead: aead}, nil
+}
+
+// Status 是否已启用两步验证及剩余可用的恢复码数量
+func (s *Service) Status(ctx context.Context, userID uint) (bool, int64, error) {
+	enabled, err := s.Enabled(ctx, userID)
+	if err != nil || !enabled {
+		return false, 0, err
+	}
+
+	var remaining int64
+	err = s.db.WithContext(ctx).Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&remaining).Error
+	return true, remaining, err
+}
+
+// Enabled 用户是否已启用两步验证
+func (s *Service) Enabled(ctx context.Context, userID uint) (bool, error) {
+	var count int64
+	err := s.db.WithContext(ctx).Model(&model.UserTOTP{}).
+		Where("user_id = ? AND enabled_at IS NOT NULL", userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Setup 生成新的TOTP密钥，返回密钥和otpauth URI；未确认的旧密钥被替换
+func (s *Service) Setup(ctx context.Context, userID uint, account string) (string, string, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	sealed, err := s.seal(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current model.UserTOTP
+		err := tx.Where("user_id = ?", userID).First(&current).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return tx.Create(&model.UserTOTP{UserID: userID, Secret: sealed}).Error
+		case err != nil:
+			return err
+		case current.EnabledAt != nil:
+			return ErrAlreadyEnabled
+		}
+		return tx.Model(&current).Updates(map[string]any{"secret": sealed, "last_used_step": 0}).Error
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return secret, totp.URI(s.c.Issuer, account, secret), nil
+}
+
+// Enable 用验证器应用中的验证码确认绑定，启用两步验证并返回一组新的恢复码
+func (s *Service) Enable(ctx context.Context, userID uint, code string, now time.Time) ([]string, error) {
+	var codes []string
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current model.UserTOTP
+		if err := tx.Where("user_id = ?", userID).First(&current).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrNotSetup
+			}
+			return err
+		}
+		if current.EnabledAt != nil {
+			return ErrAlreadyEnabled
+		}
+
+		secret, err := s.open(current.Secret)
+		if err != nil {
+			return err
+		}
+		step, ok := totp.Validate(secret, code, now, current.LastUsedStep)
+		if !ok {
+			return ErrInvalidCode
+		}
+		result := tx.Model(&model.UserTOTP{}).
+			Where("id = ? AND enabled_at IS NULL", current.ID).
+			Updates(map[string]any{"enabled_at": now, "last_used_step": step})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrAlreadyEnabled
+		}
+
+		codes, err = s.replaceRecoveryCodes(tx, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Verify 校验验证码或恢复码，通过时返回是否使用了恢复码
+// 验证码和恢复码都只能使用一次，并发提交同一个码只有一个请求通过
+func (s *Service) Verify(ctx context.Context, userID uint, code string, now time.Time) (bool, error) {
+	var current model.UserTOTP
+	if err := s.db.WithContext(ctx).Where("user_id = ? AND enabled_at IS NOT NULL", userID).First(&current).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, ErrNotEnabled
+		}
+		return false, err
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		if err := s.useRecoveryCode(ctx, userID, code, now); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	secret, err := s.open(current.Secret)
+	if err != nil {
+		return false, err
+	}
+	step, ok := totp.Validate(secret, code, now, current.LastUsedStep)
+	if !ok {
+		return false, ErrInvalidCode
+	}
+	result := s.db.WithContext(ctx).Model(&model.UserTOTP{}).
+		Where("id = ? AND last_used_step < ?", current.ID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, ErrInvalidCode
+	}
+	return false, nil
+}
+
+// Disable 关闭两步验证并删除恢复码，调用方负责先校验用户身份
+func (s *Service) Disable(ctx context.Context, userID uint) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&model.UserTOTP{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.TwoFactorChallenge{}).
+			Where("user_id = ? AND used_at IS NULL", userID).
+			Update("used_at", time.Now()).Error
+	})
+}
+
+// Challenge 密码验证通过后签发登录挑战令牌，返回令牌和有效期
+func (s *Service) Challenge(ctx context.Context, userID uint, now time.Time) (string, time.Duration, error) {
+	token, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return "", 0, err
+	}
+	ttl := time.Duration(s.c.ChallengeTTL) * time.Second
+	if err := s.db.WithContext(ctx).Create(&model.TwoFactorChallenge{
+		UserID:    userID,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: now.Add(ttl),
+	}).Error; err != nil {
+		return "", 0, err
+	}
+	return token, ttl, nil
+}
+
+// ResolveChallenge 查找有效的登录挑战
+func (s *Service) ResolveChallenge(ctx context.Context, token string, now time.Time) (*model.TwoFactorChallenge, error) {
+	var challenge model.TwoFactorChallenge
+	err := s.db.WithContext(ctx).Where("token_hash = ?", utils.HashToken(token)).First(&challenge).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidChallenge
+	}
+	if err != nil {
+		return nil, err
+	}
+	if challenge.UsedAt != nil || !now.Before(challenge.ExpiresAt) {
+		return nil, ErrInvalidChallenge
+	}
+	return &challenge, nil
+}
+
+// CompleteChallenge 两步验证通过后作废挑战，同一挑战只能完成一次登录
+func (s *Service) CompleteChallenge(ctx context.Context, challenge *model.TwoFactorChallenge, now time.Time) error {
+	result := s.db.WithContext(ctx).Model(&model.TwoFactorChallenge{}).
+		Where("id = ? AND used_at IS NULL", challenge.ID).
+		Update("used_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidChallenge
+	}
+	return nil
+}
+
+// FailChallenge 记录一次验证码错误，错误次数达到上限时作废挑战，返回挑战是否已作废
+func (s *Service) FailChallenge(ctx context.Context, challenge *model.TwoFactorChallenge, now time.Time) (bool, error) {
+	updates := map[string]any{"attempts": gorm.Expr("attempts + 1")}
+	exhausted := challenge.Attempts+1 >= s.c.MaxAttempts
+	if exhausted {
+		updates["used_at"] = now
+	}
+	err := s.db.WithContext(ctx).Model(&model.TwoFactorChallenge{}).
+		Where("id = ? AND used_at IS NULL", challenge.ID).
+		Updates(updates).Error
+	return exhausted, err
+}
+
+// useRecoveryCode 核销一个恢复码
+func (s *Service) useRecoveryCode(ctx context.Context, userID uint, code string, now time.Time) error {
+	result := s.db.WithContext(ctx).Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, utils.HashToken(normalizeRecoveryCode(code))).
+		Update("used_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidCode
+	}
+	return nil
+}
+
+// replaceRecoveryCodes 删除旧的恢复码并生成一组新的，明文只在生成时返回一次
+func (s *Service) replaceRecoveryCodes(tx *gorm.DB, userID uint) ([]string, error) {
+	if err := tx.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, s.c.RecoveryCodes)
+	records := make([]model.RecoveryCode, 0, s.c.RecoveryCodes)
+	for range s.c.RecoveryCodes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		records = append(records, model.RecoveryCode{UserID: userID, CodeHash: utils.HashToken(normalizeRecoveryCode(code))})
+	}
+	if err := tx.Create(&records).Error; err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// generateRecoveryCode 生成形如 abcde-fghij 的恢复码（50位随机数）
+func generateRecoveryCode() (string, error) {
+	buf := make([]byte, 7)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+// normalizeRecoveryCode 忽略大小写、空格和连字符
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+}
+
+// seal 加密TOTP密钥，结果为Base64编码的 nonce+密文
+func (s *Service) seal(secret string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(s.aead.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+// open 解密TOTP密钥
+func (s *Service) open(sealed string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < s.aead.NonceSize() {
+		return "", errors.New("TOTP密钥格式错误")
+	}
+	nonce, ciphertext := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	secret, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
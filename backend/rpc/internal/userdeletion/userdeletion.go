@@ -0,0 +1,384 @@
+// Package userdeletion 管理账号注销的生命周期：停用、恢复、注销冷静期和到期后的彻底清除
+// 停用只软删除本地数据并阻止登录，认证服务中的用户在清除时才删除，因此保留期内可以完整恢复
+package userdeletion
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/outbox"
+
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrAlreadyRequested 已有待处理的注销申请
+	ErrAlreadyRequested = errors.New("已申请注销账号，请勿重复申请")
+	// ErrNotRequested 没有待处理的注销申请，或已被撤销、已清除
+	ErrNotRequested = errors.New("没有待处理的注销申请")
+	// ErrNotDeleted 用户未被删除，也没有待处理的注销申请
+	ErrNotDeleted = errors.New("用户未被删除")
+)
+
+// Config 账号注销配置
+type Config struct {
+	GracePeriod   int64 `json:",default=1296000"` // 用户申请注销后的冷静期（秒），期间账号可正常使用并可撤销
+	Retention     int64 `json:",default=2592000"` // 管理员删除的用户的保留时长（秒），期间可恢复
+	PurgeInterval int64 `json:",default=3600"`    // 清除到期用户的扫描间隔（秒）
+	BatchSize     int   `json:",default=100"`     // 每批清除的用户数
+}
+
+// Service 账号注销
+type Service struct {
+	db *gorm.DB
+	c  Config
+}
+
+// PurgeResult 一次清除的结果
+type PurgeResult struct {
+	AnonymizedOrders int64 // 保留并去除用户关联的订单数
+}
+
+// DeletedUser 已停用的用户及其待清除的注销记录
+type DeletedUser struct {
+	User     model.User
+	Deletion *model.UserDeletion // 没有待清除的注销记录时为nil
+}
+
+// softDeleted 停用用户时一起软删除的数据，恢复时按相同的删除时间找回
+var softDeleted = []any{&model.VipRecord{}, &model.VipOrder{}, &model.AIUsage{}}
+
+// erased 清除用户时硬删除的数据
+var erased = []any{
+	&model.VipRecord{},
+	&model.AIUsage{},
+	&model.AIMeter{},
+	&model.AIUsageLedger{},
+	&model.AICreditGrant{},
+	&model.AICreditLedger{},
+	&model.RefreshToken{},
+	&model.RevokedToken{},
+	&model.UserToken{},
+	&model.UserTOTP{},
+	&model.RecoveryCode{},
+	&model.TwoFactorChallenge{},
+	&model.UserIdentity{},
+	&model.LoginThrottle{},
+}
+
+// New 创建账号注销服务
+func New(db *gorm.DB, c Config) (*Service, error) {
+	if c.GracePeriod <= 0 || c.Retention <= 0 {
+		return nil, errors.New("注销冷静期和删除用户的保留时长必须大于0")
+	}
+	if c.PurgeInterval <= 0 || c.BatchSize <= 0 {
+		return nil, errors.New("清除用户的扫描间隔和批大小必须大于0")
+	}
+	return &Service{db: db, c: c}, nil
+}
+
+// Interval 清除到期用户的扫描间隔
+func (s *Service) Interval() time.Duration {
+	return time.Duration(s.c.PurgeInterval) * time.Second
+}
+
+// Pending 用户待清除的注销记录，没有时返回nil
+func (s *Service) Pending(ctx context.Context, userID uint) (*model.UserDeletion, error) {
+	return pending(s.db.WithContext(ctx), userID)
+}
+
+// Closed 账号是否已停用或已被清除，认证服务中的用户在清除时才删除，登录时需据此拒绝
+func (s *Service) Closed(ctx context.Context, userID uint) (bool, error) {
+	var user model.User
+	err := s.db.WithContext(ctx).Unscoped().Select("id", "deleted_at").First(&user, userID).Error
+	if err == nil {
+		return user.DeletedAt.Valid, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+	var count int64
+	err = s.db.WithContext(ctx).Model(&model.UserDeletion{}).
+		Where("user_id = ? AND status = ?", userID, model.UserDeletionPurged).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Request 用户申请注销，冷静期结束后清除，期间账号可正常使用
+func (s *Service) Request(ctx context.Context, userID uint, now time.Time) (*model.UserDeletion, error) {
+	var deletion *model.UserDeletion
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := pending(tx, userID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return ErrAlreadyRequested
+		}
+		deletion = &model.UserDeletion{
+			UserID:  userID,
+			Source:  model.UserDeletionSelf,
+			Status:  model.UserDeletionPending,
+			PurgeAt: now.Add(time.Duration(s.c.GracePeriod) * time.Second),
+		}
+		return tx.Create(deletion).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// Cancel 用户在冷静期内撤销注销申请
+func (s *Service) Cancel(ctx context.Context, userID uint, now time.Time) error {
+	result := s.db.WithContext(ctx).Model(&model.UserDeletion{}).
+		Where("user_id = ? AND status = ? AND source = ?", userID, model.UserDeletionPending, model.UserDeletionSelf).
+		Updates(map[string]interface{}{
+			"status":       model.UserDeletionCancelled,
+			"cancelled_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotRequested
+	}
+	return nil
+}
+
+// Deactivate 停用用户：软删除用户及其VIP记录、订单和AI使用记录，吊销刷新令牌，保留期结束后清除；需在事务中调用
+// 用户已申请注销时合并为同一条注销记录，清除时间取较早者
+func (s *Service) Deactivate(tx *gorm.DB, userID uint, source string, actorID uint, now time.Time) (*model.UserDeletion, error) {
+	// 1. 以同一删除时间软删除用户及其数据，恢复时据此找回
+	for _, related := range softDeleted {
+		if err := tx.Model(related).Where("user_id = ?", userID).Update("deleted_at", now).Error; err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", now).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&model.User{}).Where("id = ?", userID).Update("deleted_at", now).Error; err != nil {
+		return nil, err
+	}
+
+	// 2. 记录或合并注销记录
+	purgeAt := now.Add(time.Duration(s.c.Retention) * time.Second)
+	deletion, err := pending(tx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil {
+		deletion = &model.UserDeletion{UserID: userID, Status: model.UserDeletionPending, PurgeAt: purgeAt}
+	} else if purgeAt.Before(deletion.PurgeAt) {
+		deletion.PurgeAt = purgeAt
+	}
+	deletion.Source = source
+	deletion.ActorID = nil
+	if actorID != 0 {
+		deletion.ActorID = &actorID
+	}
+	if err := tx.Save(deletion).Error; err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// Restore 恢复已停用的用户及停用时一起删除的数据，并撤销待清除的注销记录
+// 用户未停用但已申请注销时只撤销注销记录；返回恢复前用户是否处于停用状态
+func (s *Service) Restore(ctx context.Context, userID uint, now time.Time) (bool, error) {
+	var deactivated bool
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user model.User
+		if err := tx.Unscoped().Select("id", "deleted_at").First(&user, userID).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&model.UserDeletion{}).
+			Where("user_id = ? AND status = ?", userID, model.UserDeletionPending).
+			Updates(map[string]interface{}{
+				"status":       model.UserDeletionCancelled,
+				"cancelled_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if !user.DeletedAt.Valid {
+			if result.RowsAffected == 0 {
+				return ErrNotDeleted
+			}
+			return nil
+		}
+
+		deactivated = true
+		deletedAt := user.DeletedAt.Time
+		for _, related := range softDeleted {
+			if err := tx.Unscoped().Model(related).
+				Where("user_id = ? AND deleted_at = ?", userID, deletedAt).
+				Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Unscoped().Model(&model.User{}).Where("id = ?", userID).Update("deleted_at", nil).Error
+	})
+	return deactivated, err
+}
+
+// Due 到期待清除的注销记录，afterID用于分批读取
+func (s *Service) Due(ctx context.Context, now time.Time, afterID uint) ([]model.UserDeletion, error) {
+	var deletions []model.UserDeletion
+	err := s.db.WithContext(ctx).
+		Where("status = ? AND purge_at <= ? AND id > ?", model.UserDeletionPending, now, afterID).
+		Order("id").
+		Limit(s.c.BatchSize).
+		Find(&deletions).Error
+	return deletions, err
+}
+
+// BatchSize 每批清除的用户数
+func (s *Service) BatchSize() int {
+	return s.c.BatchSize
+}
+
+// Purge 彻底清除用户数据，并在同一事务中记录删除认证服务用户的操作
+// 订单作为财务记录保留，但去除与用户的关联；审计日志按安全要求保留
+// 注销记录已被撤销或已由其他实例清除时返回 ErrNotRequested
+func (s *Service) Purge(ctx context.Context, deletion *model.UserDeletion, now time.Time) (*PurgeResult, error) {
+	res := &PurgeResult{}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 领取注销记录，与撤销、恢复互斥
+		result := tx.Model(&model.UserDeletion{}).
+			Where("id = ? AND status = ?", deletion.ID, model.UserDeletionPending).
+			Updates(map[string]interface{}{
+				"status":    model.UserDeletionPurged,
+				"purged_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotRequested
+		}
+
+		// 2. 硬删除个人数据
+		for _, table := range erased {
+			if err := tx.Unscoped().Where("user_id = ?", deletion.UserID).Delete(table).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("link_user_id = ?", deletion.UserID).Delete(&model.OAuthState{}).Error; err != nil {
+			return err
+		}
+
+		// 3. 保留订单并去除用户关联
+		result = tx.Unscoped().Model(&model.VipOrder{}).
+			Where("user_id = ?", deletion.UserID).
+			Updates(map[string]interface{}{
+				"user_id":         nil,
+				"idempotency_key": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		res.AnonymizedOrders = result.RowsAffected
+
+		// 4. 删除用户，并记录删除认证服务用户的操作
+		if err := tx.Unscoped().Delete(&model.User{}, deletion.UserID).Error; err != nil {
+			return err
+		}
+		_, err := outbox.Enqueue(tx, model.OutboxAuthDeleteUser, deletion.UserID, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Deleted 分页列出已停用的用户，按删除时间倒序
+func (s *Service) Deleted(ctx context.Context, page, pageSize int) ([]DeletedUser, int64, error) {
+	query := s.db.WithContext(ctx).Unscoped().Model(&model.User{}).Where("deleted_at IS NOT NULL")
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var users []model.User
+	if err := query.Order("deleted_at DESC, id DESC").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uint, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	var deletions []model.UserDeletion
+	if len(ids) > 0 {
+		if err := s.db.WithContext(ctx).
+			Where("user_id IN ? AND status = ?", ids, model.UserDeletionPending).
+			Find(&deletions).Error; err != nil {
+			return nil, 0, err
+		}
+	}
+	byUser := make(map[uint]*model.UserDeletion, len(deletions))
+	for i := range deletions {
+		byUser[deletions[i].UserID] = &deletions[i]
+	}
+
+	result := make([]DeletedUser, 0, len(users))
+	for _, u := range users {
+		result = append(result, DeletedUser{User: u, Deletion: byUser[u.ID]})
+	}
+	return result, total, nil
+}
+
+// Deactivated 管理员停用、尚未清除的用户数，这些用户在认证服务中仍存在
+func (s *Service) Deactivated(ctx context.Context) (int64, error) {
+	var count int64
+	err := s.db.WithContext(ctx).Model(&model.UserDeletion{}).
+		Where("status = ? AND source = ?", model.UserDeletionPending, model.UserDeletionAdmin).
+		Count(&count).Error
+	return count, err
+}
+
+// Scheduled 有待清除注销记录的用户
+func (s *Service) Scheduled(ctx context.Context) (map[uint]bool, error) {
+	return s.users(ctx, model.UserDeletionPending)
+}
+
+// Purged 已被彻底清除的用户
+func (s *Service) Purged(ctx context.Context) (map[uint]bool, error) {
+	return s.users(ctx, model.UserDeletionPurged)
+}
+
+func (s *Service) users(ctx context.Context, status string) (map[uint]bool, error) {
+	var ids []uint
+	if err := s.db.WithContext(ctx).Model(&model.UserDeletion{}).
+		Where("status = ?", status).
+		Distinct().Pluck("user_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	users := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		users[id] = true
+	}
+	return users, nil
+}
+
+func pending(db *gorm.DB, userID uint) (*model.UserDeletion, error) {
+	var deletion model.UserDeletion
+	err := db.Where("user_id = ? AND status = ?", userID, model.UserDeletionPending).
+		Order("id").
+		First(&deletion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
@@ -0,0 +1,70 @@
+package userdeletion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+func TestPurgeKeepsOrdersWithoutUser(t *testing.T) {
+	db := testdb.Open(t, append([]any{&model.VipPlan{}, &model.CreditPack{}, &model.VipOrder{}, &model.UserDeletion{},
+		&model.OAuthState{}, &model.OutboxEvent{}}, erased...)...)
+	ctx := context.Background()
+	now := time.Now()
+
+	user := testdb.CreateUser(t, db, &model.UserDeletion{}, &model.OutboxEvent{})
+	key := user.Username
+	order := &model.VipOrder{
+		UserID:         &user.ID,
+		ProductType:    model.OrderProductVipPlan,
+		OrderNo:        user.Username,
+		Amount:         9.9,
+		Status:         model.VipOrderStatusPaid,
+		IdempotencyKey: &key,
+	}
+	if err := db.Create(order).Error; err != nil {
+		t.Fatalf("创建订单失败: %v", err)
+	}
+	t.Cleanup(func() { db.Unscoped().Delete(order) })
+
+	svc, err := New(db, Config{GracePeriod: 60, Retention: 60, PurgeInterval: 60, BatchSize: 10})
+	if err != nil {
+		t.Fatalf("创建注销服务失败: %v", err)
+	}
+	var deletion *model.UserDeletion
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		deletion, err = svc.Deactivate(tx, user.ID, model.UserDeletionAdmin, 0, now)
+		return err
+	}); err != nil {
+		t.Fatalf("停用用户失败: %v", err)
+	}
+
+	res, err := svc.Purge(ctx, deletion, now)
+	if err != nil {
+		t.Fatalf("清除用户失败: %v", err)
+	}
+	if res.AnonymizedOrders != 1 {
+		t.Fatalf("去除用户关联的订单数为%d，期望1", res.AnonymizedOrders)
+	}
+
+	// 订单保留，用户关联和幂等键被清空
+	var kept model.VipOrder
+	if err := db.Unscoped().First(&kept, order.ID).Error; err != nil {
+		t.Fatalf("查找保留的订单失败: %v", err)
+	}
+	if kept.UserID != nil || kept.IdempotencyKey != nil {
+		t.Fatalf("保留的订单仍关联用户: user_id=%v idempotency_key=%v", kept.UserID, kept.IdempotencyKey)
+	}
+	var users int64
+	if err := db.Unscoped().Model(&model.User{}).Where("id = ?", user.ID).Count(&users).Error; err != nil {
+		t.Fatalf("查询用户失败: %v", err)
+	}
+	if users != 0 {
+		t.Fatal("清除后用户仍存在")
+	}
+}
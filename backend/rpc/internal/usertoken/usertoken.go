@@ -0,0 +1,151 @@
+package usertoken
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/utils"
+
+	"gorm.io/gorm"
+)
+
+// ErrInvalid 令牌签名错误、不存在、已使用或已过期
+var ErrInvalid = errors.New("链接无效或已过期")
+
+// Config 邮件令牌配置
+type Config struct {
+	Secret           string `json:",optional,env=USER_TOKEN_SECRET"` // 令牌签名密钥，至少32字节
+	VerifyEmailTTL   int64  `json:",default=86400"`                  // 邮箱验证令牌有效期（秒）
+	PasswordResetTTL int64  `json:",default=1800"`                   // 重置密码令牌有效期（秒）
+}
+
+// Issuer 签发和核销通过邮件发送的一次性令牌
+// 令牌格式为 随机串.签名，签名绑定用途，核销时先校验签名，再按摘要查库确认未使用、未过期
+type Issuer struct {
+	db     *gorm.DB
+	secret []byte
+	ttl    map[string]time.Duration
+}
+
+// New 创建令牌签发器
+func New(db *gorm.DB, c Config) (*Issuer, error) {
+	if len(c.Secret) < 32 {
+		return nil, errors.New("邮件令牌签名密钥至少需要32字节，请配置UserToken.Secret或环境变量USER_TOKEN_SECRET")
+	}
+	if c.VerifyEmailTTL <= 0 || c.PasswordResetTTL <= 0 {
+		return nil, errors.New("邮件令牌有效期必须大于0")
+	}
+	return &Issuer{
+		db:     db,
+		secret: []byte(c.Secret),
+		ttl: map[string]time.Duration{
+			model.UserTokenVerifyEmail:   time.Duration(c.VerifyEmailTTL) * time.Second,
+			model.UserTokenPasswordReset: time.Duration(c.PasswordResetTTL) * time.Second,
+		},
+	}, nil
+}
+
+// TTL 用途对应的有效期
+func (i *Issuer) TTL(purpose string) time.Duration {
+	return i.ttl[purpose]
+}
+
+// Issue 为用户签发令牌，同一用途之前签发的未使用令牌同时作废
+func (i *Issuer) Issue(ctx context.Context, userID uint, purpose, email string) (string, error) {
+	ttl, ok := i.ttl[purpose]
+	if !ok {
+		return "", fmt.Errorf("未知的令牌用途: %s", purpose)
+	}
+
+	payload, err := utils.GenerateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+	token := payload + "." + i.sign(purpose, payload)
+
+	now := time.Now()
+	err = i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.UserToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+			Update("used_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.UserToken{
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: utils.HashToken(token),
+			Email:     email,
+			ExpiresAt: now.Add(ttl),
+		}).Error
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Consume 核销令牌，成功后令牌不能再次使用；令牌无效时返回ErrInvalid
+// 核销是一条带未使用条件的UPDATE，并发使用同一令牌只有一个请求成功
+func (i *Issuer) Consume(ctx context.Context, purpose, token string) (*model.UserToken, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(i.sign(purpose, payload))) {
+		return nil, ErrInvalid
+	}
+
+	var record model.UserToken
+	err := i.db.WithContext(ctx).
+		Where("token_hash = ? AND purpose = ?", utils.HashToken(token), purpose).
+		First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if record.UsedAt != nil || !now.Before(record.ExpiresAt) {
+		return nil, ErrInvalid
+	}
+	result := i.db.WithContext(ctx).Model(&record).
+		Where("used_at IS NULL").
+		Update("used_at", now)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrInvalid
+	}
+	return &record, nil
+}
+
+// Release 核销后的操作失败时恢复令牌，使用户可以用同一链接重试
+// 令牌在此期间被新令牌取代时不会恢复
+func (i *Issuer) Release(ctx context.Context, record *model.UserToken) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var newer int64
+		if err := tx.Model(&model.UserToken{}).
+			Where("user_id = ? AND purpose = ? AND id > ?", record.UserID, record.Purpose, record.ID).
+			Count(&newer).Error; err != nil {
+			return err
+		}
+		if newer > 0 {
+			return nil
+		}
+		return tx.Model(record).Update("used_at", nil).Error
+	})
+}
+
+// sign 计算令牌签名，签名包含用途，一种用途的令牌不能用于另一种用途
+func (i *Issuer) sign(purpose, payload string) string {
+	mac := hmac.New(sha256.New, i.secret)
+	mac.Write([]byte(purpose + "." + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
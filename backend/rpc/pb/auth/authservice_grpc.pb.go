@@ -25,6 +25,7 @@ const (
 	Auth_GetUser_FullMethodName            = "/authservice.Auth/GetUser"
 	Auth_UpdateUserInfo_FullMethodName     = "/authservice.Auth/UpdateUserInfo"
 	Auth_UpdateUserPassword_FullMethodName = "/authservice.Auth/UpdateUserPassword"
+	Auth_ResetUserPassword_FullMethodName  = "/authservice.Auth/ResetUserPassword"
 	Auth_DeleteUser_FullMethodName         = "/authservice.Auth/DeleteUser"
 	Auth_UpdateUserVip_FullMethodName      = "/authservice.Auth/UpdateUserVip"
 	Auth_GetUsers_FullMethodName           = "/authservice.Auth/GetUsers"
@@ -41,6 +42,7 @@ type AuthClient interface {
 	GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error)
 	UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*UpdateUserInfoResp, error)
 	UpdateUserPassword(ctx context.Context, in *UpdateUserPasswordReq, opts ...grpc.CallOption) (*UpdateUserPasswordResp, error)
+	ResetUserPassword(ctx context.Context, in *ResetUserPasswordReq, opts ...grpc.CallOption) (*ResetUserPasswordResp, error)
 	DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error)
 	UpdateUserVip(ctx context.Context, in *UpdateUserVipReq, opts ...grpc.CallOption) (*UpdateUserVipResp, error)
 	GetUsers(ctx context.Context, in *GetUsersReq, opts ...grpc.CallOption) (*GetUsersResp, error)
@@ -109,6 +111,15 @@ func (c *authClient) UpdateUserPassword(ctx context.Context, in *UpdateUserPassw
 	return out, nil
 }
 
+func (c *authClient) ResetUserPassword(ctx context.Context, in *ResetUserPasswordReq, opts ...grpc.CallOption) (*ResetUserPasswordResp, error) {
+	out := new(ResetUserPasswordResp)
+	err := c.cc.Invoke(ctx, Auth_ResetUserPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authClient) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error) {
 	out := new(DeleteUserResp)
 	err := c.cc.Invoke(ctx, Auth_DeleteUser_FullMethodName, in, out, opts...)
@@ -155,6 +166,7 @@ type AuthServer interface {
 	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
 	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
 	UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error)
+	ResetUserPassword(context.Context, *ResetUserPasswordReq) (*ResetUserPasswordResp, error)
 	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
 	UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error)
 	GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error)
@@ -184,6 +196,9 @@ func (UnimplementedAuthServer) UpdateUserInfo(context.Context, *UpdateUserInfoRe
 func (UnimplementedAuthServer) UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
 }
+func (UnimplementedAuthServer) ResetUserPassword(context.Context, *ResetUserPasswordReq) (*ResetUserPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserPassword not implemented")
+}
 func (UnimplementedAuthServer) DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
@@ -317,6 +332,24 @@ func _Auth_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_ResetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ResetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ResetUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ResetUserPassword(ctx, req.(*ResetUserPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Auth_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteUserReq)
 	if err := dec(in); err != nil {
@@ -420,6 +453,10 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserPassword",
 			Handler:    _Auth_UpdateUserPassword_Handler,
 		},
+		{
+			MethodName: "ResetUserPassword",
+			Handler:    _Auth_ResetUserPassword_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _Auth_DeleteUser_Handler,
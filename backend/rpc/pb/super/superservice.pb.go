@@ -35,6 +35,7 @@ type User struct {
 	IsVip        bool   `protobuf:"varint,7,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
 	VipExpiresAt string `protobuf:"bytes,8,opt,name=vip_expires_at,json=vipExpiresAt,proto3" json:"vip_expires_at,omitempty"`
 	AutoRenew    bool   `protobuf:"varint,9,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	Role         string `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"` // 角色：user, operator, admin
 }
 
 func (x *User) Reset() {
@@ -132,6 +133,13 @@ func (x *User) GetAutoRenew() bool {
 	return false
 }
 
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 // 用户注册请求
 type RegisterReq struct {
 	state         protoimpl.MessageState
@@ -253,6 +261,7 @@ type LoginReq struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Email    string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ClientIp string `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 客户端IP，由网关填写，用于按来源限制失败尝试
 }
 
 func (x *LoginReq) Reset() {
@@ -308,14 +317,26 @@ func (x *LoginReq) GetPassword() string {
 	return ""
 }
 
+func (x *LoginReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
 // 用户登录响应
 type LoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	User               *User  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token              string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                                        // 访问Token
+	RefreshToken       string `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`                      // 刷新令牌
+	ExpiresIn          int64  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`                              // 访问Token有效期（秒）
+	TwoFactorRequired  bool   `protobuf:"varint,5,opt,name=two_factor_required,json=twoFactorRequired,proto3" json:"two_factor_required,omitempty"`    // 需要两步验证，此时不返回用户和Token，凭challenge_token提交验证码完成登录
+	ChallengeToken     string `protobuf:"bytes,6,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`                // 两步验证挑战令牌
+	ChallengeExpiresIn int64  `protobuf:"varint,7,opt,name=challenge_expires_in,json=challengeExpiresIn,proto3" json:"challenge_expires_in,omitempty"` // 挑战令牌有效期（秒）
 }
 
 func (x *LoginResp) Reset() {
@@ -364,16 +385,54 @@ func (x *LoginResp) GetToken() string {
 	return ""
 }
 
-type GetUserInfoReq struct {
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginResp) GetTwoFactorRequired() bool {
+	if x != nil {
+		return x.TwoFactorRequired
+	}
+	return false
+}
+
+func (x *LoginResp) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetChallengeExpiresIn() int64 {
+	if x != nil {
+		return x.ChallengeExpiresIn
+	}
+	return 0
+}
+
+// 提交两步验证码完成登录，code为验证器应用中的6位验证码或恢复码
+type VerifyTwoFactorLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	Code           string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp       string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 }
 
-func (x *GetUserInfoReq) Reset() {
-	*x = GetUserInfoReq{}
+func (x *VerifyTwoFactorLoginReq) Reset() {
+	*x = VerifyTwoFactorLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,13 +440,13 @@ func (x *GetUserInfoReq) Reset() {
 	}
 }
 
-func (x *GetUserInfoReq) String() string {
+func (x *VerifyTwoFactorLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoReq) ProtoMessage() {}
+func (*VerifyTwoFactorLoginReq) ProtoMessage() {}
 
-func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
+func (x *VerifyTwoFactorLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,28 +458,44 @@ func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
-func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyTwoFactorLoginReq.ProtoReflect.Descriptor instead.
+func (*VerifyTwoFactorLoginReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetUserInfoReq) GetUserId() string {
+func (x *VerifyTwoFactorLoginReq) GetChallengeToken() string {
 	if x != nil {
-		return x.UserId
+		return x.ChallengeToken
 	}
 	return ""
 }
 
-type GetUserInfoResp struct {
+func (x *VerifyTwoFactorLoginReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyTwoFactorLoginReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+// 第三方登录提供方
+type OAuthProvider struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // 提供方标识，用于接口路径和回调地址
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 登录按钮上显示的名称
 }
 
-func (x *GetUserInfoResp) Reset() {
-	*x = GetUserInfoResp{}
+func (x *OAuthProvider) Reset() {
+	*x = OAuthProvider{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,13 +503,13 @@ func (x *GetUserInfoResp) Reset() {
 	}
 }
 
-func (x *GetUserInfoResp) String() string {
+func (x *OAuthProvider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoResp) ProtoMessage() {}
+func (*OAuthProvider) ProtoMessage() {}
 
-func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
+func (x *OAuthProvider) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -446,29 +521,33 @@ func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoResp.ProtoReflect.Descriptor instead.
-func (*GetUserInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use OAuthProvider.ProtoReflect.Descriptor instead.
+func (*OAuthProvider) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetUserInfoResp) GetUser() *User {
+func (x *OAuthProvider) GetName() string {
 	if x != nil {
-		return x.User
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// 获取单个用户请求
-type GetUserReq struct {
+func (x *OAuthProvider) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type GetOAuthProvidersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetUserReq) Reset() {
-	*x = GetUserReq{}
+func (x *GetOAuthProvidersReq) Reset() {
+	*x = GetOAuthProvidersReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,13 +555,13 @@ func (x *GetUserReq) Reset() {
 	}
 }
 
-func (x *GetUserReq) String() string {
+func (x *GetOAuthProvidersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserReq) ProtoMessage() {}
+func (*GetOAuthProvidersReq) ProtoMessage() {}
 
-func (x *GetUserReq) ProtoReflect() protoreflect.Message {
+func (x *GetOAuthProvidersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,29 +573,21 @@ func (x *GetUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserReq.ProtoReflect.Descriptor instead.
-func (*GetUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOAuthProvidersReq.ProtoReflect.Descriptor instead.
+func (*GetOAuthProvidersReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetUserReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-// 获取单个用户响应
-type GetUserResp struct {
+type GetOAuthProvidersResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Providers []*OAuthProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 }
 
-func (x *GetUserResp) Reset() {
-	*x = GetUserResp{}
+func (x *GetOAuthProvidersResp) Reset() {
+	*x = GetOAuthProvidersResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -524,13 +595,13 @@ func (x *GetUserResp) Reset() {
 	}
 }
 
-func (x *GetUserResp) String() string {
+func (x *GetOAuthProvidersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResp) ProtoMessage() {}
+func (*GetOAuthProvidersResp) ProtoMessage() {}
 
-func (x *GetUserResp) ProtoReflect() protoreflect.Message {
+func (x *GetOAuthProvidersResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,32 +613,30 @@ func (x *GetUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResp.ProtoReflect.Descriptor instead.
-func (*GetUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOAuthProvidersResp.ProtoReflect.Descriptor instead.
+func (*GetOAuthProvidersResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUserResp) GetUser() *User {
+func (x *GetOAuthProvidersResp) GetProviders() []*OAuthProvider {
 	if x != nil {
-		return x.User
+		return x.Providers
 	}
 	return nil
 }
 
-// 更新用户信息请求
-type UpdateUserInfoReq struct {
+// 生成第三方授权页地址，link_user_id不为空时为已登录用户绑定第三方账号，否则为第三方登录
+type StartOAuthReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Avatar   string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Provider   string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	LinkUserId string `protobuf:"bytes,2,opt,name=link_user_id,json=linkUserId,proto3" json:"link_user_id,omitempty"`
 }
 
-func (x *UpdateUserInfoReq) Reset() {
-	*x = UpdateUserInfoReq{}
+func (x *StartOAuthReq) Reset() {
+	*x = StartOAuthReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,13 +644,13 @@ func (x *UpdateUserInfoReq) Reset() {
 	}
 }
 
-func (x *UpdateUserInfoReq) String() string {
+func (x *StartOAuthReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserInfoReq) ProtoMessage() {}
+func (*StartOAuthReq) ProtoMessage() {}
 
-func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
+func (x *StartOAuthReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,50 +662,35 @@ func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartOAuthReq.ProtoReflect.Descriptor instead.
+func (*StartOAuthReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateUserInfoReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdateUserInfoReq) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UpdateUserInfoReq) GetEmail() string {
+func (x *StartOAuthReq) GetProvider() string {
 	if x != nil {
-		return x.Email
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoReq) GetAvatar() string {
+func (x *StartOAuthReq) GetLinkUserId() string {
 	if x != nil {
-		return x.Avatar
+		return x.LinkUserId
 	}
 	return ""
 }
 
-// 更新用户信息响应
-type UpdateUserInfoResp struct {
+type StartOAuthResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
 }
 
-func (x *UpdateUserInfoResp) Reset() {
-	*x = UpdateUserInfoResp{}
+func (x *StartOAuthResp) Reset() {
+	*x = StartOAuthResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -644,13 +698,13 @@ func (x *UpdateUserInfoResp) Reset() {
 	}
 }
 
-func (x *UpdateUserInfoResp) String() string {
+func (x *StartOAuthResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserInfoResp) ProtoMessage() {}
+func (*StartOAuthResp) ProtoMessage() {}
 
-func (x *UpdateUserInfoResp) ProtoReflect() protoreflect.Message {
+func (x *StartOAuthResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,31 +716,32 @@ func (x *UpdateUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserInfoResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartOAuthResp.ProtoReflect.Descriptor instead.
+func (*StartOAuthResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateUserInfoResp) GetUser() *User {
+func (x *StartOAuthResp) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.User
+		return x.AuthorizationUrl
 	}
-	return nil
+	return ""
 }
 
-// 更新用户密码请求
-type UpdateUserPasswordReq struct {
+// 第三方授权后携带回调中的code和state完成登录
+type OAuthLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OldPassword string `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State    string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ClientIp string `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 }
 
-func (x *UpdateUserPasswordReq) Reset() {
-	*x = UpdateUserPasswordReq{}
+func (x *OAuthLoginReq) Reset() {
+	*x = OAuthLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,13 +749,13 @@ func (x *UpdateUserPasswordReq) Reset() {
 	}
 }
 
-func (x *UpdateUserPasswordReq) String() string {
+func (x *OAuthLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPasswordReq) ProtoMessage() {}
+func (*OAuthLoginReq) ProtoMessage() {}
 
-func (x *UpdateUserPasswordReq) ProtoReflect() protoreflect.Message {
+func (x *OAuthLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -712,41 +767,53 @@ func (x *UpdateUserPasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPasswordReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserPasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use OAuthLoginReq.ProtoReflect.Descriptor instead.
+func (*OAuthLoginReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateUserPasswordReq) GetUserId() string {
+func (x *OAuthLoginReq) GetProvider() string {
 	if x != nil {
-		return x.UserId
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *UpdateUserPasswordReq) GetOldPassword() string {
+func (x *OAuthLoginReq) GetCode() string {
 	if x != nil {
-		return x.OldPassword
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateUserPasswordReq) GetNewPassword() string {
+func (x *OAuthLoginReq) GetState() string {
 	if x != nil {
-		return x.NewPassword
+		return x.State
 	}
 	return ""
 }
 
-// 更新用户密码响应
-type UpdateUserPasswordResp struct {
+func (x *OAuthLoginReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+// 用户绑定的第三方账号
+type UserIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                  // 绑定时提供方返回的邮箱
+	CreatedAt   string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // 绑定时间
+	LastLoginAt string `protobuf:"bytes,4,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"` // 最后一次通过该账号登录的时间
 }
 
-func (x *UpdateUserPasswordResp) Reset() {
-	*x = UpdateUserPasswordResp{}
+func (x *UserIdentity) Reset() {
+	*x = UserIdentity{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -754,13 +821,13 @@ func (x *UpdateUserPasswordResp) Reset() {
 	}
 }
 
-func (x *UpdateUserPasswordResp) String() string {
+func (x *UserIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPasswordResp) ProtoMessage() {}
+func (*UserIdentity) ProtoMessage() {}
 
-func (x *UpdateUserPasswordResp) ProtoReflect() protoreflect.Message {
+func (x *UserIdentity) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -772,22 +839,53 @@ func (x *UpdateUserPasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPasswordResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserPasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserIdentity.ProtoReflect.Descriptor instead.
+func (*UserIdentity) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{12}
 }
 
-// 删除用户请求
-type DeleteUserReq struct {
+func (x *UserIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserIdentity) GetLastLoginAt() string {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return ""
+}
+
+// 第三方授权后携带回调中的code和state完成绑定
+type LinkOAuthIdentityReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code     string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	State    string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *DeleteUserReq) Reset() {
-	*x = DeleteUserReq{}
+func (x *LinkOAuthIdentityReq) Reset() {
+	*x = LinkOAuthIdentityReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -795,13 +893,13 @@ func (x *DeleteUserReq) Reset() {
 	}
 }
 
-func (x *DeleteUserReq) String() string {
+func (x *LinkOAuthIdentityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserReq) ProtoMessage() {}
+func (*LinkOAuthIdentityReq) ProtoMessage() {}
 
-func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
+func (x *LinkOAuthIdentityReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -813,27 +911,49 @@ func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserReq.ProtoReflect.Descriptor instead.
-func (*DeleteUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkOAuthIdentityReq.ProtoReflect.Descriptor instead.
+func (*LinkOAuthIdentityReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeleteUserReq) GetUserId() string {
+func (x *LinkOAuthIdentityReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-// 删除用户响应
-type DeleteUserResp struct {
+func (x *LinkOAuthIdentityReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkOAuthIdentityReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LinkOAuthIdentityReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type LinkOAuthIdentityResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Identity *UserIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func (x *DeleteUserResp) Reset() {
-	*x = DeleteUserResp{}
+func (x *LinkOAuthIdentityResp) Reset() {
+	*x = LinkOAuthIdentityResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -841,13 +961,13 @@ func (x *DeleteUserResp) Reset() {
 	}
 }
 
-func (x *DeleteUserResp) String() string {
+func (x *LinkOAuthIdentityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResp) ProtoMessage() {}
+func (*LinkOAuthIdentityResp) ProtoMessage() {}
 
-func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
+func (x *LinkOAuthIdentityResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,24 +979,28 @@ func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResp.ProtoReflect.Descriptor instead.
-func (*DeleteUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use LinkOAuthIdentityResp.ProtoReflect.Descriptor instead.
+func (*LinkOAuthIdentityResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{14}
 }
 
-// 更新用户VIP状态请求
-type UpdateUserVipReq struct {
+func (x *LinkOAuthIdentityResp) GetIdentity() *UserIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type GetUserIdentitiesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	IsVip      bool   `protobuf:"varint,2,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	VipExpires string `protobuf:"bytes,3,opt,name=vip_expires,json=vipExpires,proto3" json:"vip_expires,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *UpdateUserVipReq) Reset() {
-	*x = UpdateUserVipReq{}
+func (x *GetUserIdentitiesReq) Reset() {
+	*x = GetUserIdentitiesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -884,13 +1008,13 @@ func (x *UpdateUserVipReq) Reset() {
 	}
 }
 
-func (x *UpdateUserVipReq) String() string {
+func (x *GetUserIdentitiesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserVipReq) ProtoMessage() {}
+func (*GetUserIdentitiesReq) ProtoMessage() {}
 
-func (x *UpdateUserVipReq) ProtoReflect() protoreflect.Message {
+func (x *GetUserIdentitiesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,43 +1026,28 @@ func (x *UpdateUserVipReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserVipReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserVipReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserIdentitiesReq.ProtoReflect.Descriptor instead.
+func (*GetUserIdentitiesReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateUserVipReq) GetUserId() string {
+func (x *GetUserIdentitiesReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateUserVipReq) GetIsVip() bool {
-	if x != nil {
-		return x.IsVip
-	}
-	return false
-}
-
-func (x *UpdateUserVipReq) GetVipExpires() string {
-	if x != nil {
-		return x.VipExpires
-	}
-	return ""
-}
-
-// 更新用户VIP状态响应
-type UpdateUserVipResp struct {
+type GetUserIdentitiesResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Identities []*UserIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
 }
 
-func (x *UpdateUserVipResp) Reset() {
-	*x = UpdateUserVipResp{}
+func (x *GetUserIdentitiesResp) Reset() {
+	*x = GetUserIdentitiesResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -946,13 +1055,13 @@ func (x *UpdateUserVipResp) Reset() {
 	}
 }
 
-func (x *UpdateUserVipResp) String() string {
+func (x *GetUserIdentitiesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserVipResp) ProtoMessage() {}
+func (*GetUserIdentitiesResp) ProtoMessage() {}
 
-func (x *UpdateUserVipResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserIdentitiesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -964,29 +1073,29 @@ func (x *UpdateUserVipResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserVipResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserVipResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserIdentitiesResp.ProtoReflect.Descriptor instead.
+func (*GetUserIdentitiesResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateUserVipResp) GetUser() *User {
+func (x *GetUserIdentitiesResp) GetIdentities() []*UserIdentity {
 	if x != nil {
-		return x.User
+		return x.Identities
 	}
 	return nil
 }
 
-type GetUsersReq struct {
+type UnlinkUserIdentityReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 }
 
-func (x *GetUsersReq) Reset() {
-	*x = GetUsersReq{}
+func (x *UnlinkUserIdentityReq) Reset() {
+	*x = UnlinkUserIdentityReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -994,13 +1103,13 @@ func (x *GetUsersReq) Reset() {
 	}
 }
 
-func (x *GetUsersReq) String() string {
+func (x *UnlinkUserIdentityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersReq) ProtoMessage() {}
+func (*UnlinkUserIdentityReq) ProtoMessage() {}
 
-func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
+func (x *UnlinkUserIdentityReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,36 +1121,33 @@ func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersReq.ProtoReflect.Descriptor instead.
-func (*GetUsersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlinkUserIdentityReq.ProtoReflect.Descriptor instead.
+func (*UnlinkUserIdentityReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetUsersReq) GetPage() int32 {
+func (x *UnlinkUserIdentityReq) GetUserId() string {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUsersReq) GetPageSize() int32 {
+func (x *UnlinkUserIdentityReq) GetProvider() string {
 	if x != nil {
-		return x.PageSize
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-type GetUsersResp struct {
+type UnlinkUserIdentityResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *GetUsersResp) Reset() {
-	*x = GetUsersResp{}
+func (x *UnlinkUserIdentityResp) Reset() {
+	*x = UnlinkUserIdentityResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,13 +1155,13 @@ func (x *GetUsersResp) Reset() {
 	}
 }
 
-func (x *GetUsersResp) String() string {
+func (x *UnlinkUserIdentityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersResp) ProtoMessage() {}
+func (*UnlinkUserIdentityResp) ProtoMessage() {}
 
-func (x *GetUsersResp) ProtoReflect() protoreflect.Message {
+func (x *UnlinkUserIdentityResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1067,33 +1173,22 @@ func (x *GetUsersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersResp.ProtoReflect.Descriptor instead.
-func (*GetUsersResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlinkUserIdentityResp.ProtoReflect.Descriptor instead.
+func (*UnlinkUserIdentityResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetUsersResp) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *GetUsersResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type GetUserCountReq struct {
+// 刷新Token请求
+type RefreshTokenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *GetUserCountReq) Reset() {
-	*x = GetUserCountReq{}
+func (x *RefreshTokenReq) Reset() {
+	*x = RefreshTokenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,13 +1196,13 @@ func (x *GetUserCountReq) Reset() {
 	}
 }
 
-func (x *GetUserCountReq) String() string {
+func (x *RefreshTokenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCountReq) ProtoMessage() {}
+func (*RefreshTokenReq) ProtoMessage() {}
 
-func (x *GetUserCountReq) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,21 +1214,31 @@ func (x *GetUserCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCountReq.ProtoReflect.Descriptor instead.
-func (*GetUserCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{19}
 }
 
-type GetUserCountResp struct {
+func (x *RefreshTokenReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// 刷新Token响应，旧的刷新令牌随之失效
+type RefreshTokenResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn    int64  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 }
 
-func (x *GetUserCountResp) Reset() {
-	*x = GetUserCountResp{}
+func (x *RefreshTokenResp) Reset() {
+	*x = RefreshTokenResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1141,13 +1246,13 @@ func (x *GetUserCountResp) Reset() {
 	}
 }
 
-func (x *GetUserCountResp) String() string {
+func (x *RefreshTokenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCountResp) ProtoMessage() {}
+func (*RefreshTokenResp) ProtoMessage() {}
 
-func (x *GetUserCountResp) ProtoReflect() protoreflect.Message {
+func (x *RefreshTokenResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1159,35 +1264,46 @@ func (x *GetUserCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCountResp.ProtoReflect.Descriptor instead.
-func (*GetUserCountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshTokenResp.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetUserCountResp) GetCount() int32 {
+func (x *RefreshTokenResp) GetToken() string {
 	if x != nil {
-		return x.Count
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
 	}
 	return 0
 }
 
-// VIP套餐相关消息
-type VipPlan struct {
+// 登出请求
+type LogoutReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description  string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price        float32 `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
-	DurationDays int32   `protobuf:"varint,5,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
-	CreatedAt    string  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt    string  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TokenId        string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`                         // 当前访问Token的jti
+	TokenExpiresAt int64  `protobuf:"varint,3,opt,name=token_expires_at,json=tokenExpiresAt,proto3" json:"token_expires_at,omitempty"` // 当前访问Token的过期时间（Unix秒）
+	RefreshToken   string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`          // 可选，同时吊销该刷新令牌所在的家族
 }
 
-func (x *VipPlan) Reset() {
-	*x = VipPlan{}
+func (x *LogoutReq) Reset() {
+	*x = LogoutReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1195,13 +1311,13 @@ func (x *VipPlan) Reset() {
 	}
 }
 
-func (x *VipPlan) String() string {
+func (x *LogoutReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VipPlan) ProtoMessage() {}
+func (*LogoutReq) ProtoMessage() {}
 
-func (x *VipPlan) ProtoReflect() protoreflect.Message {
+func (x *LogoutReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1213,71 +1329,47 @@ func (x *VipPlan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VipPlan.ProtoReflect.Descriptor instead.
-func (*VipPlan) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
+func (*LogoutReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *VipPlan) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *VipPlan) GetName() string {
+func (x *LogoutReq) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *VipPlan) GetDescription() string {
+func (x *LogoutReq) GetTokenId() string {
 	if x != nil {
-		return x.Description
+		return x.TokenId
 	}
 	return ""
 }
 
-func (x *VipPlan) GetPrice() float32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *VipPlan) GetDurationDays() int32 {
+func (x *LogoutReq) GetTokenExpiresAt() int64 {
 	if x != nil {
-		return x.DurationDays
+		return x.TokenExpiresAt
 	}
 	return 0
 }
 
-func (x *VipPlan) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *VipPlan) GetUpdatedAt() string {
+func (x *LogoutReq) GetRefreshToken() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RefreshToken
 	}
 	return ""
 }
 
-// 获取单个VIP套餐请求
-type GetVipPlanReq struct {
+type LogoutResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
 
-func (x *GetVipPlanReq) Reset() {
-	*x = GetVipPlanReq{}
+func (x *LogoutResp) Reset() {
+	*x = LogoutResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1285,13 +1377,13 @@ func (x *GetVipPlanReq) Reset() {
 	}
 }
 
-func (x *GetVipPlanReq) String() string {
+func (x *LogoutResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlanReq) ProtoMessage() {}
+func (*LogoutResp) ProtoMessage() {}
 
-func (x *GetVipPlanReq) ProtoReflect() protoreflect.Message {
+func (x *LogoutResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1303,29 +1395,24 @@ func (x *GetVipPlanReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlanReq.ProtoReflect.Descriptor instead.
-func (*GetVipPlanReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogoutResp.ProtoReflect.Descriptor instead.
+func (*LogoutResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetVipPlanReq) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-// 获取单个VIP套餐响应
-type GetVipPlanResp struct {
+// 设置用户角色请求，撤销角色即设置为user
+type SetUserRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plan *VipPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role       string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	OperatorId string `protobuf:"bytes,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID
 }
 
-func (x *GetVipPlanResp) Reset() {
-	*x = GetVipPlanResp{}
+func (x *SetUserRoleReq) Reset() {
+	*x = SetUserRoleReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1333,13 +1420,13 @@ func (x *GetVipPlanResp) Reset() {
 	}
 }
 
-func (x *GetVipPlanResp) String() string {
+func (x *SetUserRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlanResp) ProtoMessage() {}
+func (*SetUserRoleReq) ProtoMessage() {}
 
-func (x *GetVipPlanResp) ProtoReflect() protoreflect.Message {
+func (x *SetUserRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1351,32 +1438,43 @@ func (x *GetVipPlanResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlanResp.ProtoReflect.Descriptor instead.
-func (*GetVipPlanResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserRoleReq.ProtoReflect.Descriptor instead.
+func (*SetUserRoleReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetVipPlanResp) GetPlan() *VipPlan {
+func (x *SetUserRoleReq) GetUserId() string {
 	if x != nil {
-		return x.Plan
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// 创建VIP套餐请求
-type CreateVipPlanReq struct {
+func (x *SetUserRoleReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetUserRoleReq) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type SetUserRoleResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description  string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price        float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	DurationDays int32   `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role   string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *CreateVipPlanReq) Reset() {
-	*x = CreateVipPlanReq{}
+func (x *SetUserRoleResp) Reset() {
+	*x = SetUserRoleResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1384,13 +1482,13 @@ func (x *CreateVipPlanReq) Reset() {
 	}
 }
 
-func (x *CreateVipPlanReq) String() string {
+func (x *SetUserRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipPlanReq) ProtoMessage() {}
+func (*SetUserRoleResp) ProtoMessage() {}
 
-func (x *CreateVipPlanReq) ProtoReflect() protoreflect.Message {
+func (x *SetUserRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1402,50 +1500,37 @@ func (x *CreateVipPlanReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipPlanReq.ProtoReflect.Descriptor instead.
-func (*CreateVipPlanReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserRoleResp.ProtoReflect.Descriptor instead.
+func (*SetUserRoleResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateVipPlanReq) GetName() string {
+func (x *SetUserRoleResp) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateVipPlanReq) GetDescription() string {
+func (x *SetUserRoleResp) GetRole() string {
 	if x != nil {
-		return x.Description
+		return x.Role
 	}
 	return ""
 }
 
-func (x *CreateVipPlanReq) GetPrice() float32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *CreateVipPlanReq) GetDurationDays() int32 {
-	if x != nil {
-		return x.DurationDays
-	}
-	return 0
-}
-
-// 创建VIP套餐响应
-type CreateVipPlanResp struct {
+// 解除账号的登录锁定并清零失败次数
+type UnlockUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plan *VipPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID
 }
 
-func (x *CreateVipPlanResp) Reset() {
-	*x = CreateVipPlanResp{}
+func (x *UnlockUserReq) Reset() {
+	*x = UnlockUserReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1453,13 +1538,13 @@ func (x *CreateVipPlanResp) Reset() {
 	}
 }
 
-func (x *CreateVipPlanResp) String() string {
+func (x *UnlockUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipPlanResp) ProtoMessage() {}
+func (*UnlockUserReq) ProtoMessage() {}
 
-func (x *CreateVipPlanResp) ProtoReflect() protoreflect.Message {
+func (x *UnlockUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1471,26 +1556,36 @@ func (x *CreateVipPlanResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipPlanResp.ProtoReflect.Descriptor instead.
-func (*CreateVipPlanResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockUserReq.ProtoReflect.Descriptor instead.
+func (*UnlockUserReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateVipPlanResp) GetPlan() *VipPlan {
+func (x *UnlockUserReq) GetUserId() string {
 	if x != nil {
-		return x.Plan
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetVipPlansReq struct {
+func (x *UnlockUserReq) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type UnlockUserResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WasLocked bool   `protobuf:"varint,2,opt,name=was_locked,json=wasLocked,proto3" json:"was_locked,omitempty"` // 解除前是否处于锁定中
 }
 
-func (x *GetVipPlansReq) Reset() {
-	*x = GetVipPlansReq{}
+func (x *UnlockUserResp) Reset() {
+	*x = UnlockUserResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1498,13 +1593,13 @@ func (x *GetVipPlansReq) Reset() {
 	}
 }
 
-func (x *GetVipPlansReq) String() string {
+func (x *UnlockUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlansReq) ProtoMessage() {}
+func (*UnlockUserResp) ProtoMessage() {}
 
-func (x *GetVipPlansReq) ProtoReflect() protoreflect.Message {
+func (x *UnlockUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1516,21 +1611,36 @@ func (x *GetVipPlansReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlansReq.ProtoReflect.Descriptor instead.
-func (*GetVipPlansReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockUserResp.ProtoReflect.Descriptor instead.
+func (*UnlockUserResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{26}
 }
 
-type GetVipPlansResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Plans []*VipPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+func (x *UnlockUserResp) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *GetVipPlansResp) Reset() {
-	*x = GetVipPlansResp{}
+func (x *UnlockUserResp) GetWasLocked() bool {
+	if x != nil {
+		return x.WasLocked
+	}
+	return false
+}
+
+// 两步验证状态
+type GetTwoFactorStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetTwoFactorStatusReq) Reset() {
+	*x = GetTwoFactorStatusReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1538,13 +1648,13 @@ func (x *GetVipPlansResp) Reset() {
 	}
 }
 
-func (x *GetVipPlansResp) String() string {
+func (x *GetTwoFactorStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlansResp) ProtoMessage() {}
+func (*GetTwoFactorStatusReq) ProtoMessage() {}
 
-func (x *GetVipPlansResp) ProtoReflect() protoreflect.Message {
+func (x *GetTwoFactorStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1556,36 +1666,29 @@ func (x *GetVipPlansResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlansResp.ProtoReflect.Descriptor instead.
-func (*GetVipPlansResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTwoFactorStatusReq.ProtoReflect.Descriptor instead.
+func (*GetTwoFactorStatusReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetVipPlansResp) GetPlans() []*VipPlan {
+func (x *GetTwoFactorStatusReq) GetUserId() string {
 	if x != nil {
-		return x.Plans
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// VIP订单相关消息
-type VipOrder struct {
+type GetTwoFactorStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId    string  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId    string  `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	PlanName  string  `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
-	Amount    float32 `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status    string  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt string  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PaidAt    string  `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Enabled                bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RecoveryCodesRemaining int64 `protobuf:"varint,2,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"` // 剩余可用的恢复码数量
 }
 
-func (x *VipOrder) Reset() {
-	*x = VipOrder{}
+func (x *GetTwoFactorStatusResp) Reset() {
+	*x = GetTwoFactorStatusResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,13 +1696,13 @@ func (x *VipOrder) Reset() {
 	}
 }
 
-func (x *VipOrder) String() string {
+func (x *GetTwoFactorStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VipOrder) ProtoMessage() {}
+func (*GetTwoFactorStatusResp) ProtoMessage() {}
 
-func (x *VipOrder) ProtoReflect() protoreflect.Message {
+func (x *GetTwoFactorStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,78 +1714,36 @@ func (x *VipOrder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VipOrder.ProtoReflect.Descriptor instead.
-func (*VipOrder) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTwoFactorStatusResp.ProtoReflect.Descriptor instead.
+func (*GetTwoFactorStatusResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *VipOrder) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *VipOrder) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *VipOrder) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-func (x *VipOrder) GetPlanName() string {
+func (x *GetTwoFactorStatusResp) GetEnabled() bool {
 	if x != nil {
-		return x.PlanName
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *VipOrder) GetAmount() float32 {
+func (x *GetTwoFactorStatusResp) GetRecoveryCodesRemaining() int64 {
 	if x != nil {
-		return x.Amount
+		return x.RecoveryCodesRemaining
 	}
 	return 0
 }
 
-func (x *VipOrder) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *VipOrder) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *VipOrder) GetPaidAt() string {
-	if x != nil {
-		return x.PaidAt
-	}
-	return ""
-}
-
-type CreateVipOrderReq struct {
+// 生成TOTP密钥，确认绑定前不生效
+type SetupTwoFactorReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
 
-func (x *CreateVipOrderReq) Reset() {
-	*x = CreateVipOrderReq{}
+func (x *SetupTwoFactorReq) Reset() {
+	*x = SetupTwoFactorReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1690,13 +1751,13 @@ func (x *CreateVipOrderReq) Reset() {
 	}
 }
 
-func (x *CreateVipOrderReq) String() string {
+func (x *SetupTwoFactorReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipOrderReq) ProtoMessage() {}
+func (*SetupTwoFactorReq) ProtoMessage() {}
 
-func (x *CreateVipOrderReq) ProtoReflect() protoreflect.Message {
+func (x *SetupTwoFactorReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1708,35 +1769,29 @@ func (x *CreateVipOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipOrderReq.ProtoReflect.Descriptor instead.
-func (*CreateVipOrderReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupTwoFactorReq.ProtoReflect.Descriptor instead.
+func (*SetupTwoFactorReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateVipOrderReq) GetUserId() string {
+func (x *SetupTwoFactorReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateVipOrderReq) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-type CreateVipOrderResp struct {
+type SetupTwoFactorResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order *VipOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Secret     string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                           // Base32编码的密钥，用于手动输入
+	OtpauthUri string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"` // otpauth URI，用于生成二维码
 }
 
-func (x *CreateVipOrderResp) Reset() {
-	*x = CreateVipOrderResp{}
+func (x *SetupTwoFactorResp) Reset() {
+	*x = SetupTwoFactorResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1744,13 +1799,13 @@ func (x *CreateVipOrderResp) Reset() {
 	}
 }
 
-func (x *CreateVipOrderResp) String() string {
+func (x *SetupTwoFactorResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipOrderResp) ProtoMessage() {}
+func (*SetupTwoFactorResp) ProtoMessage() {}
 
-func (x *CreateVipOrderResp) ProtoReflect() protoreflect.Message {
+func (x *SetupTwoFactorResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1762,30 +1817,37 @@ func (x *CreateVipOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipOrderResp.ProtoReflect.Descriptor instead.
-func (*CreateVipOrderResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetupTwoFactorResp.ProtoReflect.Descriptor instead.
+func (*SetupTwoFactorResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreateVipOrderResp) GetOrder() *VipOrder {
+func (x *SetupTwoFactorResp) GetSecret() string {
 	if x != nil {
-		return x.Order
+		return x.Secret
 	}
-	return nil
+	return ""
 }
 
-type GetVipOrdersReq struct {
+func (x *SetupTwoFactorResp) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// 输入验证码确认绑定并启用两步验证
+type EnableTwoFactorReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *GetVipOrdersReq) Reset() {
-	*x = GetVipOrdersReq{}
+func (x *EnableTwoFactorReq) Reset() {
+	*x = EnableTwoFactorReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1793,13 +1855,13 @@ func (x *GetVipOrdersReq) Reset() {
 	}
 }
 
-func (x *GetVipOrdersReq) String() string {
+func (x *EnableTwoFactorReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipOrdersReq) ProtoMessage() {}
+func (*EnableTwoFactorReq) ProtoMessage() {}
 
-func (x *GetVipOrdersReq) ProtoReflect() protoreflect.Message {
+func (x *EnableTwoFactorReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1811,43 +1873,35 @@ func (x *GetVipOrdersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipOrdersReq.ProtoReflect.Descriptor instead.
-func (*GetVipOrdersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableTwoFactorReq.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetVipOrdersReq) GetUserId() string {
+func (x *EnableTwoFactorReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetVipOrdersReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetVipOrdersReq) GetPageSize() int32 {
+func (x *EnableTwoFactorReq) GetCode() string {
 	if x != nil {
-		return x.PageSize
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type GetVipOrdersResp struct {
+type EnableTwoFactorResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*VipOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total  int32       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"` // 恢复码，只在启用时返回一次
 }
 
-func (x *GetVipOrdersResp) Reset() {
-	*x = GetVipOrdersResp{}
+func (x *EnableTwoFactorResp) Reset() {
+	*x = EnableTwoFactorResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,13 +1909,13 @@ func (x *GetVipOrdersResp) Reset() {
 	}
 }
 
-func (x *GetVipOrdersResp) String() string {
+func (x *EnableTwoFactorResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipOrdersResp) ProtoMessage() {}
+func (*EnableTwoFactorResp) ProtoMessage() {}
 
-func (x *GetVipOrdersResp) ProtoReflect() protoreflect.Message {
+func (x *EnableTwoFactorResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1873,43 +1927,31 @@ func (x *GetVipOrdersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipOrdersResp.ProtoReflect.Descriptor instead.
-func (*GetVipOrdersResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnableTwoFactorResp.ProtoReflect.Descriptor instead.
+func (*EnableTwoFactorResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetVipOrdersResp) GetOrders() []*VipOrder {
+func (x *EnableTwoFactorResp) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.Orders
+		return x.RecoveryCodes
 	}
 	return nil
 }
 
-func (x *GetVipOrdersResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// VIP记录相关消息
-type VipRecord struct {
+// 关闭两步验证，需要验证码或恢复码
+type DisableTwoFactorReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId    string `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	PlanName  string `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
-	StartAt   string `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	EndAt     string `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
-	Status    string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 客户端IP，用于按来源限制失败尝试
 }
 
-func (x *VipRecord) Reset() {
-	*x = VipRecord{}
+func (x *DisableTwoFactorReq) Reset() {
+	*x = DisableTwoFactorReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1917,13 +1959,13 @@ func (x *VipRecord) Reset() {
 	}
 }
 
-func (x *VipRecord) String() string {
+func (x *DisableTwoFactorReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VipRecord) ProtoMessage() {}
+func (*DisableTwoFactorReq) ProtoMessage() {}
 
-func (x *VipRecord) ProtoReflect() protoreflect.Message {
+func (x *DisableTwoFactorReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1935,94 +1977,97 @@ func (x *VipRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VipRecord.ProtoReflect.Descriptor instead.
-func (*VipRecord) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTwoFactorReq.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *VipRecord) GetId() string {
+func (x *DisableTwoFactorReq) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *VipRecord) GetUserId() string {
+func (x *DisableTwoFactorReq) GetCode() string {
 	if x != nil {
-		return x.UserId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *VipRecord) GetPlanId() string {
+func (x *DisableTwoFactorReq) GetClientIp() string {
 	if x != nil {
-		return x.PlanId
+		return x.ClientIp
 	}
 	return ""
 }
 
-func (x *VipRecord) GetPlanName() string {
-	if x != nil {
-		return x.PlanName
-	}
-	return ""
+type DisableTwoFactorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *VipRecord) GetStartAt() string {
-	if x != nil {
-		return x.StartAt
+func (x *DisableTwoFactorResp) Reset() {
+	*x = DisableTwoFactorResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *VipRecord) GetEndAt() string {
-	if x != nil {
-		return x.EndAt
-	}
-	return ""
+func (x *DisableTwoFactorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VipRecord) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (*DisableTwoFactorResp) ProtoMessage() {}
+
+func (x *DisableTwoFactorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VipRecord) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use DisableTwoFactorResp.ProtoReflect.Descriptor instead.
+func (*DisableTwoFactorResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{34}
 }
 
-type GetVipRecordsReq struct {
+// 管理员重置用户的两步验证（用户丢失验证器和恢复码时）
+type ResetTwoFactorReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID
 }
 
-func (x *GetVipRecordsReq) Reset() {
-	*x = GetVipRecordsReq{}
+func (x *ResetTwoFactorReq) Reset() {
+	*x = ResetTwoFactorReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[34]
+		mi := &file_superservice_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVipRecordsReq) String() string {
+func (x *ResetTwoFactorReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipRecordsReq) ProtoMessage() {}
+func (*ResetTwoFactorReq) ProtoMessage() {}
 
-func (x *GetVipRecordsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[34]
+func (x *ResetTwoFactorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,58 +2078,48 @@ func (x *GetVipRecordsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipRecordsReq.ProtoReflect.Descriptor instead.
-func (*GetVipRecordsReq) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ResetTwoFactorReq.ProtoReflect.Descriptor instead.
+func (*ResetTwoFactorReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetVipRecordsReq) GetUserId() string {
+func (x *ResetTwoFactorReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetVipRecordsReq) GetPage() int32 {
+func (x *ResetTwoFactorReq) GetOperatorId() string {
 	if x != nil {
-		return x.Page
+		return x.OperatorId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetVipRecordsReq) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-type GetVipRecordsResp struct {
+type ResetTwoFactorResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Records []*VipRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	Total   int32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *GetVipRecordsResp) Reset() {
-	*x = GetVipRecordsResp{}
+func (x *ResetTwoFactorResp) Reset() {
+	*x = ResetTwoFactorResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[35]
+		mi := &file_superservice_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVipRecordsResp) String() string {
+func (x *ResetTwoFactorResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipRecordsResp) ProtoMessage() {}
+func (*ResetTwoFactorResp) ProtoMessage() {}
 
-func (x *GetVipRecordsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[35]
+func (x *ResetTwoFactorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,51 +2130,39 @@ func (x *GetVipRecordsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipRecordsResp.ProtoReflect.Descriptor instead.
-func (*GetVipRecordsResp) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *GetVipRecordsResp) GetRecords() []*VipRecord {
-	if x != nil {
-		return x.Records
-	}
-	return nil
-}
-
-func (x *GetVipRecordsResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use ResetTwoFactorResp.ProtoReflect.Descriptor instead.
+func (*ResetTwoFactorResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{36}
 }
 
-// 获取用户活跃VIP记录请求
-type GetUserActiveVipRecordReq struct {
+// 对账本地用户表与认证服务的用户，修复不一致
+// 用户名和邮箱以认证服务为准，VIP状态以本地为准；有未投递的同步操作的用户跳过
+type ReconcileUsersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DryRun     bool   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`            // 只报告差异，不修复
+	OperatorId string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID
 }
 
-func (x *GetUserActiveVipRecordReq) Reset() {
-	*x = GetUserActiveVipRecordReq{}
+func (x *ReconcileUsersReq) Reset() {
+	*x = ReconcileUsersReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[36]
+		mi := &file_superservice_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserActiveVipRecordReq) String() string {
+func (x *ReconcileUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActiveVipRecordReq) ProtoMessage() {}
+func (*ReconcileUsersReq) ProtoMessage() {}
 
-func (x *GetUserActiveVipRecordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[36]
+func (x *ReconcileUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2150,44 +2173,54 @@ func (x *GetUserActiveVipRecordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActiveVipRecordReq.ProtoReflect.Descriptor instead.
-func (*GetUserActiveVipRecordReq) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ReconcileUsersReq.ProtoReflect.Descriptor instead.
+func (*ReconcileUsersReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetUserActiveVipRecordReq) GetUserId() string {
+func (x *ReconcileUsersReq) GetDryRun() bool {
 	if x != nil {
-		return x.UserId
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReconcileUsersReq) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
 	}
 	return ""
 }
 
-// 获取用户活跃VIP记录响应
-type GetUserActiveVipRecordResp struct {
+type UserDrift struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Record *VipRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind     string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`          // 差异类型：missing_local 本地缺少, missing_remote 认证服务缺少, remote_not_deleted 本地已删除但认证服务未删除, profile_mismatch 用户名或邮箱不一致, vip_mismatch VIP状态不一致
+	Detail   string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`      // 差异说明
+	Repaired bool   `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"` // 是否已修复
+	Error    string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`        // 修复失败的原因
 }
 
-func (x *GetUserActiveVipRecordResp) Reset() {
-	*x = GetUserActiveVipRecordResp{}
+func (x *UserDrift) Reset() {
+	*x = UserDrift{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[37]
+		mi := &file_superservice_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserActiveVipRecordResp) String() string {
+func (x *UserDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActiveVipRecordResp) ProtoMessage() {}
+func (*UserDrift) ProtoMessage() {}
 
-func (x *GetUserActiveVipRecordResp) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[37]
+func (x *UserDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2198,78 +2231,59 @@ func (x *GetUserActiveVipRecordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActiveVipRecordResp.ProtoReflect.Descriptor instead.
-func (*GetUserActiveVipRecordResp) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use UserDrift.ProtoReflect.Descriptor instead.
+func (*UserDrift) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetUserActiveVipRecordResp) GetRecord() *VipRecord {
+func (x *UserDrift) GetUserId() string {
 	if x != nil {
-		return x.Record
+		return x.UserId
 	}
-	return nil
-}
-
-// VIP状态相关消息
-type GetUserVipStatusReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	return ""
 }
 
-func (x *GetUserVipStatusReq) Reset() {
-	*x = GetUserVipStatusReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[38]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UserDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
+	return ""
 }
 
-func (x *GetUserVipStatusReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetUserVipStatusReq) ProtoMessage() {}
-
-func (x *GetUserVipStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[38]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *UserDrift) GetDetail() string {
+	if x != nil {
+		return x.Detail
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetUserVipStatusReq.ProtoReflect.Descriptor instead.
-func (*GetUserVipStatusReq) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{38}
+func (x *UserDrift) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
 }
 
-func (x *GetUserVipStatusReq) GetUserId() string {
+func (x *UserDrift) GetError() string {
 	if x != nil {
-		return x.UserId
+		return x.Error
 	}
 	return ""
 }
 
-type GetUserVipStatusResp struct {
+type ReconcileUsersResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsVip     bool   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	ExpiresAt string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	AutoRenew bool   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	RemoteUsers int32        `protobuf:"varint,1,opt,name=remote_users,json=remoteUsers,proto3" json:"remote_users,omitempty"` // 认证服务用户数
+	LocalUsers  int32        `protobuf:"varint,2,opt,name=local_users,json=localUsers,proto3" json:"local_users,omitempty"`    // 本地用户数（含已删除）
+	Skipped     int32        `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`                            // 有未投递的同步操作而跳过的用户数
+	Drifts      []*UserDrift `protobuf:"bytes,4,rep,name=drifts,proto3" json:"drifts,omitempty"`
 }
 
-func (x *GetUserVipStatusResp) Reset() {
-	*x = GetUserVipStatusResp{}
+func (x *ReconcileUsersResp) Reset() {
+	*x = ReconcileUsersResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2277,13 +2291,13 @@ func (x *GetUserVipStatusResp) Reset() {
 	}
 }
 
-func (x *GetUserVipStatusResp) String() string {
+func (x *ReconcileUsersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserVipStatusResp) ProtoMessage() {}
+func (*ReconcileUsersResp) ProtoMessage() {}
 
-func (x *GetUserVipStatusResp) ProtoReflect() protoreflect.Message {
+func (x *ReconcileUsersResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2295,42 +2309,51 @@ func (x *GetUserVipStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserVipStatusResp.ProtoReflect.Descriptor instead.
-func (*GetUserVipStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconcileUsersResp.ProtoReflect.Descriptor instead.
+func (*ReconcileUsersResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetUserVipStatusResp) GetIsVip() bool {
+func (x *ReconcileUsersResp) GetRemoteUsers() int32 {
 	if x != nil {
-		return x.IsVip
+		return x.RemoteUsers
 	}
-	return false
+	return 0
 }
 
-func (x *GetUserVipStatusResp) GetExpiresAt() string {
+func (x *ReconcileUsersResp) GetLocalUsers() int32 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.LocalUsers
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUserVipStatusResp) GetAutoRenew() bool {
+func (x *ReconcileUsersResp) GetSkipped() int32 {
 	if x != nil {
-		return x.AutoRenew
+		return x.Skipped
 	}
-	return false
+	return 0
 }
 
-type CheckUserVipReq struct {
+func (x *ReconcileUsersResp) GetDrifts() []*UserDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+// 恢复已删除的用户，或撤销用户待清除的注销申请
+type RestoreUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID
 }
 
-func (x *CheckUserVipReq) Reset() {
-	*x = CheckUserVipReq{}
+func (x *RestoreUserReq) Reset() {
+	*x = RestoreUserReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2338,13 +2361,13 @@ func (x *CheckUserVipReq) Reset() {
 	}
 }
 
-func (x *CheckUserVipReq) String() string {
+func (x *RestoreUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserVipReq) ProtoMessage() {}
+func (*RestoreUserReq) ProtoMessage() {}
 
-func (x *CheckUserVipReq) ProtoReflect() protoreflect.Message {
+func (x *RestoreUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2356,28 +2379,35 @@ func (x *CheckUserVipReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserVipReq.ProtoReflect.Descriptor instead.
-func (*CheckUserVipReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreUserReq.ProtoReflect.Descriptor instead.
+func (*RestoreUserReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CheckUserVipReq) GetUserId() string {
+func (x *RestoreUserReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type CheckUserVipResp struct {
+func (x *RestoreUserReq) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type RestoreUserResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsVip bool `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	WasDeleted bool `protobuf:"varint,1,opt,name=was_deleted,json=wasDeleted,proto3" json:"was_deleted,omitempty"` // 恢复前是否处于删除状态，为false表示只撤销了注销申请
 }
 
-func (x *CheckUserVipResp) Reset() {
-	*x = CheckUserVipResp{}
+func (x *RestoreUserResp) Reset() {
+	*x = RestoreUserResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2385,13 +2415,13 @@ func (x *CheckUserVipResp) Reset() {
 	}
 }
 
-func (x *CheckUserVipResp) String() string {
+func (x *RestoreUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserVipResp) ProtoMessage() {}
+func (*RestoreUserResp) ProtoMessage() {}
 
-func (x *CheckUserVipResp) ProtoReflect() protoreflect.Message {
+func (x *RestoreUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2403,29 +2433,30 @@ func (x *CheckUserVipResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserVipResp.ProtoReflect.Descriptor instead.
-func (*CheckUserVipResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreUserResp.ProtoReflect.Descriptor instead.
+func (*RestoreUserResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CheckUserVipResp) GetIsVip() bool {
+func (x *RestoreUserResp) GetWasDeleted() bool {
 	if x != nil {
-		return x.IsVip
+		return x.WasDeleted
 	}
 	return false
 }
 
-type UpdateAutoRenewReq struct {
+// 已删除、尚未清除的用户
+type GetDeletedUsersReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AutoRenew bool   `protobuf:"varint,2,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *UpdateAutoRenewReq) Reset() {
-	*x = UpdateAutoRenewReq{}
+func (x *GetDeletedUsersReq) Reset() {
+	*x = GetDeletedUsersReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2433,13 +2464,13 @@ func (x *UpdateAutoRenewReq) Reset() {
 	}
 }
 
-func (x *UpdateAutoRenewReq) String() string {
+func (x *GetDeletedUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAutoRenewReq) ProtoMessage() {}
+func (*GetDeletedUsersReq) ProtoMessage() {}
 
-func (x *UpdateAutoRenewReq) ProtoReflect() protoreflect.Message {
+func (x *GetDeletedUsersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,33 +2482,40 @@ func (x *UpdateAutoRenewReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAutoRenewReq.ProtoReflect.Descriptor instead.
-func (*UpdateAutoRenewReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeletedUsersReq.ProtoReflect.Descriptor instead.
+func (*GetDeletedUsersReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateAutoRenewReq) GetUserId() string {
+func (x *GetDeletedUsersReq) GetPage() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAutoRenewReq) GetAutoRenew() bool {
+func (x *GetDeletedUsersReq) GetPageSize() int32 {
 	if x != nil {
-		return x.AutoRenew
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-type UpdateAutoRenewResp struct {
+type DeletedUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DeletedAt string `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Source    string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                  // 删除来源：admin 管理员删除, reconcile 对账发现认证服务中已不存在，旧数据为空
+	PurgeAt   string `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // 计划清除时间，没有注销记录时为空
 }
 
-func (x *UpdateAutoRenewResp) Reset() {
-	*x = UpdateAutoRenewResp{}
+func (x *DeletedUser) Reset() {
+	*x = DeletedUser{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2485,13 +2523,13 @@ func (x *UpdateAutoRenewResp) Reset() {
 	}
 }
 
-func (x *UpdateAutoRenewResp) String() string {
+func (x *DeletedUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAutoRenewResp) ProtoMessage() {}
+func (*DeletedUser) ProtoMessage() {}
 
-func (x *UpdateAutoRenewResp) ProtoReflect() protoreflect.Message {
+func (x *DeletedUser) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2503,21 +2541,64 @@ func (x *UpdateAutoRenewResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAutoRenewResp.ProtoReflect.Descriptor instead.
-func (*UpdateAutoRenewResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeletedUser.ProtoReflect.Descriptor instead.
+func (*DeletedUser) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{43}
 }
 
-type SyncUserVipStatusReq struct {
+func (x *DeletedUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletedUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *DeletedUser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DeletedUser) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *DeletedUser) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DeletedUser) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+type GetDeletedUsersResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Users []*DeletedUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total int32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *SyncUserVipStatusReq) Reset() {
-	*x = SyncUserVipStatusReq{}
+func (x *GetDeletedUsersResp) Reset() {
+	*x = GetDeletedUsersResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2525,13 +2606,13 @@ func (x *SyncUserVipStatusReq) Reset() {
 	}
 }
 
-func (x *SyncUserVipStatusReq) String() string {
+func (x *GetDeletedUsersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncUserVipStatusReq) ProtoMessage() {}
+func (*GetDeletedUsersResp) ProtoMessage() {}
 
-func (x *SyncUserVipStatusReq) ProtoReflect() protoreflect.Message {
+func (x *GetDeletedUsersResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2543,29 +2624,36 @@ func (x *SyncUserVipStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncUserVipStatusReq.ProtoReflect.Descriptor instead.
-func (*SyncUserVipStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDeletedUsersResp.ProtoReflect.Descriptor instead.
+func (*GetDeletedUsersResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SyncUserVipStatusReq) GetUserId() string {
+func (x *GetDeletedUsersResp) GetUsers() []*DeletedUser {
 	if x != nil {
-		return x.UserId
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-type SyncUserVipStatusResp struct {
+func (x *GetDeletedUsersResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 用户的注销申请
+type GetAccountDeletionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsVip     bool   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	ExpiresAt string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *SyncUserVipStatusResp) Reset() {
-	*x = SyncUserVipStatusResp{}
+func (x *GetAccountDeletionReq) Reset() {
+	*x = GetAccountDeletionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2573,13 +2661,13 @@ func (x *SyncUserVipStatusResp) Reset() {
 	}
 }
 
-func (x *SyncUserVipStatusResp) String() string {
+func (x *GetAccountDeletionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncUserVipStatusResp) ProtoMessage() {}
+func (*GetAccountDeletionReq) ProtoMessage() {}
 
-func (x *SyncUserVipStatusResp) ProtoReflect() protoreflect.Message {
+func (x *GetAccountDeletionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2591,43 +2679,30 @@ func (x *SyncUserVipStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncUserVipStatusResp.ProtoReflect.Descriptor instead.
-func (*SyncUserVipStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionReq) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SyncUserVipStatusResp) GetIsVip() bool {
-	if x != nil {
-		return x.IsVip
-	}
-	return false
-}
-
-func (x *SyncUserVipStatusResp) GetExpiresAt() string {
+func (x *GetAccountDeletionReq) GetUserId() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.UserId
 	}
 	return ""
 }
 
-// AI使用量相关消息
-type AIUsageData struct {
+type AccountDeletionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsVip           bool   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	AiChatCount     int32  `protobuf:"varint,2,opt,name=ai_chat_count,json=aiChatCount,proto3" json:"ai_chat_count,omitempty"`
-	AiChatLimit     int32  `protobuf:"varint,3,opt,name=ai_chat_limit,json=aiChatLimit,proto3" json:"ai_chat_limit,omitempty"`
-	AiContentCount  int32  `protobuf:"varint,4,opt,name=ai_content_count,json=aiContentCount,proto3" json:"ai_content_count,omitempty"`
-	AiContentLimit  int32  `protobuf:"varint,5,opt,name=ai_content_limit,json=aiContentLimit,proto3" json:"ai_content_limit,omitempty"`
-	AiAnalysisCount int32  `protobuf:"varint,6,opt,name=ai_analysis_count,json=aiAnalysisCount,proto3" json:"ai_analysis_count,omitempty"`
-	AiAnalysisLimit int32  `protobuf:"varint,7,opt,name=ai_analysis_limit,json=aiAnalysisLimit,proto3" json:"ai_analysis_limit,omitempty"`
-	AiLastResetAt   string `protobuf:"bytes,8,opt,name=ai_last_reset_at,json=aiLastResetAt,proto3" json:"ai_last_reset_at,omitempty"`
+	Scheduled   bool   `protobuf:"varint,1,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                       // 是否有待处理的注销申请
+	RequestedAt string `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"` // 申请时间
+	PurgeAt     string `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`             // 冷静期结束、数据将被清除的时间
 }
 
-func (x *AIUsageData) Reset() {
-	*x = AIUsageData{}
+func (x *AccountDeletionResp) Reset() {
+	*x = AccountDeletionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_superservice_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2635,13 +2710,13 @@ func (x *AIUsageData) Reset() {
 	}
 }
 
-func (x *AIUsageData) String() string {
+func (x *AccountDeletionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AIUsageData) ProtoMessage() {}
+func (*AccountDeletionResp) ProtoMessage() {}
 
-func (x *AIUsageData) ProtoReflect() protoreflect.Message {
+func (x *AccountDeletionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_superservice_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2653,92 +2728,123 @@ func (x *AIUsageData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AIUsageData.ProtoReflect.Descriptor instead.
-func (*AIUsageData) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*AccountDeletionResp) Descriptor() ([]byte, []int) {
 	return file_superservice_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AIUsageData) GetIsVip() bool {
+func (x *AccountDeletionResp) GetScheduled() bool {
 	if x != nil {
-		return x.IsVip
+		return x.Scheduled
 	}
 	return false
 }
 
-func (x *AIUsageData) GetAiChatCount() int32 {
+func (x *AccountDeletionResp) GetRequestedAt() string {
 	if x != nil {
-		return x.AiChatCount
+		return x.RequestedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *AIUsageData) GetAiChatLimit() int32 {
+func (x *AccountDeletionResp) GetPurgeAt() string {
 	if x != nil {
-		return x.AiChatLimit
+		return x.PurgeAt
 	}
-	return 0
+	return ""
 }
 
-func (x *AIUsageData) GetAiContentCount() int32 {
-	if x != nil {
-		return x.AiContentCount
+// 用户申请注销账号，需要验证密码；冷静期内账号可正常使用并可撤销
+type RequestAccountDeletionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ClientIp string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 客户端IP，用于审计
+}
+
+func (x *RequestAccountDeletionReq) Reset() {
+	*x = RequestAccountDeletionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *AIUsageData) GetAiContentLimit() int32 {
-	if x != nil {
-		return x.AiContentLimit
+func (x *RequestAccountDeletionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionReq) ProtoMessage() {}
+
+func (x *RequestAccountDeletionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AIUsageData) GetAiAnalysisCount() int32 {
+// Deprecated: Use RequestAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RequestAccountDeletionReq) GetUserId() string {
 	if x != nil {
-		return x.AiAnalysisCount
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *AIUsageData) GetAiAnalysisLimit() int32 {
+func (x *RequestAccountDeletionReq) GetPassword() string {
 	if x != nil {
-		return x.AiAnalysisLimit
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-func (x *AIUsageData) GetAiLastResetAt() string {
+func (x *RequestAccountDeletionReq) GetClientIp() string {
 	if x != nil {
-		return x.AiLastResetAt
+		return x.ClientIp
 	}
 	return ""
 }
 
-type GetAIUsageReq struct {
+// 用户撤销注销申请
+type CancelAccountDeletionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClientIp string `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 客户端IP，用于审计
 }
 
-func (x *GetAIUsageReq) Reset() {
-	*x = GetAIUsageReq{}
+func (x *CancelAccountDeletionReq) Reset() {
+	*x = CancelAccountDeletionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[47]
+		mi := &file_superservice_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAIUsageReq) String() string {
+func (x *CancelAccountDeletionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAIUsageReq) ProtoMessage() {}
+func (*CancelAccountDeletionReq) ProtoMessage() {}
 
-func (x *GetAIUsageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[47]
+func (x *CancelAccountDeletionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,43 +2855,92 @@ func (x *GetAIUsageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAIUsageReq.ProtoReflect.Descriptor instead.
-func (*GetAIUsageReq) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use CancelAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetAIUsageReq) GetUserId() string {
+func (x *CancelAccountDeletionReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetAIUsageResp struct {
+func (x *CancelAccountDeletionReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type CancelAccountDeletionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Usage *AIUsageData `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+func (x *CancelAccountDeletionResp) Reset() {
+	*x = CancelAccountDeletionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *GetAIUsageResp) Reset() {
-	*x = GetAIUsageResp{}
+func (x *CancelAccountDeletionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionResp) ProtoMessage() {}
+
+func (x *CancelAccountDeletionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{49}
+}
+
+// 申请导出个人数据，已有等待或正在生成的导出时直接返回
+type StartDataExportReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Fresh      bool   `protobuf:"varint,2,opt,name=fresh,proto3" json:"fresh,omitempty"`                            // 已有未过期的导出文件时仍重新生成
+	ClientIp   string `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`       // 客户端IP，用于审计
+	OperatorId string `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID，管理员代用户申请时与user_id不同
+}
+
+func (x *StartDataExportReq) Reset() {
+	*x = StartDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[48]
+		mi := &file_superservice_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAIUsageResp) String() string {
+func (x *StartDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAIUsageResp) ProtoMessage() {}
+func (*StartDataExportReq) ProtoMessage() {}
 
-func (x *GetAIUsageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[48]
+func (x *StartDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2796,44 +2951,66 @@ func (x *GetAIUsageResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAIUsageResp.ProtoReflect.Descriptor instead.
-func (*GetAIUsageResp) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use StartDataExportReq.ProtoReflect.Descriptor instead.
+func (*StartDataExportReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetAIUsageResp) GetUsage() *AIUsageData {
+func (x *StartDataExportReq) GetUserId() string {
 	if x != nil {
-		return x.Usage
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type UpdateAIUsageReq struct {
+func (x *StartDataExportReq) GetFresh() bool {
+	if x != nil {
+		return x.Fresh
+	}
+	return false
+}
+
+func (x *StartDataExportReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *StartDataExportReq) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+// 查询个人数据导出的状态
+type GetDataExportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UsageType string `protobuf:"bytes,2,opt,name=usage_type,json=usageType,proto3" json:"usage_type,omitempty"` // chat, content, analysis
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExportId string `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 }
 
-func (x *UpdateAIUsageReq) Reset() {
-	*x = UpdateAIUsageReq{}
+func (x *GetDataExportReq) Reset() {
+	*x = GetDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[49]
+		mi := &file_superservice_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateAIUsageReq) String() string {
+func (x *GetDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAIUsageReq) ProtoMessage() {}
+func (*GetDataExportReq) ProtoMessage() {}
 
-func (x *UpdateAIUsageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[49]
+func (x *GetDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2844,50 +3021,57 @@ func (x *UpdateAIUsageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAIUsageReq.ProtoReflect.Descriptor instead.
-func (*UpdateAIUsageReq) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use GetDataExportReq.ProtoReflect.Descriptor instead.
+func (*GetDataExportReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UpdateAIUsageReq) GetUserId() string {
+func (x *GetDataExportReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateAIUsageReq) GetUsageType() string {
+func (x *GetDataExportReq) GetExportId() string {
 	if x != nil {
-		return x.UsageType
+		return x.ExportId
 	}
 	return ""
 }
 
-type UpdateAIUsageResp struct {
+type DataExportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Usage *AIUsageData `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status            string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 状态：pending 等待生成, running 生成中, ready 可下载, failed 生成失败, expired 已过期
+	Size              int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`    // 导出文件大小（字节）
+	CreatedAt         string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt       string `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`                     // 生成完成时间
+	ExpiresAt         string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                           // 导出文件过期时间
+	DownloadUrl       string `protobuf:"bytes,7,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`                     // 带签名的下载链接，仅在ready时返回
+	DownloadExpiresAt string `protobuf:"bytes,8,opt,name=download_expires_at,json=downloadExpiresAt,proto3" json:"download_expires_at,omitempty"` // 下载链接过期时间，过期后重新查询获取新链接
 }
 
-func (x *UpdateAIUsageResp) Reset() {
-	*x = UpdateAIUsageResp{}
+func (x *DataExportResp) Reset() {
+	*x = DataExportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_superservice_proto_msgTypes[50]
+		mi := &file_superservice_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateAIUsageResp) String() string {
+func (x *DataExportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAIUsageResp) ProtoMessage() {}
+func (*DataExportResp) ProtoMessage() {}
 
-func (x *UpdateAIUsageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_superservice_proto_msgTypes[50]
+func (x *DataExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2898,551 +3082,7283 @@ func (x *UpdateAIUsageResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAIUsageResp.ProtoReflect.Descriptor instead.
-func (*UpdateAIUsageResp) Descriptor() ([]byte, []int) {
-	return file_superservice_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use DataExportResp.ProtoReflect.Descriptor instead.
+func (*DataExportResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UpdateAIUsageResp) GetUsage() *AIUsageData {
+func (x *DataExportResp) GetId() string {
 	if x != nil {
-		return x.Usage
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-var File_superservice_proto protoreflect.FileDescriptor
+func (x *DataExportResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
 
-var file_superservice_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x76, 0x69,
-	0x70, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x22,
-	0x5b, 0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x36, 0x0a, 0x0c,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x22, 0x58, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x49,
-	0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x25, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x76, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x22, 0x76, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x63, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
-	0x69, 0x70, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15,
-	0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x69, 0x70, 0x5f, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x70, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x4e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xc8, 0x01, 0x0a, 0x07, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x28, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c,
-	0x61, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70,
-	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x3e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a,
-	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75,
-	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56,
-	0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x3e, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2b, 0x0a,
-	0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x50,
-	0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x56,
-	0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
-	0x61, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x22, 0x45,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x22, 0xd3, 0x01, 0x0a, 0x09, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x22, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x22, 0x34, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
-	0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52,
-	0x65, 0x6e, 0x65, 0x77, 0x22, 0x2a, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
-	0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x29, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x22, 0x4c, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65,
-	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75,
-	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x2f, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x4d, 0x0a, 0x15, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73,
-	0x5f, 0x76, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69,
-	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
-	0x22, 0xc1, 0x02, 0x0a, 0x0b, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x63, 0x68,
-	0x61, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x61, 0x69, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61,
-	0x69, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x61, 0x69, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x28, 0x0a, 0x10, 0x61, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x69, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x69, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
-	0x69, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x61, 0x69, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2a, 0x0a, 0x11, 0x61, 0x69, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x69, 0x41, 0x6e,
-	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x61,
-	0x69, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x69, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x41, 0x74, 0x22, 0x28, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41,
-	0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x4a, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x44, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x32, 0xb8, 0x0e, 0x0a, 0x05, 0x53, 0x75, 0x70, 0x65, 0x72, 0x12, 0x41, 0x0a,
-	0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x38, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x17, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x18, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x73, 0x75,
-	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x56, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x56, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a,
-	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c,
-	0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50,
-	0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
-	0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12,
-	0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x12, 0x27, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x70,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x73, 0x75, 0x70, 0x65,
-	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d,
-	0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x12, 0x1d,
-	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
-	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a,
-	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77,
-	0x12, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65,
-	0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23,
-	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x79,
-	0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c,
-	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e,
-	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
-	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x42, 0x09,
-	0x5a, 0x07, 0x2e, 0x2f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *DataExportResp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-var (
-	file_superservice_proto_rawDescOnce sync.Once
-	file_superservice_proto_rawDescData = file_superservice_proto_rawDesc
-)
+func (x *DataExportResp) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
 
-func file_superservice_proto_rawDescGZIP() []byte {
-	file_superservice_proto_rawDescOnce.Do(func() {
-		file_superservice_proto_rawDescData = protoimpl.X.CompressGZIP(file_superservice_proto_rawDescData)
-	})
-	return file_superservice_proto_rawDescData
+func (x *DataExportResp) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
 }
 
-var file_superservice_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
-var file_superservice_proto_goTypes = []interface{}{
-	(*User)(nil),                       // 0: superservice.User
-	(*RegisterReq)(nil),                // 1: superservice.RegisterReq
-	(*RegisterResp)(nil),               // 2: superservice.RegisterResp
-	(*LoginReq)(nil),                   // 3: superservice.LoginReq
-	(*LoginResp)(nil),                  // 4: superservice.LoginResp
-	(*GetUserInfoReq)(nil),             // 5: superservice.GetUserInfoReq
-	(*GetUserInfoResp)(nil),            // 6: superservice.GetUserInfoResp
-	(*GetUserReq)(nil),                 // 7: superservice.GetUserReq
-	(*GetUserResp)(nil),                // 8: superservice.GetUserResp
-	(*UpdateUserInfoReq)(nil),          // 9: superservice.UpdateUserInfoReq
-	(*UpdateUserInfoResp)(nil),         // 10: superservice.UpdateUserInfoResp
-	(*UpdateUserPasswordReq)(nil),      // 11: superservice.UpdateUserPasswordReq
-	(*UpdateUserPasswordResp)(nil),     // 12: superservice.UpdateUserPasswordResp
-	(*DeleteUserReq)(nil),              // 13: superservice.DeleteUserReq
-	(*DeleteUserResp)(nil),             // 14: superservice.DeleteUserResp
-	(*UpdateUserVipReq)(nil),           // 15: superservice.UpdateUserVipReq
-	(*UpdateUserVipResp)(nil),          // 16: superservice.UpdateUserVipResp
-	(*GetUsersReq)(nil),                // 17: superservice.GetUsersReq
-	(*GetUsersResp)(nil),               // 18: superservice.GetUsersResp
-	(*GetUserCountReq)(nil),            // 19: superservice.GetUserCountReq
-	(*GetUserCountResp)(nil),           // 20: superservice.GetUserCountResp
-	(*VipPlan)(nil),                    // 21: superservice.VipPlan
-	(*GetVipPlanReq)(nil),              // 22: superservice.GetVipPlanReq
-	(*GetVipPlanResp)(nil),             // 23: superservice.GetVipPlanResp
-	(*CreateVipPlanReq)(nil),           // 24: superservice.CreateVipPlanReq
-	(*CreateVipPlanResp)(nil),          // 25: superservice.CreateVipPlanResp
-	(*GetVipPlansReq)(nil),             // 26: superservice.GetVipPlansReq
-	(*GetVipPlansResp)(nil),            // 27: superservice.GetVipPlansResp
-	(*VipOrder)(nil),                   // 28: superservice.VipOrder
-	(*CreateVipOrderReq)(nil),          // 29: superservice.CreateVipOrderReq
-	(*CreateVipOrderResp)(nil),         // 30: superservice.CreateVipOrderResp
-	(*GetVipOrdersReq)(nil),            // 31: superservice.GetVipOrdersReq
-	(*GetVipOrdersResp)(nil),           // 32: superservice.GetVipOrdersResp
-	(*VipRecord)(nil),                  // 33: superservice.VipRecord
-	(*GetVipRecordsReq)(nil),           // 34: superservice.GetVipRecordsReq
-	(*GetVipRecordsResp)(nil),          // 35: superservice.GetVipRecordsResp
-	(*GetUserActiveVipRecordReq)(nil),  // 36: superservice.GetUserActiveVipRecordReq
-	(*GetUserActiveVipRecordResp)(nil), // 37: superservice.GetUserActiveVipRecordResp
-	(*GetUserVipStatusReq)(nil),        // 38: superservice.GetUserVipStatusReq
-	(*GetUserVipStatusResp)(nil),       // 39: superservice.GetUserVipStatusResp
-	(*CheckUserVipReq)(nil),            // 40: superservice.CheckUserVipReq
-	(*CheckUserVipResp)(nil),           // 41: superservice.CheckUserVipResp
-	(*UpdateAutoRenewReq)(nil),         // 42: superservice.UpdateAutoRenewReq
-	(*UpdateAutoRenewResp)(nil),        // 43: superservice.UpdateAutoRenewResp
-	(*SyncUserVipStatusReq)(nil),       // 44: superservice.SyncUserVipStatusReq
-	(*SyncUserVipStatusResp)(nil),      // 45: superservice.SyncUserVipStatusResp
-	(*AIUsageData)(nil),                // 46: superservice.AIUsageData
-	(*GetAIUsageReq)(nil),              // 47: superservice.GetAIUsageReq
-	(*GetAIUsageResp)(nil),             // 48: superservice.GetAIUsageResp
-	(*UpdateAIUsageReq)(nil),           // 49: superservice.UpdateAIUsageReq
-	(*UpdateAIUsageResp)(nil),          // 50: superservice.UpdateAIUsageResp
+func (x *DataExportResp) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
-var file_superservice_proto_depIdxs = []int32{
-	0,  // 0: superservice.RegisterResp.user:type_name -> superservice.User
-	0,  // 1: superservice.LoginResp.user:type_name -> superservice.User
-	0,  // 2: superservice.GetUserInfoResp.user:type_name -> superservice.User
-	0,  // 3: superservice.GetUserResp.user:type_name -> superservice.User
-	0,  // 4: superservice.UpdateUserInfoResp.user:type_name -> superservice.User
-	0,  // 5: superservice.UpdateUserVipResp.user:type_name -> superservice.User
-	0,  // 6: superservice.GetUsersResp.users:type_name -> superservice.User
-	21, // 7: superservice.GetVipPlanResp.plan:type_name -> superservice.VipPlan
-	21, // 8: superservice.CreateVipPlanResp.plan:type_name -> superservice.VipPlan
-	21, // 9: superservice.GetVipPlansResp.plans:type_name -> superservice.VipPlan
-	28, // 10: superservice.CreateVipOrderResp.order:type_name -> superservice.VipOrder
-	28, // 11: superservice.GetVipOrdersResp.orders:type_name -> superservice.VipOrder
-	33, // 12: superservice.GetVipRecordsResp.records:type_name -> superservice.VipRecord
-	33, // 13: superservice.GetUserActiveVipRecordResp.record:type_name -> superservice.VipRecord
-	46, // 14: superservice.GetAIUsageResp.usage:type_name -> superservice.AIUsageData
-	46, // 15: superservice.UpdateAIUsageResp.usage:type_name -> superservice.AIUsageData
-	1,  // 16: superservice.Super.Register:input_type -> superservice.RegisterReq
-	3,  // 17: superservice.Super.Login:input_type -> superservice.LoginReq
-	5,  // 18: superservice.Super.GetUserInfo:input_type -> superservice.GetUserInfoReq
-	7,  // 19: superservice.Super.GetUser:input_type -> superservice.GetUserReq
-	9,  // 20: superservice.Super.UpdateUserInfo:input_type -> superservice.UpdateUserInfoReq
-	11, // 21: superservice.Super.UpdateUserPassword:input_type -> superservice.UpdateUserPasswordReq
-	13, // 22: superservice.Super.DeleteUser:input_type -> superservice.DeleteUserReq
-	15, // 23: superservice.Super.UpdateUserVip:input_type -> superservice.UpdateUserVipReq
-	17, // 24: superservice.Super.GetUsers:input_type -> superservice.GetUsersReq
-	19, // 25: superservice.Super.GetUserCount:input_type -> superservice.GetUserCountReq
-	26, // 26: superservice.Super.GetVipPlans:input_type -> superservice.GetVipPlansReq
-	22, // 27: superservice.Super.GetVipPlan:input_type -> superservice.GetVipPlanReq
-	24, // 28: superservice.Super.CreateVipPlan:input_type -> superservice.CreateVipPlanReq
-	29, // 29: superservice.Super.CreateVipOrder:input_type -> superservice.CreateVipOrderReq
-	31, // 30: superservice.Super.GetVipOrders:input_type -> superservice.GetVipOrdersReq
-	34, // 31: superservice.Super.GetVipRecords:input_type -> superservice.GetVipRecordsReq
-	36, // 32: superservice.Super.GetUserActiveVipRecord:input_type -> superservice.GetUserActiveVipRecordReq
-	38, // 33: superservice.Super.GetUserVipStatus:input_type -> superservice.GetUserVipStatusReq
-	40, // 34: superservice.Super.CheckUserVip:input_type -> superservice.CheckUserVipReq
-	42, // 35: superservice.Super.UpdateAutoRenew:input_type -> superservice.UpdateAutoRenewReq
-	44, // 36: superservice.Super.SyncUserVipStatus:input_type -> superservice.SyncUserVipStatusReq
-	47, // 37: superservice.Super.GetAIUsage:input_type -> superservice.GetAIUsageReq
-	49, // 38: superservice.Super.UpdateAIUsage:input_type -> superservice.UpdateAIUsageReq
-	2,  // 39: superservice.Super.Register:output_type -> superservice.RegisterResp
-	4,  // 40: superservice.Super.Login:output_type -> superservice.LoginResp
-	6,  // 41: superservice.Super.GetUserInfo:output_type -> superservice.GetUserInfoResp
-	8,  // 42: superservice.Super.GetUser:output_type -> superservice.GetUserResp
-	10, // 43: superservice.Super.UpdateUserInfo:output_type -> superservice.UpdateUserInfoResp
-	12, // 44: superservice.Super.UpdateUserPassword:output_type -> superservice.UpdateUserPasswordResp
-	14, // 45: superservice.Super.DeleteUser:output_type -> superservice.DeleteUserResp
-	16, // 46: superservice.Super.UpdateUserVip:output_type -> superservice.UpdateUserVipResp
-	18, // 47: superservice.Super.GetUsers:output_type -> superservice.GetUsersResp
-	20, // 48: superservice.Super.GetUserCount:output_type -> superservice.GetUserCountResp
-	27, // 49: superservice.Super.GetVipPlans:output_type -> superservice.GetVipPlansResp
-	23, // 50: superservice.Super.GetVipPlan:output_type -> superservice.GetVipPlanResp
-	25, // 51: superservice.Super.CreateVipPlan:output_type -> superservice.CreateVipPlanResp
-	30, // 52: superservice.Super.CreateVipOrder:output_type -> superservice.CreateVipOrderResp
-	32, // 53: superservice.Super.GetVipOrders:output_type -> superservice.GetVipOrdersResp
-	35, // 54: superservice.Super.GetVipRecords:output_type -> superservice.GetVipRecordsResp
-	37, // 55: superservice.Super.GetUserActiveVipRecord:output_type -> superservice.GetUserActiveVipRecordResp
-	39, // 56: superservice.Super.GetUserVipStatus:output_type -> superservice.GetUserVipStatusResp
-	41, // 57: superservice.Super.CheckUserVip:output_type -> superservice.CheckUserVipResp
-	43, // 58: superservice.Super.UpdateAutoRenew:output_type -> superservice.UpdateAutoRenewResp
-	45, // 59: superservice.Super.SyncUserVipStatus:output_type -> superservice.SyncUserVipStatusResp
-	48, // 60: superservice.Super.GetAIUsage:output_type -> superservice.GetAIUsageResp
-	50, // 61: superservice.Super.UpdateAIUsage:output_type -> superservice.UpdateAIUsageResp
-	39, // [39:62] is the sub-list for method output_type
-	16, // [16:39] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+func (x *DataExportResp) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
 }
 
-func init() { file_superservice_proto_init() }
-func file_superservice_proto_init() {
-	if File_superservice_proto != nil {
-		return
+func (x *DataExportResp) GetDownloadExpiresAt() string {
+	if x != nil {
+		return x.DownloadExpiresAt
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_superservice_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+	return ""
+}
+
+// 通过带签名的下载链接下载导出文件
+type DownloadDataExportReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportId  string `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	Expires   int64  `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"`
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	ClientIp  string `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 客户端IP，用于审计
+}
+
+func (x *DownloadDataExportReq) Reset() {
+	*x = DownloadDataExportReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDataExportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDataExportReq) ProtoMessage() {}
+
+func (x *DownloadDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDataExportReq.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DownloadDataExportReq) GetExportId() string {
+	if x != nil {
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *DownloadDataExportReq) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *DownloadDataExportReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DownloadDataExportReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+// 导出文件分片，第一个分片携带文件名和大小
+type DataExportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Size     int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DataExportChunk) Reset() {
+	*x = DataExportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExportChunk) ProtoMessage() {}
+
+func (x *DataExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExportChunk.ProtoReflect.Descriptor instead.
+func (*DataExportChunk) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DataExportChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DataExportChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DataExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 检查访问Token是否已吊销
+type CheckTokenRevokedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokenId string `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (x *CheckTokenRevokedReq) Reset() {
+	*x = CheckTokenRevokedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckTokenRevokedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTokenRevokedReq) ProtoMessage() {}
+
+func (x *CheckTokenRevokedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTokenRevokedReq.ProtoReflect.Descriptor instead.
+func (*CheckTokenRevokedReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CheckTokenRevokedReq) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+type CheckTokenRevokedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revoked bool `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (x *CheckTokenRevokedResp) Reset() {
+	*x = CheckTokenRevokedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckTokenRevokedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTokenRevokedResp) ProtoMessage() {}
+
+func (x *CheckTokenRevokedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTokenRevokedResp.ProtoReflect.Descriptor instead.
+func (*CheckTokenRevokedResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CheckTokenRevokedResp) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+type GetUserInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserInfoReq) Reset() {
+	*x = GetUserInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoReq) ProtoMessage() {}
+
+func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
+func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetUserInfoReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserInfoResp) Reset() {
+	*x = GetUserInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserInfoResp) ProtoMessage() {}
+
+func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserInfoResp.ProtoReflect.Descriptor instead.
+func (*GetUserInfoResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetUserInfoResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 获取单个用户请求
+type GetUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserReq) Reset() {
+	*x = GetUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReq) ProtoMessage() {}
+
+func (x *GetUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReq.ProtoReflect.Descriptor instead.
+func (*GetUserReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 获取单个用户响应
+type GetUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *GetUserResp) Reset() {
+	*x = GetUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResp) ProtoMessage() {}
+
+func (x *GetUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResp.ProtoReflect.Descriptor instead.
+func (*GetUserResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetUserResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 更新用户信息请求
+type UpdateUserInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Avatar   string `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA时区，如Asia/Shanghai，为空时不修改
+}
+
+func (x *UpdateUserInfoReq) Reset() {
+	*x = UpdateUserInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserInfoReq) ProtoMessage() {}
+
+func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateUserInfoReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 更新用户信息响应
+type UpdateUserInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UpdateUserInfoResp) Reset() {
+	*x = UpdateUserInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserInfoResp) ProtoMessage() {}
+
+func (x *UpdateUserInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserInfoResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserInfoResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateUserInfoResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 更新用户密码请求
+type UpdateUserPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPassword string `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *UpdateUserPasswordReq) Reset() {
+	*x = UpdateUserPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPasswordReq) ProtoMessage() {}
+
+func (x *UpdateUserPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPasswordReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserPasswordReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateUserPasswordReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserPasswordReq) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *UpdateUserPasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// 更新用户密码响应
+type UpdateUserPasswordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateUserPasswordResp) Reset() {
+	*x = UpdateUserPasswordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserPasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPasswordResp) ProtoMessage() {}
+
+func (x *UpdateUserPasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPasswordResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserPasswordResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{64}
+}
+
+// 验证邮箱请求，token来自验证邮件中的链接
+type VerifyEmailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *VerifyEmailReq) Reset() {
+	*x = VerifyEmailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyEmailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailReq) ProtoMessage() {}
+
+func (x *VerifyEmailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailReq.ProtoReflect.Descriptor instead.
+func (*VerifyEmailReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *VerifyEmailReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email  string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *VerifyEmailResp) Reset() {
+	*x = VerifyEmailResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyEmailResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResp) ProtoMessage() {}
+
+func (x *VerifyEmailResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResp.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *VerifyEmailResp) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyEmailResp) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// 重新发送验证邮件请求
+type SendVerificationEmailReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *SendVerificationEmailReq) Reset() {
+	*x = SendVerificationEmailReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendVerificationEmailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailReq) ProtoMessage() {}
+
+func (x *SendVerificationEmailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailReq.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SendVerificationEmailReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SendVerificationEmailResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendVerificationEmailResp) Reset() {
+	*x = SendVerificationEmailResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendVerificationEmailResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailResp) ProtoMessage() {}
+
+func (x *SendVerificationEmailResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailResp.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{68}
+}
+
+// 忘记密码请求，邮箱对应的用户存在时发送重置密码邮件
+type ForgotPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ForgotPasswordReq) Reset() {
+	*x = ForgotPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgotPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordReq) ProtoMessage() {}
+
+func (x *ForgotPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordReq.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ForgotPasswordReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ForgotPasswordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForgotPasswordResp) Reset() {
+	*x = ForgotPasswordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForgotPasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordResp) ProtoMessage() {}
+
+func (x *ForgotPasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordResp.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{70}
+}
+
+// 重置密码请求，token来自重置密码邮件中的链接
+type ResetPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordReq) ProtoMessage() {}
+
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ResetPasswordReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetPasswordResp) Reset() {
+	*x = ResetPasswordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResp) ProtoMessage() {}
+
+func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{72}
+}
+
+// 删除用户请求：停用用户，保留期内可恢复，到期后彻底清除
+type DeleteUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人用户ID
+}
+
+func (x *DeleteUserReq) Reset() {
+	*x = DeleteUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserReq) ProtoMessage() {}
+
+func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserReq) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+// 删除用户响应
+type DeleteUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PurgeAt string `protobuf:"bytes,1,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // 计划清除时间，用户只存在于认证服务时为空
+}
+
+func (x *DeleteUserResp) Reset() {
+	*x = DeleteUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResp) ProtoMessage() {}
+
+func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteUserResp) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+// 更新用户VIP状态请求
+type UpdateUserVipReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsVip  bool   `protobuf:"varint,2,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	PlanId string `protobuf:"bytes,4,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"` // 开通VIP时使用的套餐ID
+}
+
+func (x *UpdateUserVipReq) Reset() {
+	*x = UpdateUserVipReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserVipReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserVipReq) ProtoMessage() {}
+
+func (x *UpdateUserVipReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserVipReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserVipReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateUserVipReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserVipReq) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *UpdateUserVipReq) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// 更新用户VIP状态响应
+type UpdateUserVipResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *UpdateUserVipResp) Reset() {
+	*x = UpdateUserVipResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserVipResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserVipResp) ProtoMessage() {}
+
+func (x *UpdateUserVipResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserVipResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserVipResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateUserVipResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetUsersReq) Reset() {
+	*x = GetUsersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersReq) ProtoMessage() {}
+
+func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersReq.ProtoReflect.Descriptor instead.
+func (*GetUsersReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetUsersReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUsersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetUsersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetUsersResp) Reset() {
+	*x = GetUsersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersResp) ProtoMessage() {}
+
+func (x *GetUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersResp.ProtoReflect.Descriptor instead.
+func (*GetUsersResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetUsersResp) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetUsersResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetUserCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUserCountReq) Reset() {
+	*x = GetUserCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCountReq) ProtoMessage() {}
+
+func (x *GetUserCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCountReq.ProtoReflect.Descriptor instead.
+func (*GetUserCountReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{79}
+}
+
+type GetUserCountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetUserCountResp) Reset() {
+	*x = GetUserCountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCountResp) ProtoMessage() {}
+
+func (x *GetUserCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCountResp.ProtoReflect.Descriptor instead.
+func (*GetUserCountResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetUserCountResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// VIP套餐相关消息
+// 套餐AI权益
+type PlanFeatures struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quotas      map[string]int32 `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // 每个周期各AI功能的使用次数，key为chat, content, analysis
+	ModelTiers  []string         `protobuf:"bytes,2,rep,name=model_tiers,json=modelTiers,proto3" json:"model_tiers,omitempty"`                                                                // 可使用的模型等级：basic, advanced, premium
+	MaxTokens   int32            `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`                                                                  // 单次请求的最大输出token数
+	TokenBudget int32            `protobuf:"varint,4,opt,name=token_budget,json=tokenBudget,proto3" json:"token_budget,omitempty"`                                                            // 每个周期的token预算，0表示不限制
+	ResetPolicy string           `protobuf:"bytes,5,opt,name=reset_policy,json=resetPolicy,proto3" json:"reset_policy,omitempty"`                                                             // 额度重置策略：calendar_month, billing_anchor, fixed_30d，为空时按自然月重置
+}
+
+func (x *PlanFeatures) Reset() {
+	*x = PlanFeatures{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanFeatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanFeatures) ProtoMessage() {}
+
+func (x *PlanFeatures) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanFeatures.ProtoReflect.Descriptor instead.
+func (*PlanFeatures) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PlanFeatures) GetQuotas() map[string]int32 {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *PlanFeatures) GetModelTiers() []string {
+	if x != nil {
+		return x.ModelTiers
+	}
+	return nil
+}
+
+func (x *PlanFeatures) GetMaxTokens() int32 {
+	if x != nil {
+		return x.MaxTokens
+	}
+	return 0
+}
+
+func (x *PlanFeatures) GetTokenBudget() int32 {
+	if x != nil {
+		return x.TokenBudget
+	}
+	return 0
+}
+
+func (x *PlanFeatures) GetResetPolicy() string {
+	if x != nil {
+		return x.ResetPolicy
+	}
+	return ""
+}
+
+type VipPlan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price        float32       `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	DurationDays int32         `protobuf:"varint,5,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	CreatedAt    string        `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    string        `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Features     *PlanFeatures `protobuf:"bytes,8,opt,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *VipPlan) Reset() {
+	*x = VipPlan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VipPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VipPlan) ProtoMessage() {}
+
+func (x *VipPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VipPlan.ProtoReflect.Descriptor instead.
+func (*VipPlan) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *VipPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VipPlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VipPlan) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VipPlan) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VipPlan) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *VipPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *VipPlan) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *VipPlan) GetFeatures() *PlanFeatures {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// 获取单个VIP套餐请求
+type GetVipPlanReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (x *GetVipPlanReq) Reset() {
+	*x = GetVipPlanReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipPlanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipPlanReq) ProtoMessage() {}
+
+func (x *GetVipPlanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipPlanReq.ProtoReflect.Descriptor instead.
+func (*GetVipPlanReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetVipPlanReq) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+// 获取单个VIP套餐响应
+type GetVipPlanResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan *VipPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (x *GetVipPlanResp) Reset() {
+	*x = GetVipPlanResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipPlanResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipPlanResp) ProtoMessage() {}
+
+func (x *GetVipPlanResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipPlanResp.ProtoReflect.Descriptor instead.
+func (*GetVipPlanResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetVipPlanResp) GetPlan() *VipPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// 创建VIP套餐请求
+type CreateVipPlanReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price        float32       `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	DurationDays int32         `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	Features     *PlanFeatures `protobuf:"bytes,5,opt,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *CreateVipPlanReq) Reset() {
+	*x = CreateVipPlanReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVipPlanReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVipPlanReq) ProtoMessage() {}
+
+func (x *CreateVipPlanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVipPlanReq.ProtoReflect.Descriptor instead.
+func (*CreateVipPlanReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateVipPlanReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVipPlanReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateVipPlanReq) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateVipPlanReq) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *CreateVipPlanReq) GetFeatures() *PlanFeatures {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// 创建VIP套餐响应
+type CreateVipPlanResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plan *VipPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+}
+
+func (x *CreateVipPlanResp) Reset() {
+	*x = CreateVipPlanResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVipPlanResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVipPlanResp) ProtoMessage() {}
+
+func (x *CreateVipPlanResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVipPlanResp.ProtoReflect.Descriptor instead.
+func (*CreateVipPlanResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateVipPlanResp) GetPlan() *VipPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type GetVipPlansReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVipPlansReq) Reset() {
+	*x = GetVipPlansReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipPlansReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipPlansReq) ProtoMessage() {}
+
+func (x *GetVipPlansReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipPlansReq.ProtoReflect.Descriptor instead.
+func (*GetVipPlansReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{87}
+}
+
+type GetVipPlansResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plans []*VipPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+}
+
+func (x *GetVipPlansResp) Reset() {
+	*x = GetVipPlansResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipPlansResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipPlansResp) ProtoMessage() {}
+
+func (x *GetVipPlansResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipPlansResp.ProtoReflect.Descriptor instead.
+func (*GetVipPlansResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetVipPlansResp) GetPlans() []*VipPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// AI额度加油包相关消息
+type CreditPack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float32 `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	Credits     int32   `protobuf:"varint,5,opt,name=credits,proto3" json:"credits,omitempty"`                      // 包含的AI调用次数
+	ValidDays   int32   `protobuf:"varint,6,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"` // 购买后的有效期（天数）
+	CreatedAt   string  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string  `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *CreditPack) Reset() {
+	*x = CreditPack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditPack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditPack) ProtoMessage() {}
+
+func (x *CreditPack) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditPack.ProtoReflect.Descriptor instead.
+func (*CreditPack) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CreditPack) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreditPack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreditPack) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreditPack) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreditPack) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *CreditPack) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+func (x *CreditPack) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CreditPack) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetCreditPacksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCreditPacksReq) Reset() {
+	*x = GetCreditPacksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreditPacksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditPacksReq) ProtoMessage() {}
+
+func (x *GetCreditPacksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditPacksReq.ProtoReflect.Descriptor instead.
+func (*GetCreditPacksReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{90}
+}
+
+type GetCreditPacksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packs []*CreditPack `protobuf:"bytes,1,rep,name=packs,proto3" json:"packs,omitempty"`
+}
+
+func (x *GetCreditPacksResp) Reset() {
+	*x = GetCreditPacksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreditPacksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditPacksResp) ProtoMessage() {}
+
+func (x *GetCreditPacksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditPacksResp.ProtoReflect.Descriptor instead.
+func (*GetCreditPacksResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetCreditPacksResp) GetPacks() []*CreditPack {
+	if x != nil {
+		return x.Packs
+	}
+	return nil
+}
+
+type CreateCreditPackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Credits     int32   `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	ValidDays   int32   `protobuf:"varint,5,opt,name=valid_days,json=validDays,proto3" json:"valid_days,omitempty"`
+}
+
+func (x *CreateCreditPackReq) Reset() {
+	*x = CreateCreditPackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCreditPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditPackReq) ProtoMessage() {}
+
+func (x *CreateCreditPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditPackReq.ProtoReflect.Descriptor instead.
+func (*CreateCreditPackReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateCreditPackReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCreditPackReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCreditPackReq) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateCreditPackReq) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *CreateCreditPackReq) GetValidDays() int32 {
+	if x != nil {
+		return x.ValidDays
+	}
+	return 0
+}
+
+type CreateCreditPackResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pack *CreditPack `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+}
+
+func (x *CreateCreditPackResp) Reset() {
+	*x = CreateCreditPackResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCreditPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCreditPackResp) ProtoMessage() {}
+
+func (x *CreateCreditPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCreditPackResp.ProtoReflect.Descriptor instead.
+func (*CreateCreditPackResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateCreditPackResp) GetPack() *CreditPack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+// VIP订单相关消息
+type VipOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       string  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanId       string  `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName     string  `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	Amount       float32 `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status       string  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt    string  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaidAt       string  `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	OrderNo      string  `protobuf:"bytes,9,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	ProductType  string  `protobuf:"bytes,10,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`      // 商品类型：vip_plan, credit_pack
+	CreditPackId string  `protobuf:"bytes,11,opt,name=credit_pack_id,json=creditPackId,proto3" json:"credit_pack_id,omitempty"` // 加油包ID，购买加油包时有值
+}
+
+func (x *VipOrder) Reset() {
+	*x = VipOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VipOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VipOrder) ProtoMessage() {}
+
+func (x *VipOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VipOrder.ProtoReflect.Descriptor instead.
+func (*VipOrder) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *VipOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VipOrder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VipOrder) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *VipOrder) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *VipOrder) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *VipOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VipOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *VipOrder) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *VipOrder) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *VipOrder) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *VipOrder) GetCreditPackId() string {
+	if x != nil {
+		return x.CreditPackId
+	}
+	return ""
+}
+
+type CreateVipOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId         string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanId         string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 可选，相同的幂等键返回首次创建的订单
+	ProductType    string `protobuf:"bytes,4,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`          // 商品类型：vip_plan（默认）, credit_pack
+	CreditPackId   string `protobuf:"bytes,5,opt,name=credit_pack_id,json=creditPackId,proto3" json:"credit_pack_id,omitempty"`     // 购买加油包时必填
+}
+
+func (x *CreateVipOrderReq) Reset() {
+	*x = CreateVipOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVipOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVipOrderReq) ProtoMessage() {}
+
+func (x *CreateVipOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVipOrderReq.ProtoReflect.Descriptor instead.
+func (*CreateVipOrderReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateVipOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateVipOrderReq) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *CreateVipOrderReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateVipOrderReq) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *CreateVipOrderReq) GetCreditPackId() string {
+	if x != nil {
+		return x.CreditPackId
+	}
+	return ""
+}
+
+type CreateVipOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *VipOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *CreateVipOrderResp) Reset() {
+	*x = CreateVipOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVipOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVipOrderResp) ProtoMessage() {}
+
+func (x *CreateVipOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVipOrderResp.ProtoReflect.Descriptor instead.
+func (*CreateVipOrderResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateVipOrderResp) GetOrder() *VipOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 发起支付请求
+type PayVipOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderNo   string `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	PayMethod string `protobuf:"bytes,3,opt,name=pay_method,json=payMethod,proto3" json:"pay_method,omitempty"` // wechat, alipay 等，由支付提供方解释
+}
+
+func (x *PayVipOrderReq) Reset() {
+	*x = PayVipOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayVipOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayVipOrderReq) ProtoMessage() {}
+
+func (x *PayVipOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayVipOrderReq.ProtoReflect.Descriptor instead.
+func (*PayVipOrderReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PayVipOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PayVipOrderReq) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *PayVipOrderReq) GetPayMethod() string {
+	if x != nil {
+		return x.PayMethod
+	}
+	return ""
+}
+
+// 发起支付响应，pay_url 和 qr_code 至少返回一个
+type PayVipOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderNo  string `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	PayUrl   string `protobuf:"bytes,3,opt,name=pay_url,json=payUrl,proto3" json:"pay_url,omitempty"`
+	QrCode   string `protobuf:"bytes,4,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+}
+
+func (x *PayVipOrderResp) Reset() {
+	*x = PayVipOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayVipOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayVipOrderResp) ProtoMessage() {}
+
+func (x *PayVipOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayVipOrderResp.ProtoReflect.Descriptor instead.
+func (*PayVipOrderResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *PayVipOrderResp) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *PayVipOrderResp) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PayVipOrderResp) GetPayUrl() string {
+	if x != nil {
+		return x.PayUrl
+	}
+	return ""
+}
+
+func (x *PayVipOrderResp) GetQrCode() string {
+	if x != nil {
+		return x.QrCode
+	}
+	return ""
+}
+
+// 取消订单请求，只能取消待支付订单
+type CancelVipOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderNo string `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+}
+
+func (x *CancelVipOrderReq) Reset() {
+	*x = CancelVipOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelVipOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVipOrderReq) ProtoMessage() {}
+
+func (x *CancelVipOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVipOrderReq.ProtoReflect.Descriptor instead.
+func (*CancelVipOrderReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CancelVipOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelVipOrderReq) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+type CancelVipOrderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *VipOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *CancelVipOrderResp) Reset() {
+	*x = CancelVipOrderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelVipOrderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVipOrderResp) ProtoMessage() {}
+
+func (x *CancelVipOrderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVipOrderResp.ProtoReflect.Descriptor instead.
+func (*CancelVipOrderResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CancelVipOrderResp) GetOrder() *VipOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 支付回调请求，透传网关收到的原始请求
+type PaymentNotifyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Body     []byte            `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Headers  map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PaymentNotifyReq) Reset() {
+	*x = PaymentNotifyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentNotifyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentNotifyReq) ProtoMessage() {}
+
+func (x *PaymentNotifyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentNotifyReq.ProtoReflect.Descriptor instead.
+func (*PaymentNotifyReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PaymentNotifyReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PaymentNotifyReq) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *PaymentNotifyReq) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// 支付回调响应
+type PaymentNotifyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ack string `protobuf:"bytes,1,opt,name=ack,proto3" json:"ack,omitempty"` // 返回给支付平台的应答内容
+}
+
+func (x *PaymentNotifyResp) Reset() {
+	*x = PaymentNotifyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentNotifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentNotifyResp) ProtoMessage() {}
+
+func (x *PaymentNotifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentNotifyResp.ProtoReflect.Descriptor instead.
+func (*PaymentNotifyResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PaymentNotifyResp) GetAck() string {
+	if x != nil {
+		return x.Ack
+	}
+	return ""
+}
+
+type GetVipOrdersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetVipOrdersReq) Reset() {
+	*x = GetVipOrdersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipOrdersReq) ProtoMessage() {}
+
+func (x *GetVipOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipOrdersReq.ProtoReflect.Descriptor instead.
+func (*GetVipOrdersReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetVipOrdersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetVipOrdersReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetVipOrdersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetVipOrdersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*VipOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total  int32       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetVipOrdersResp) Reset() {
+	*x = GetVipOrdersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipOrdersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipOrdersResp) ProtoMessage() {}
+
+func (x *GetVipOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipOrdersResp.ProtoReflect.Descriptor instead.
+func (*GetVipOrdersResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetVipOrdersResp) GetOrders() []*VipOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetVipOrdersResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// VIP记录相关消息
+type VipRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanId    string `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName  string `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	StartAt   string `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt     string `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Status    string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *VipRecord) Reset() {
+	*x = VipRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VipRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VipRecord) ProtoMessage() {}
+
+func (x *VipRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VipRecord.ProtoReflect.Descriptor instead.
+func (*VipRecord) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *VipRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VipRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VipRecord) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *VipRecord) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *VipRecord) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *VipRecord) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *VipRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VipRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetVipRecordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetVipRecordsReq) Reset() {
+	*x = GetVipRecordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipRecordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipRecordsReq) ProtoMessage() {}
+
+func (x *GetVipRecordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipRecordsReq.ProtoReflect.Descriptor instead.
+func (*GetVipRecordsReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetVipRecordsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetVipRecordsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetVipRecordsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetVipRecordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Records []*VipRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total   int32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetVipRecordsResp) Reset() {
+	*x = GetVipRecordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVipRecordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipRecordsResp) ProtoMessage() {}
+
+func (x *GetVipRecordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipRecordsResp.ProtoReflect.Descriptor instead.
+func (*GetVipRecordsResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetVipRecordsResp) GetRecords() []*VipRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetVipRecordsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取用户活跃VIP记录请求
+type GetUserActiveVipRecordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserActiveVipRecordReq) Reset() {
+	*x = GetUserActiveVipRecordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserActiveVipRecordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActiveVipRecordReq) ProtoMessage() {}
+
+func (x *GetUserActiveVipRecordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserActiveVipRecordReq.ProtoReflect.Descriptor instead.
+func (*GetUserActiveVipRecordReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetUserActiveVipRecordReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 获取用户活跃VIP记录响应
+type GetUserActiveVipRecordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *VipRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+}
+
+func (x *GetUserActiveVipRecordResp) Reset() {
+	*x = GetUserActiveVipRecordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserActiveVipRecordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserActiveVipRecordResp) ProtoMessage() {}
+
+func (x *GetUserActiveVipRecordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserActiveVipRecordResp.ProtoReflect.Descriptor instead.
+func (*GetUserActiveVipRecordResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetUserActiveVipRecordResp) GetRecord() *VipRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// VIP状态相关消息
+type GetUserVipStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserVipStatusReq) Reset() {
+	*x = GetUserVipStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserVipStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserVipStatusReq) ProtoMessage() {}
+
+func (x *GetUserVipStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserVipStatusReq.ProtoReflect.Descriptor instead.
+func (*GetUserVipStatusReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetUserVipStatusReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserVipStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsVip     bool   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	ExpiresAt string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AutoRenew bool   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+}
+
+func (x *GetUserVipStatusResp) Reset() {
+	*x = GetUserVipStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserVipStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserVipStatusResp) ProtoMessage() {}
+
+func (x *GetUserVipStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserVipStatusResp.ProtoReflect.Descriptor instead.
+func (*GetUserVipStatusResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetUserVipStatusResp) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *GetUserVipStatusResp) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *GetUserVipStatusResp) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+type CheckUserVipReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *CheckUserVipReq) Reset() {
+	*x = CheckUserVipReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserVipReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserVipReq) ProtoMessage() {}
+
+func (x *CheckUserVipReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserVipReq.ProtoReflect.Descriptor instead.
+func (*CheckUserVipReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CheckUserVipReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CheckUserVipResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsVip bool `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+}
+
+func (x *CheckUserVipResp) Reset() {
+	*x = CheckUserVipResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserVipResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserVipResp) ProtoMessage() {}
+
+func (x *CheckUserVipResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserVipResp.ProtoReflect.Descriptor instead.
+func (*CheckUserVipResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CheckUserVipResp) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+type UpdateAutoRenewReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AutoRenew bool   `protobuf:"varint,2,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+}
+
+func (x *UpdateAutoRenewReq) Reset() {
+	*x = UpdateAutoRenewReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAutoRenewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAutoRenewReq) ProtoMessage() {}
+
+func (x *UpdateAutoRenewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAutoRenewReq.ProtoReflect.Descriptor instead.
+func (*UpdateAutoRenewReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateAutoRenewReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateAutoRenewReq) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+type UpdateAutoRenewResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateAutoRenewResp) Reset() {
+	*x = UpdateAutoRenewResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAutoRenewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAutoRenewResp) ProtoMessage() {}
+
+func (x *UpdateAutoRenewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAutoRenewResp.ProtoReflect.Descriptor instead.
+func (*UpdateAutoRenewResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{115}
+}
+
+type SyncUserVipStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *SyncUserVipStatusReq) Reset() {
+	*x = SyncUserVipStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncUserVipStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUserVipStatusReq) ProtoMessage() {}
+
+func (x *SyncUserVipStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUserVipStatusReq.ProtoReflect.Descriptor instead.
+func (*SyncUserVipStatusReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SyncUserVipStatusReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SyncUserVipStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsVip     bool   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	ExpiresAt string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *SyncUserVipStatusResp) Reset() {
+	*x = SyncUserVipStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncUserVipStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUserVipStatusResp) ProtoMessage() {}
+
+func (x *SyncUserVipStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUserVipStatusResp.ProtoReflect.Descriptor instead.
+func (*SyncUserVipStatusResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SyncUserVipStatusResp) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *SyncUserVipStatusResp) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// AI使用量相关消息
+type AIUsageData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsVip           bool   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	AiChatCount     int32  `protobuf:"varint,2,opt,name=ai_chat_count,json=aiChatCount,proto3" json:"ai_chat_count,omitempty"`
+	AiChatLimit     int32  `protobuf:"varint,3,opt,name=ai_chat_limit,json=aiChatLimit,proto3" json:"ai_chat_limit,omitempty"`
+	AiContentCount  int32  `protobuf:"varint,4,opt,name=ai_content_count,json=aiContentCount,proto3" json:"ai_content_count,omitempty"`
+	AiContentLimit  int32  `protobuf:"varint,5,opt,name=ai_content_limit,json=aiContentLimit,proto3" json:"ai_content_limit,omitempty"`
+	AiAnalysisCount int32  `protobuf:"varint,6,opt,name=ai_analysis_count,json=aiAnalysisCount,proto3" json:"ai_analysis_count,omitempty"`
+	AiAnalysisLimit int32  `protobuf:"varint,7,opt,name=ai_analysis_limit,json=aiAnalysisLimit,proto3" json:"ai_analysis_limit,omitempty"`
+	AiLastResetAt   string `protobuf:"bytes,8,opt,name=ai_last_reset_at,json=aiLastResetAt,proto3" json:"ai_last_reset_at,omitempty"`
+	TokenUsed       int64  `protobuf:"varint,9,opt,name=token_used,json=tokenUsed,proto3" json:"token_used,omitempty"`              // 本周期已使用的token数
+	TokenBudget     int64  `protobuf:"varint,10,opt,name=token_budget,json=tokenBudget,proto3" json:"token_budget,omitempty"`       // 每个周期的token预算，0表示不限制
+	NextResetAt     string `protobuf:"bytes,11,opt,name=next_reset_at,json=nextResetAt,proto3" json:"next_reset_at,omitempty"`      // 下次重置时间（RFC3339，使用用户时区）
+	ResetPolicy     string `protobuf:"bytes,12,opt,name=reset_policy,json=resetPolicy,proto3" json:"reset_policy,omitempty"`        // 额度重置策略
+	CreditBalance   int64  `protobuf:"varint,13,opt,name=credit_balance,json=creditBalance,proto3" json:"credit_balance,omitempty"` // 可用的加油包额度，每月额度用完后抵扣
+}
+
+func (x *AIUsageData) Reset() {
+	*x = AIUsageData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIUsageData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsageData) ProtoMessage() {}
+
+func (x *AIUsageData) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsageData.ProtoReflect.Descriptor instead.
+func (*AIUsageData) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *AIUsageData) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *AIUsageData) GetAiChatCount() int32 {
+	if x != nil {
+		return x.AiChatCount
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetAiChatLimit() int32 {
+	if x != nil {
+		return x.AiChatLimit
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetAiContentCount() int32 {
+	if x != nil {
+		return x.AiContentCount
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetAiContentLimit() int32 {
+	if x != nil {
+		return x.AiContentLimit
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetAiAnalysisCount() int32 {
+	if x != nil {
+		return x.AiAnalysisCount
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetAiAnalysisLimit() int32 {
+	if x != nil {
+		return x.AiAnalysisLimit
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetAiLastResetAt() string {
+	if x != nil {
+		return x.AiLastResetAt
+	}
+	return ""
+}
+
+func (x *AIUsageData) GetTokenUsed() int64 {
+	if x != nil {
+		return x.TokenUsed
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetTokenBudget() int64 {
+	if x != nil {
+		return x.TokenBudget
+	}
+	return 0
+}
+
+func (x *AIUsageData) GetNextResetAt() string {
+	if x != nil {
+		return x.NextResetAt
+	}
+	return ""
+}
+
+func (x *AIUsageData) GetResetPolicy() string {
+	if x != nil {
+		return x.ResetPolicy
+	}
+	return ""
+}
+
+func (x *AIUsageData) GetCreditBalance() int64 {
+	if x != nil {
+		return x.CreditBalance
+	}
+	return 0
+}
+
+type GetAIUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetAIUsageReq) Reset() {
+	*x = GetAIUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAIUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageReq) ProtoMessage() {}
+
+func (x *GetAIUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageReq.ProtoReflect.Descriptor instead.
+func (*GetAIUsageReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetAIUsageReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAIUsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage *AIUsageData `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *GetAIUsageResp) Reset() {
+	*x = GetAIUsageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAIUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageResp) ProtoMessage() {}
+
+func (x *GetAIUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageResp.ProtoReflect.Descriptor instead.
+func (*GetAIUsageResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetAIUsageResp) GetUsage() *AIUsageData {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+type UpdateAIUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UsageType string `protobuf:"bytes,2,opt,name=usage_type,json=usageType,proto3" json:"usage_type,omitempty"` // chat, content, analysis
+}
+
+func (x *UpdateAIUsageReq) Reset() {
+	*x = UpdateAIUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAIUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAIUsageReq) ProtoMessage() {}
+
+func (x *UpdateAIUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAIUsageReq.ProtoReflect.Descriptor instead.
+func (*UpdateAIUsageReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UpdateAIUsageReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateAIUsageReq) GetUsageType() string {
+	if x != nil {
+		return x.UsageType
+	}
+	return ""
+}
+
+type UpdateAIUsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage *AIUsageData `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *UpdateAIUsageResp) Reset() {
+	*x = UpdateAIUsageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAIUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAIUsageResp) ProtoMessage() {}
+
+func (x *UpdateAIUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAIUsageResp.ProtoReflect.Descriptor instead.
+func (*UpdateAIUsageResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *UpdateAIUsageResp) GetUsage() *AIUsageData {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// AI调用流水
+type AIUsageLedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Feature          string `protobuf:"bytes,2,opt,name=feature,proto3" json:"feature,omitempty"`
+	Model            string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	ModelTier        string `protobuf:"bytes,4,opt,name=model_tier,json=modelTier,proto3" json:"model_tier,omitempty"`
+	PromptTokens     int32  `protobuf:"varint,5,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens int32  `protobuf:"varint,6,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	TotalTokens      int32  `protobuf:"varint,7,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	LatencyMs        int64  `protobuf:"varint,8,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Status           string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                   // success, failed, cancelled
+	PlanId           string `protobuf:"bytes,10,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`    // 调用时生效的套餐ID，免费用户为空
+	OrderId          string `protobuf:"bytes,11,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 调用时生效的VIP记录的来源订单ID
+	CreatedAt        string `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AIUsageLedgerEntry) Reset() {
+	*x = AIUsageLedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIUsageLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIUsageLedgerEntry) ProtoMessage() {}
+
+func (x *AIUsageLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIUsageLedgerEntry.ProtoReflect.Descriptor instead.
+func (*AIUsageLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AIUsageLedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetModelTier() string {
+	if x != nil {
+		return x.ModelTier
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetPromptTokens() int32 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *AIUsageLedgerEntry) GetCompletionTokens() int32 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *AIUsageLedgerEntry) GetTotalTokens() int32 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *AIUsageLedgerEntry) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *AIUsageLedgerEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AIUsageLedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAIUsageHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 开始日期（含），格式2006-01-02，为空时不限制
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 结束日期（含），格式2006-01-02，为空时不限制
+	Page      int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetAIUsageHistoryReq) Reset() {
+	*x = GetAIUsageHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAIUsageHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageHistoryReq) ProtoMessage() {}
+
+func (x *GetAIUsageHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetAIUsageHistoryReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetAIUsageHistoryReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAIUsageHistoryReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetAIUsageHistoryReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetAIUsageHistoryReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAIUsageHistoryReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetAIUsageHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AIUsageLedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total   int32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetAIUsageHistoryResp) Reset() {
+	*x = GetAIUsageHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAIUsageHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAIUsageHistoryResp) ProtoMessage() {}
+
+func (x *GetAIUsageHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAIUsageHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetAIUsageHistoryResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetAIUsageHistoryResp) GetEntries() []*AIUsageLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAIUsageHistoryResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// AI额度相关消息
+type AICreditGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PackId    string `protobuf:"bytes,2,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+	OrderId   string `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Credits   int32  `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`     // 发放的次数
+	Remaining int32  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"` // 剩余次数
+	ExpiresAt string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AICreditGrant) Reset() {
+	*x = AICreditGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AICreditGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AICreditGrant) ProtoMessage() {}
+
+func (x *AICreditGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AICreditGrant.ProtoReflect.Descriptor instead.
+func (*AICreditGrant) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *AICreditGrant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AICreditGrant) GetPackId() string {
+	if x != nil {
+		return x.PackId
+	}
+	return ""
+}
+
+func (x *AICreditGrant) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AICreditGrant) GetCredits() int32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *AICreditGrant) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *AICreditGrant) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *AICreditGrant) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAICreditsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetAICreditsReq) Reset() {
+	*x = GetAICreditsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAICreditsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAICreditsReq) ProtoMessage() {}
+
+func (x *GetAICreditsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAICreditsReq.ProtoReflect.Descriptor instead.
+func (*GetAICreditsReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetAICreditsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAICreditsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance int64            `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"` // 可用额度
+	Grants  []*AICreditGrant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants,omitempty"`    // 未到期且有剩余的额度，先到期的在前
+}
+
+func (x *GetAICreditsResp) Reset() {
+	*x = GetAICreditsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAICreditsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAICreditsResp) ProtoMessage() {}
+
+func (x *GetAICreditsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAICreditsResp.ProtoReflect.Descriptor instead.
+func (*GetAICreditsResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetAICreditsResp) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetAICreditsResp) GetGrants() []*AICreditGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type AICreditLedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GrantId   string `protobuf:"bytes,2,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                      // grant, spend
+	Amount    int32  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`                 // 变动次数，发放为正数，消耗为负数
+	Feature   string `protobuf:"bytes,5,opt,name=feature,proto3" json:"feature,omitempty"`                // 消耗的AI功能，发放时为空
+	OrderId   string `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"` // 发放的来源订单ID
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AICreditLedgerEntry) Reset() {
+	*x = AICreditLedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AICreditLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AICreditLedgerEntry) ProtoMessage() {}
+
+func (x *AICreditLedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AICreditLedgerEntry.ProtoReflect.Descriptor instead.
+func (*AICreditLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *AICreditLedgerEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AICreditLedgerEntry) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+func (x *AICreditLedgerEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AICreditLedgerEntry) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AICreditLedgerEntry) GetFeature() string {
+	if x != nil {
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *AICreditLedgerEntry) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AICreditLedgerEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAICreditLedgerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetAICreditLedgerReq) Reset() {
+	*x = GetAICreditLedgerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAICreditLedgerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAICreditLedgerReq) ProtoMessage() {}
+
+func (x *GetAICreditLedgerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAICreditLedgerReq.ProtoReflect.Descriptor instead.
+func (*GetAICreditLedgerReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetAICreditLedgerReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAICreditLedgerReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAICreditLedgerReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetAICreditLedgerResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AICreditLedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total   int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetAICreditLedgerResp) Reset() {
+	*x = GetAICreditLedgerResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAICreditLedgerResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAICreditLedgerResp) ProtoMessage() {}
+
+func (x *GetAICreditLedgerResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAICreditLedgerResp.ProtoReflect.Descriptor instead.
+func (*GetAICreditLedgerResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetAICreditLedgerResp) GetEntries() []*AICreditLedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetAICreditLedgerResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// AI请求相关消息
+type AIRequestReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Prompt    string `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	UsageType string `protobuf:"bytes,3,opt,name=usage_type,json=usageType,proto3" json:"usage_type,omitempty"` // chat, content, analysis，为空时按chat计费
+	ModelTier string `protobuf:"bytes,4,opt,name=model_tier,json=modelTier,proto3" json:"model_tier,omitempty"` // basic, advanced, premium，为空时使用套餐可用的最高等级
+}
+
+func (x *AIRequestReq) Reset() {
+	*x = AIRequestReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIRequestReq) ProtoMessage() {}
+
+func (x *AIRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIRequestReq.ProtoReflect.Descriptor instead.
+func (*AIRequestReq) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *AIRequestReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AIRequestReq) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *AIRequestReq) GetUsageType() string {
+	if x != nil {
+		return x.UsageType
+	}
+	return ""
+}
+
+func (x *AIRequestReq) GetModelTier() string {
+	if x != nil {
+		return x.ModelTier
+	}
+	return ""
+}
+
+type AIRequestResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string       `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Model    string       `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Usage    *AIUsageData `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *AIRequestResp) Reset() {
+	*x = AIRequestResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIRequestResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIRequestResp) ProtoMessage() {}
+
+func (x *AIRequestResp) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIRequestResp.ProtoReflect.Descriptor instead.
+func (*AIRequestResp) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *AIRequestResp) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *AIRequestResp) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIRequestResp) GetUsage() *AIUsageData {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// AI流式响应分片
+type AIStreamChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delta string       `protobuf:"bytes,1,opt,name=delta,proto3" json:"delta,omitempty"` // 本次增量内容
+	Done  bool         `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`  // 是否为最后一个分片
+	Model string       `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"` // 仅在最后一个分片中返回
+	Usage *AIUsageData `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"` // 仅在最后一个分片中返回
+}
+
+func (x *AIStreamChunk) Reset() {
+	*x = AIStreamChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_superservice_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AIStreamChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AIStreamChunk) ProtoMessage() {}
+
+func (x *AIStreamChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_superservice_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AIStreamChunk.ProtoReflect.Descriptor instead.
+func (*AIStreamChunk) Descriptor() ([]byte, []int) {
+	return file_superservice_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *AIStreamChunk) GetDelta() string {
+	if x != nil {
+		return x.Delta
+	}
+	return ""
+}
+
+func (x *AIStreamChunk) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *AIStreamChunk) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AIStreamChunk) GetUsage() *AIUsageData {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+var File_superservice_proto protoreflect.FileDescriptor
+
+var file_superservice_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x76, 0x69,
+	0x70, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x70, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0x5b, 0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x36, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22,
+	0x98, 0x02, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12,
+	0x2e, 0x0a, 0x13, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0x73, 0x0a, 0x17, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22,
+	0x46, 0x0a, 0x0d, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x22,
+	0x52, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x4d, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x72,
+	0x6c, 0x22, 0x72, 0x0a, 0x0d, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x22, 0x75, 0x0a, 0x14, 0x4c,
+	0x69, 0x6e, 0x6b, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x4f, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x22, 0x2f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x15, 0x55, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c, 0x0a, 0x10, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0c, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5e, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x49, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x22, 0x48, 0x0a, 0x0e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x77, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x77, 0x61, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6c, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x16, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x74, 0x70, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x74,
+	0x70, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x12, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3c, 0x0a, 0x13, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x13, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x73, 0x22, 0x4a, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x32, 0x0a,
+	0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x61, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x13,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x22,
+	0x6d, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x50,
+	0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70,
+	0x22, 0x1b, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x81, 0x01,
+	0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x22, 0x48, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x0e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x2e,
+	0x0a, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x89,
+	0x01, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x56, 0x0a, 0x0f, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x31, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x25,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x92, 0x01, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x22, 0x3c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x76, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x26, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x40, 0x0a, 0x0f, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x33, 0x0a, 0x18, 0x53,
+	0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x29, 0x0a,
+	0x11, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x67,
+	0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4b,
+	0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x49, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x19, 0x0a,
+	0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x6e, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x3b, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x26, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x28, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x02, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
+	0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x39, 0x0a, 0x0b,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x80, 0x02, 0x0a, 0x07, 0x56, 0x69, 0x70, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61,
+	0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22,
+	0x3e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22,
+	0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x22, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e,
+	0x73, 0x22, 0xdf, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x22, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e,
+	0x0a, 0x05, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x9a,
+	0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x44, 0x61, 0x79, 0x73, 0x22, 0x44, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x04, 0x70, 0x61, 0x63,
+	0x6b, 0x22, 0xb5, 0x02, 0x0a, 0x08, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e,
+	0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63,
+	0x6b, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x70,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x56, 0x69,
+	0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x7a, 0x0a, 0x0f,
+	0x50, 0x61, 0x79, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x17, 0x0a, 0x07, 0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x71, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x4e,
+	0x6f, 0x22, 0x42, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x56, 0x69, 0x70, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xc5, 0x01, 0x0a, 0x10, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x45, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x25, 0x0a,
+	0x11, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x61, 0x63, 0x6b, 0x22, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x58, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xd3, 0x01, 0x0a, 0x09,
+	0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x5c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x34, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69,
+	0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x22, 0x2e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73,
+	0x5f, 0x76, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x22,
+	0x2a, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52,
+	0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x10, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x22, 0x4c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x14, 0x53,
+	0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x15,
+	0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xf1, 0x03, 0x0a, 0x0b,
+	0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x69,
+	0x73, 0x5f, 0x76, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x56,
+	0x69, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x69, 0x43, 0x68, 0x61,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x69, 0x5f, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61,
+	0x69, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x61, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x69, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x69, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x69,
+	0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x61, 0x69, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x10, 0x61, 0x69, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x69, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73, 0x65, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
+	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x28, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x10,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf2,
+	0x02, 0x0a, 0x12, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74,
+	0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x54, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x69, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc9, 0x01, 0x0a, 0x0d,
+	0x41, 0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x49,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x41, 0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x13, 0x41, 0x49, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x60, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x41, 0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6a, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x7d, 0x0a, 0x0c, 0x41, 0x49, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x54, 0x69, 0x65, 0x72, 0x22, 0x72, 0x0a, 0x0d, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x41,
+	0x49, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x32, 0x99, 0x29,
+	0x0a, 0x05, 0x53, 0x75, 0x70, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x05, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x77,
+	0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x25, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a,
+	0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x15, 0x53, 0x65,
+	0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x26, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x56, 0x69, 0x70, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
+	0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x15,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x23,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x17, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5c, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a,
+	0x0a, 0x0b, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x59, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x77, 0x6f,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x42, 0x0a, 0x0a, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x1b, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f,
+	0x41, 0x75, 0x74, 0x68, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x41, 0x75,
+	0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x23,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5f, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e,
+	0x73, 0x12, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x69, 0x70, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69,
+	0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69,
+	0x70, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a,
+	0x0b, 0x50, 0x61, 0x79, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x56,
+	0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x56, 0x69, 0x70,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x56, 0x69, 0x70, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50,
+	0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12,
+	0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x56, 0x69, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x56, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x20, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x55, 0x73,
+	0x65, 0x72, 0x56, 0x69, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x2e,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x22, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x55, 0x73, 0x61, 0x67, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41,
+	0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x49,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x49, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0f, 0x41,
+	0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a,
+	0x2e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x73, 0x75, 0x70,
+	0x65, 0x72, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x41, 0x49, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_superservice_proto_rawDescOnce sync.Once
+	file_superservice_proto_rawDescData = file_superservice_proto_rawDesc
+)
+
+func file_superservice_proto_rawDescGZIP() []byte {
+	file_superservice_proto_rawDescOnce.Do(func() {
+		file_superservice_proto_rawDescData = protoimpl.X.CompressGZIP(file_superservice_proto_rawDescData)
+	})
+	return file_superservice_proto_rawDescData
+}
+
+var file_superservice_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
+var file_superservice_proto_goTypes = []interface{}{
+	(*User)(nil),                       // 0: superservice.User
+	(*RegisterReq)(nil),                // 1: superservice.RegisterReq
+	(*RegisterResp)(nil),               // 2: superservice.RegisterResp
+	(*LoginReq)(nil),                   // 3: superservice.LoginReq
+	(*LoginResp)(nil),                  // 4: superservice.LoginResp
+	(*VerifyTwoFactorLoginReq)(nil),    // 5: superservice.VerifyTwoFactorLoginReq
+	(*OAuthProvider)(nil),              // 6: superservice.OAuthProvider
+	(*GetOAuthProvidersReq)(nil),       // 7: superservice.GetOAuthProvidersReq
+	(*GetOAuthProvidersResp)(nil),      // 8: superservice.GetOAuthProvidersResp
+	(*StartOAuthReq)(nil),              // 9: superservice.StartOAuthReq
+	(*StartOAuthResp)(nil),             // 10: superservice.StartOAuthResp
+	(*OAuthLoginReq)(nil),              // 11: superservice.OAuthLoginReq
+	(*UserIdentity)(nil),               // 12: superservice.UserIdentity
+	(*LinkOAuthIdentityReq)(nil),       // 13: superservice.LinkOAuthIdentityReq
+	(*LinkOAuthIdentityResp)(nil),      // 14: superservice.LinkOAuthIdentityResp
+	(*GetUserIdentitiesReq)(nil),       // 15: superservice.GetUserIdentitiesReq
+	(*GetUserIdentitiesResp)(nil),      // 16: superservice.GetUserIdentitiesResp
+	(*UnlinkUserIdentityReq)(nil),      // 17: superservice.UnlinkUserIdentityReq
+	(*UnlinkUserIdentityResp)(nil),     // 18: superservice.UnlinkUserIdentityResp
+	(*RefreshTokenReq)(nil),            // 19: superservice.RefreshTokenReq
+	(*RefreshTokenResp)(nil),           // 20: superservice.RefreshTokenResp
+	(*LogoutReq)(nil),                  // 21: superservice.LogoutReq
+	(*LogoutResp)(nil),                 // 22: superservice.LogoutResp
+	(*SetUserRoleReq)(nil),             // 23: superservice.SetUserRoleReq
+	(*SetUserRoleResp)(nil),            // 24: superservice.SetUserRoleResp
+	(*UnlockUserReq)(nil),              // 25: superservice.UnlockUserReq
+	(*UnlockUserResp)(nil),             // 26: superservice.UnlockUserResp
+	(*GetTwoFactorStatusReq)(nil),      // 27: superservice.GetTwoFactorStatusReq
+	(*GetTwoFactorStatusResp)(nil),     // 28: superservice.GetTwoFactorStatusResp
+	(*SetupTwoFactorReq)(nil),          // 29: superservice.SetupTwoFactorReq
+	(*SetupTwoFactorResp)(nil),         // 30: superservice.SetupTwoFactorResp
+	(*EnableTwoFactorReq)(nil),         // 31: superservice.EnableTwoFactorReq
+	(*EnableTwoFactorResp)(nil),        // 32: superservice.EnableTwoFactorResp
+	(*DisableTwoFactorReq)(nil),        // 33: superservice.DisableTwoFactorReq
+	(*DisableTwoFactorResp)(nil),       // 34: superservice.DisableTwoFactorResp
+	(*ResetTwoFactorReq)(nil),          // 35: superservice.ResetTwoFactorReq
+	(*ResetTwoFactorResp)(nil),         // 36: superservice.ResetTwoFactorResp
+	(*ReconcileUsersReq)(nil),          // 37: superservice.ReconcileUsersReq
+	(*UserDrift)(nil),                  // 38: superservice.UserDrift
+	(*ReconcileUsersResp)(nil),         // 39: superservice.ReconcileUsersResp
+	(*RestoreUserReq)(nil),             // 40: superservice.RestoreUserReq
+	(*RestoreUserResp)(nil),            // 41: superservice.RestoreUserResp
+	(*GetDeletedUsersReq)(nil),         // 42: superservice.GetDeletedUsersReq
+	(*DeletedUser)(nil),                // 43: superservice.DeletedUser
+	(*GetDeletedUsersResp)(nil),        // 44: superservice.GetDeletedUsersResp
+	(*GetAccountDeletionReq)(nil),      // 45: superservice.GetAccountDeletionReq
+	(*AccountDeletionResp)(nil),        // 46: superservice.AccountDeletionResp
+	(*RequestAccountDeletionReq)(nil),  // 47: superservice.RequestAccountDeletionReq
+	(*CancelAccountDeletionReq)(nil),   // 48: superservice.CancelAccountDeletionReq
+	(*CancelAccountDeletionResp)(nil),  // 49: superservice.CancelAccountDeletionResp
+	(*StartDataExportReq)(nil),         // 50: superservice.StartDataExportReq
+	(*GetDataExportReq)(nil),           // 51: superservice.GetDataExportReq
+	(*DataExportResp)(nil),             // 52: superservice.DataExportResp
+	(*DownloadDataExportReq)(nil),      // 53: superservice.DownloadDataExportReq
+	(*DataExportChunk)(nil),            // 54: superservice.DataExportChunk
+	(*CheckTokenRevokedReq)(nil),       // 55: superservice.CheckTokenRevokedReq
+	(*CheckTokenRevokedResp)(nil),      // 56: superservice.CheckTokenRevokedResp
+	(*GetUserInfoReq)(nil),             // 57: superservice.GetUserInfoReq
+	(*GetUserInfoResp)(nil),            // 58: superservice.GetUserInfoResp
+	(*GetUserReq)(nil),                 // 59: superservice.GetUserReq
+	(*GetUserResp)(nil),                // 60: superservice.GetUserResp
+	(*UpdateUserInfoReq)(nil),          // 61: superservice.UpdateUserInfoReq
+	(*UpdateUserInfoResp)(nil),         // 62: superservice.UpdateUserInfoResp
+	(*UpdateUserPasswordReq)(nil),      // 63: superservice.UpdateUserPasswordReq
+	(*UpdateUserPasswordResp)(nil),     // 64: superservice.UpdateUserPasswordResp
+	(*VerifyEmailReq)(nil),             // 65: superservice.VerifyEmailReq
+	(*VerifyEmailResp)(nil),            // 66: superservice.VerifyEmailResp
+	(*SendVerificationEmailReq)(nil),   // 67: superservice.SendVerificationEmailReq
+	(*SendVerificationEmailResp)(nil),  // 68: superservice.SendVerificationEmailResp
+	(*ForgotPasswordReq)(nil),          // 69: superservice.ForgotPasswordReq
+	(*ForgotPasswordResp)(nil),         // 70: superservice.ForgotPasswordResp
+	(*ResetPasswordReq)(nil),           // 71: superservice.ResetPasswordReq
+	(*ResetPasswordResp)(nil),          // 72: superservice.ResetPasswordResp
+	(*DeleteUserReq)(nil),              // 73: superservice.DeleteUserReq
+	(*DeleteUserResp)(nil),             // 74: superservice.DeleteUserResp
+	(*UpdateUserVipReq)(nil),           // 75: superservice.UpdateUserVipReq
+	(*UpdateUserVipResp)(nil),          // 76: superservice.UpdateUserVipResp
+	(*GetUsersReq)(nil),                // 77: superservice.GetUsersReq
+	(*GetUsersResp)(nil),               // 78: superservice.GetUsersResp
+	(*GetUserCountReq)(nil),            // 79: superservice.GetUserCountReq
+	(*GetUserCountResp)(nil),           // 80: superservice.GetUserCountResp
+	(*PlanFeatures)(nil),               // 81: superservice.PlanFeatures
+	(*VipPlan)(nil),                    // 82: superservice.VipPlan
+	(*GetVipPlanReq)(nil),              // 83: superservice.GetVipPlanReq
+	(*GetVipPlanResp)(nil),             // 84: superservice.GetVipPlanResp
+	(*CreateVipPlanReq)(nil),           // 85: superservice.CreateVipPlanReq
+	(*CreateVipPlanResp)(nil),          // 86: superservice.CreateVipPlanResp
+	(*GetVipPlansReq)(nil),             // 87: superservice.GetVipPlansReq
+	(*GetVipPlansResp)(nil),            // 88: superservice.GetVipPlansResp
+	(*CreditPack)(nil),                 // 89: superservice.CreditPack
+	(*GetCreditPacksReq)(nil),          // 90: superservice.GetCreditPacksReq
+	(*GetCreditPacksResp)(nil),         // 91: superservice.GetCreditPacksResp
+	(*CreateCreditPackReq)(nil),        // 92: superservice.CreateCreditPackReq
+	(*CreateCreditPackResp)(nil),       // 93: superservice.CreateCreditPackResp
+	(*VipOrder)(nil),                   // 94: superservice.VipOrder
+	(*CreateVipOrderReq)(nil),          // 95: superservice.CreateVipOrderReq
+	(*CreateVipOrderResp)(nil),         // 96: superservice.CreateVipOrderResp
+	(*PayVipOrderReq)(nil),             // 97: superservice.PayVipOrderReq
+	(*PayVipOrderResp)(nil),            // 98: superservice.PayVipOrderResp
+	(*CancelVipOrderReq)(nil),          // 99: superservice.CancelVipOrderReq
+	(*CancelVipOrderResp)(nil),         // 100: superservice.CancelVipOrderResp
+	(*PaymentNotifyReq)(nil),           // 101: superservice.PaymentNotifyReq
+	(*PaymentNotifyResp)(nil),          // 102: superservice.PaymentNotifyResp
+	(*GetVipOrdersReq)(nil),            // 103: superservice.GetVipOrdersReq
+	(*GetVipOrdersResp)(nil),           // 104: superservice.GetVipOrdersResp
+	(*VipRecord)(nil),                  // 105: superservice.VipRecord
+	(*GetVipRecordsReq)(nil),           // 106: superservice.GetVipRecordsReq
+	(*GetVipRecordsResp)(nil),          // 107: superservice.GetVipRecordsResp
+	(*GetUserActiveVipRecordReq)(nil),  // 108: superservice.GetUserActiveVipRecordReq
+	(*GetUserActiveVipRecordResp)(nil), // 109: superservice.GetUserActiveVipRecordResp
+	(*GetUserVipStatusReq)(nil),        // 110: superservice.GetUserVipStatusReq
+	(*GetUserVipStatusResp)(nil),       // 111: superservice.GetUserVipStatusResp
+	(*CheckUserVipReq)(nil),            // 112: superservice.CheckUserVipReq
+	(*CheckUserVipResp)(nil),           // 113: superservice.CheckUserVipResp
+	(*UpdateAutoRenewReq)(nil),         // 114: superservice.UpdateAutoRenewReq
+	(*UpdateAutoRenewResp)(nil),        // 115: superservice.UpdateAutoRenewResp
+	(*SyncUserVipStatusReq)(nil),       // 116: superservice.SyncUserVipStatusReq
+	(*SyncUserVipStatusResp)(nil),      // 117: superservice.SyncUserVipStatusResp
+	(*AIUsageData)(nil),                // 118: superservice.AIUsageData
+	(*GetAIUsageReq)(nil),              // 119: superservice.GetAIUsageReq
+	(*GetAIUsageResp)(nil),             // 120: superservice.GetAIUsageResp
+	(*UpdateAIUsageReq)(nil),           // 121: superservice.UpdateAIUsageReq
+	(*UpdateAIUsageResp)(nil),          // 122: superservice.UpdateAIUsageResp
+	(*AIUsageLedgerEntry)(nil),         // 123: superservice.AIUsageLedgerEntry
+	(*GetAIUsageHistoryReq)(nil),       // 124: superservice.GetAIUsageHistoryReq
+	(*GetAIUsageHistoryResp)(nil),      // 125: superservice.GetAIUsageHistoryResp
+	(*AICreditGrant)(nil),              // 126: superservice.AICreditGrant
+	(*GetAICreditsReq)(nil),            // 127: superservice.GetAICreditsReq
+	(*GetAICreditsResp)(nil),           // 128: superservice.GetAICreditsResp
+	(*AICreditLedgerEntry)(nil),        // 129: superservice.AICreditLedgerEntry
+	(*GetAICreditLedgerReq)(nil),       // 130: superservice.GetAICreditLedgerReq
+	(*GetAICreditLedgerResp)(nil),      // 131: superservice.GetAICreditLedgerResp
+	(*AIRequestReq)(nil),               // 132: superservice.AIRequestReq
+	(*AIRequestResp)(nil),              // 133: superservice.AIRequestResp
+	(*AIStreamChunk)(nil),              // 134: superservice.AIStreamChunk
+	nil,                                // 135: superservice.PlanFeatures.QuotasEntry
+	nil,                                // 136: superservice.PaymentNotifyReq.HeadersEntry
+}
+var file_superservice_proto_depIdxs = []int32{
+	0,   // 0: superservice.RegisterResp.user:type_name -> superservice.User
+	0,   // 1: superservice.LoginResp.user:type_name -> superservice.User
+	6,   // 2: superservice.GetOAuthProvidersResp.providers:type_name -> superservice.OAuthProvider
+	12,  // 3: superservice.LinkOAuthIdentityResp.identity:type_name -> superservice.UserIdentity
+	12,  // 4: superservice.GetUserIdentitiesResp.identities:type_name -> superservice.UserIdentity
+	38,  // 5: superservice.ReconcileUsersResp.drifts:type_name -> superservice.UserDrift
+	43,  // 6: superservice.GetDeletedUsersResp.users:type_name -> superservice.DeletedUser
+	0,   // 7: superservice.GetUserInfoResp.user:type_name -> superservice.User
+	0,   // 8: superservice.GetUserResp.user:type_name -> superservice.User
+	0,   // 9: superservice.UpdateUserInfoResp.user:type_name -> superservice.User
+	0,   // 10: superservice.UpdateUserVipResp.user:type_name -> superservice.User
+	0,   // 11: superservice.GetUsersResp.users:type_name -> superservice.User
+	135, // 12: superservice.PlanFeatures.quotas:type_name -> superservice.PlanFeatures.QuotasEntry
+	81,  // 13: superservice.VipPlan.features:type_name -> superservice.PlanFeatures
+	82,  // 14: superservice.GetVipPlanResp.plan:type_name -> superservice.VipPlan
+	81,  // 15: superservice.CreateVipPlanReq.features:type_name -> superservice.PlanFeatures
+	82,  // 16: superservice.CreateVipPlanResp.plan:type_name -> superservice.VipPlan
+	82,  // 17: superservice.GetVipPlansResp.plans:type_name -> superservice.VipPlan
+	89,  // 18: superservice.GetCreditPacksResp.packs:type_name -> superservice.CreditPack
+	89,  // 19: superservice.CreateCreditPackResp.pack:type_name -> superservice.CreditPack
+	94,  // 20: superservice.CreateVipOrderResp.order:type_name -> superservice.VipOrder
+	94,  // 21: superservice.CancelVipOrderResp.order:type_name -> superservice.VipOrder
+	136, // 22: superservice.PaymentNotifyReq.headers:type_name -> superservice.PaymentNotifyReq.HeadersEntry
+	94,  // 23: superservice.GetVipOrdersResp.orders:type_name -> superservice.VipOrder
+	105, // 24: superservice.GetVipRecordsResp.records:type_name -> superservice.VipRecord
+	105, // 25: superservice.GetUserActiveVipRecordResp.record:type_name -> superservice.VipRecord
+	118, // 26: superservice.GetAIUsageResp.usage:type_name -> superservice.AIUsageData
+	118, // 27: superservice.UpdateAIUsageResp.usage:type_name -> superservice.AIUsageData
+	123, // 28: superservice.GetAIUsageHistoryResp.entries:type_name -> superservice.AIUsageLedgerEntry
+	126, // 29: superservice.GetAICreditsResp.grants:type_name -> superservice.AICreditGrant
+	129, // 30: superservice.GetAICreditLedgerResp.entries:type_name -> superservice.AICreditLedgerEntry
+	118, // 31: superservice.AIRequestResp.usage:type_name -> superservice.AIUsageData
+	118, // 32: superservice.AIStreamChunk.usage:type_name -> superservice.AIUsageData
+	1,   // 33: superservice.Super.Register:input_type -> superservice.RegisterReq
+	3,   // 34: superservice.Super.Login:input_type -> superservice.LoginReq
+	5,   // 35: superservice.Super.VerifyTwoFactorLogin:input_type -> superservice.VerifyTwoFactorLoginReq
+	57,  // 36: superservice.Super.GetUserInfo:input_type -> superservice.GetUserInfoReq
+	59,  // 37: superservice.Super.GetUser:input_type -> superservice.GetUserReq
+	61,  // 38: superservice.Super.UpdateUserInfo:input_type -> superservice.UpdateUserInfoReq
+	63,  // 39: superservice.Super.UpdateUserPassword:input_type -> superservice.UpdateUserPasswordReq
+	65,  // 40: superservice.Super.VerifyEmail:input_type -> superservice.VerifyEmailReq
+	67,  // 41: superservice.Super.SendVerificationEmail:input_type -> superservice.SendVerificationEmailReq
+	69,  // 42: superservice.Super.ForgotPassword:input_type -> superservice.ForgotPasswordReq
+	71,  // 43: superservice.Super.ResetPassword:input_type -> superservice.ResetPasswordReq
+	73,  // 44: superservice.Super.DeleteUser:input_type -> superservice.DeleteUserReq
+	75,  // 45: superservice.Super.UpdateUserVip:input_type -> superservice.UpdateUserVipReq
+	77,  // 46: superservice.Super.GetUsers:input_type -> superservice.GetUsersReq
+	79,  // 47: superservice.Super.GetUserCount:input_type -> superservice.GetUserCountReq
+	45,  // 48: superservice.Super.GetAccountDeletion:input_type -> superservice.GetAccountDeletionReq
+	47,  // 49: superservice.Super.RequestAccountDeletion:input_type -> superservice.RequestAccountDeletionReq
+	48,  // 50: superservice.Super.CancelAccountDeletion:input_type -> superservice.CancelAccountDeletionReq
+	50,  // 51: superservice.Super.StartDataExport:input_type -> superservice.StartDataExportReq
+	51,  // 52: superservice.Super.GetDataExport:input_type -> superservice.GetDataExportReq
+	53,  // 53: superservice.Super.DownloadDataExport:input_type -> superservice.DownloadDataExportReq
+	19,  // 54: superservice.Super.RefreshToken:input_type -> superservice.RefreshTokenReq
+	21,  // 55: superservice.Super.Logout:input_type -> superservice.LogoutReq
+	55,  // 56: superservice.Super.CheckTokenRevoked:input_type -> superservice.CheckTokenRevokedReq
+	23,  // 57: superservice.Super.SetUserRole:input_type -> superservice.SetUserRoleReq
+	25,  // 58: superservice.Super.UnlockUser:input_type -> superservice.UnlockUserReq
+	35,  // 59: superservice.Super.ResetTwoFactor:input_type -> superservice.ResetTwoFactorReq
+	37,  // 60: superservice.Super.ReconcileUsers:input_type -> superservice.ReconcileUsersReq
+	40,  // 61: superservice.Super.RestoreUser:input_type -> superservice.RestoreUserReq
+	42,  // 62: superservice.Super.GetDeletedUsers:input_type -> superservice.GetDeletedUsersReq
+	27,  // 63: superservice.Super.GetTwoFactorStatus:input_type -> superservice.GetTwoFactorStatusReq
+	29,  // 64: superservice.Super.SetupTwoFactor:input_type -> superservice.SetupTwoFactorReq
+	31,  // 65: superservice.Super.EnableTwoFactor:input_type -> superservice.EnableTwoFactorReq
+	33,  // 66: superservice.Super.DisableTwoFactor:input_type -> superservice.DisableTwoFactorReq
+	7,   // 67: superservice.Super.GetOAuthProviders:input_type -> superservice.GetOAuthProvidersReq
+	9,   // 68: superservice.Super.StartOAuth:input_type -> superservice.StartOAuthReq
+	11,  // 69: superservice.Super.OAuthLogin:input_type -> superservice.OAuthLoginReq
+	13,  // 70: superservice.Super.LinkOAuthIdentity:input_type -> superservice.LinkOAuthIdentityReq
+	15,  // 71: superservice.Super.GetUserIdentities:input_type -> superservice.GetUserIdentitiesReq
+	17,  // 72: superservice.Super.UnlinkUserIdentity:input_type -> superservice.UnlinkUserIdentityReq
+	87,  // 73: superservice.Super.GetVipPlans:input_type -> superservice.GetVipPlansReq
+	83,  // 74: superservice.Super.GetVipPlan:input_type -> superservice.GetVipPlanReq
+	85,  // 75: superservice.Super.CreateVipPlan:input_type -> superservice.CreateVipPlanReq
+	90,  // 76: superservice.Super.GetCreditPacks:input_type -> superservice.GetCreditPacksReq
+	92,  // 77: superservice.Super.CreateCreditPack:input_type -> superservice.CreateCreditPackReq
+	95,  // 78: superservice.Super.CreateVipOrder:input_type -> superservice.CreateVipOrderReq
+	103, // 79: superservice.Super.GetVipOrders:input_type -> superservice.GetVipOrdersReq
+	97,  // 80: superservice.Super.PayVipOrder:input_type -> superservice.PayVipOrderReq
+	99,  // 81: superservice.Super.CancelVipOrder:input_type -> superservice.CancelVipOrderReq
+	101, // 82: superservice.Super.PaymentNotify:input_type -> superservice.PaymentNotifyReq
+	106, // 83: superservice.Super.GetVipRecords:input_type -> superservice.GetVipRecordsReq
+	108, // 84: superservice.Super.GetUserActiveVipRecord:input_type -> superservice.GetUserActiveVipRecordReq
+	110, // 85: superservice.Super.GetUserVipStatus:input_type -> superservice.GetUserVipStatusReq
+	112, // 86: superservice.Super.CheckUserVip:input_type -> superservice.CheckUserVipReq
+	114, // 87: superservice.Super.UpdateAutoRenew:input_type -> superservice.UpdateAutoRenewReq
+	116, // 88: superservice.Super.SyncUserVipStatus:input_type -> superservice.SyncUserVipStatusReq
+	119, // 89: superservice.Super.GetAIUsage:input_type -> superservice.GetAIUsageReq
+	121, // 90: superservice.Super.UpdateAIUsage:input_type -> superservice.UpdateAIUsageReq
+	124, // 91: superservice.Super.GetAIUsageHistory:input_type -> superservice.GetAIUsageHistoryReq
+	127, // 92: superservice.Super.GetAICredits:input_type -> superservice.GetAICreditsReq
+	130, // 93: superservice.Super.GetAICreditLedger:input_type -> superservice.GetAICreditLedgerReq
+	132, // 94: superservice.Super.AIRequest:input_type -> superservice.AIRequestReq
+	132, // 95: superservice.Super.AIRequestStream:input_type -> superservice.AIRequestReq
+	2,   // 96: superservice.Super.Register:output_type -> superservice.RegisterResp
+	4,   // 97: superservice.Super.Login:output_type -> superservice.LoginResp
+	4,   // 98: superservice.Super.VerifyTwoFactorLogin:output_type -> superservice.LoginResp
+	58,  // 99: superservice.Super.GetUserInfo:output_type -> superservice.GetUserInfoResp
+	60,  // 100: superservice.Super.GetUser:output_type -> superservice.GetUserResp
+	62,  // 101: superservice.Super.UpdateUserInfo:output_type -> superservice.UpdateUserInfoResp
+	64,  // 102: superservice.Super.UpdateUserPassword:output_type -> superservice.UpdateUserPasswordResp
+	66,  // 103: superservice.Super.VerifyEmail:output_type -> superservice.VerifyEmailResp
+	68,  // 104: superservice.Super.SendVerificationEmail:output_type -> superservice.SendVerificationEmailResp
+	70,  // 105: superservice.Super.ForgotPassword:output_type -> superservice.ForgotPasswordResp
+	72,  // 106: superservice.Super.ResetPassword:output_type -> superservice.ResetPasswordResp
+	74,  // 107: superservice.Super.DeleteUser:output_type -> superservice.DeleteUserResp
+	76,  // 108: superservice.Super.UpdateUserVip:output_type -> superservice.UpdateUserVipResp
+	78,  // 109: superservice.Super.GetUsers:output_type -> superservice.GetUsersResp
+	80,  // 110: superservice.Super.GetUserCount:output_type -> superservice.GetUserCountResp
+	46,  // 111: superservice.Super.GetAccountDeletion:output_type -> superservice.AccountDeletionResp
+	46,  // 112: superservice.Super.RequestAccountDeletion:output_type -> superservice.AccountDeletionResp
+	49,  // 113: superservice.Super.CancelAccountDeletion:output_type -> superservice.CancelAccountDeletionResp
+	52,  // 114: superservice.Super.StartDataExport:output_type -> superservice.DataExportResp
+	52,  // 115: superservice.Super.GetDataExport:output_type -> superservice.DataExportResp
+	54,  // 116: superservice.Super.DownloadDataExport:output_type -> superservice.DataExportChunk
+	20,  // 117: superservice.Super.RefreshToken:output_type -> superservice.RefreshTokenResp
+	22,  // 118: superservice.Super.Logout:output_type -> superservice.LogoutResp
+	56,  // 119: superservice.Super.CheckTokenRevoked:output_type -> superservice.CheckTokenRevokedResp
+	24,  // 120: superservice.Super.SetUserRole:output_type -> superservice.SetUserRoleResp
+	26,  // 121: superservice.Super.UnlockUser:output_type -> superservice.UnlockUserResp
+	36,  // 122: superservice.Super.ResetTwoFactor:output_type -> superservice.ResetTwoFactorResp
+	39,  // 123: superservice.Super.ReconcileUsers:output_type -> superservice.ReconcileUsersResp
+	41,  // 124: superservice.Super.RestoreUser:output_type -> superservice.RestoreUserResp
+	44,  // 125: superservice.Super.GetDeletedUsers:output_type -> superservice.GetDeletedUsersResp
+	28,  // 126: superservice.Super.GetTwoFactorStatus:output_type -> superservice.GetTwoFactorStatusResp
+	30,  // 127: superservice.Super.SetupTwoFactor:output_type -> superservice.SetupTwoFactorResp
+	32,  // 128: superservice.Super.EnableTwoFactor:output_type -> superservice.EnableTwoFactorResp
+	34,  // 129: superservice.Super.DisableTwoFactor:output_type -> superservice.DisableTwoFactorResp
+	8,   // 130: superservice.Super.GetOAuthProviders:output_type -> superservice.GetOAuthProvidersResp
+	10,  // 131: superservice.Super.StartOAuth:output_type -> superservice.StartOAuthResp
+	4,   // 132: superservice.Super.OAuthLogin:output_type -> superservice.LoginResp
+	14,  // 133: superservice.Super.LinkOAuthIdentity:output_type -> superservice.LinkOAuthIdentityResp
+	16,  // 134: superservice.Super.GetUserIdentities:output_type -> superservice.GetUserIdentitiesResp
+	18,  // 135: superservice.Super.UnlinkUserIdentity:output_type -> superservice.UnlinkUserIdentityResp
+	88,  // 136: superservice.Super.GetVipPlans:output_type -> superservice.GetVipPlansResp
+	84,  // 137: superservice.Super.GetVipPlan:output_type -> superservice.GetVipPlanResp
+	86,  // 138: superservice.Super.CreateVipPlan:output_type -> superservice.CreateVipPlanResp
+	91,  // 139: superservice.Super.GetCreditPacks:output_type -> superservice.GetCreditPacksResp
+	93,  // 140: superservice.Super.CreateCreditPack:output_type -> superservice.CreateCreditPackResp
+	96,  // 141: superservice.Super.CreateVipOrder:output_type -> superservice.CreateVipOrderResp
+	104, // 142: superservice.Super.GetVipOrders:output_type -> superservice.GetVipOrdersResp
+	98,  // 143: superservice.Super.PayVipOrder:output_type -> superservice.PayVipOrderResp
+	100, // 144: superservice.Super.CancelVipOrder:output_type -> superservice.CancelVipOrderResp
+	102, // 145: superservice.Super.PaymentNotify:output_type -> superservice.PaymentNotifyResp
+	107, // 146: superservice.Super.GetVipRecords:output_type -> superservice.GetVipRecordsResp
+	109, // 147: superservice.Super.GetUserActiveVipRecord:output_type -> superservice.GetUserActiveVipRecordResp
+	111, // 148: superservice.Super.GetUserVipStatus:output_type -> superservice.GetUserVipStatusResp
+	113, // 149: superservice.Super.CheckUserVip:output_type -> superservice.CheckUserVipResp
+	115, // 150: superservice.Super.UpdateAutoRenew:output_type -> superservice.UpdateAutoRenewResp
+	117, // 151: superservice.Super.SyncUserVipStatus:output_type -> superservice.SyncUserVipStatusResp
+	120, // 152: superservice.Super.GetAIUsage:output_type -> superservice.GetAIUsageResp
+	122, // 153: superservice.Super.UpdateAIUsage:output_type -> superservice.UpdateAIUsageResp
+	125, // 154: superservice.Super.GetAIUsageHistory:output_type -> superservice.GetAIUsageHistoryResp
+	128, // 155: superservice.Super.GetAICredits:output_type -> superservice.GetAICreditsResp
+	131, // 156: superservice.Super.GetAICreditLedger:output_type -> superservice.GetAICreditLedgerResp
+	133, // 157: superservice.Super.AIRequest:output_type -> superservice.AIRequestResp
+	134, // 158: superservice.Super.AIRequestStream:output_type -> superservice.AIStreamChunk
+	96,  // [96:159] is the sub-list for method output_type
+	33,  // [33:96] is the sub-list for method input_type
+	33,  // [33:33] is the sub-list for extension type_name
+	33,  // [33:33] is the sub-list for extension extendee
+	0,   // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_superservice_proto_init() }
+func file_superservice_proto_init() {
+	if File_superservice_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_superservice_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTwoFactorLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuthProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOAuthProvidersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOAuthProvidersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartOAuthReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartOAuthResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuthLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkOAuthIdentityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkOAuthIdentityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserIdentitiesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserIdentitiesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkUserIdentityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkUserIdentityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTwoFactorStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTwoFactorStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupTwoFactorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupTwoFactorResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableTwoFactorResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTwoFactorResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetTwoFactorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetTwoFactorResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileUsersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletedUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletedUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeletedUsersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountDeletionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountDeletionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestAccountDeletionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAccountDeletionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAccountDeletionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartDataExportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDataExportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataExportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDataExportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataExportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTokenRevokedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTokenRevokedResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserPasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyEmailResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerificationEmailReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerificationEmailResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgotPasswordReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3453,8 +10369,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterReq); i {
+		file_superservice_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForgotPasswordResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3465,8 +10381,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResp); i {
+		file_superservice_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3477,8 +10393,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReq); i {
+		file_superservice_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3489,8 +10405,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResp); i {
+		file_superservice_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3501,8 +10417,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserInfoReq); i {
+		file_superservice_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3513,8 +10429,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserInfoResp); i {
+		file_superservice_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserVipReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3525,8 +10441,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserReq); i {
+		file_superservice_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserVipResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,8 +10453,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResp); i {
+		file_superservice_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3549,8 +10465,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserInfoReq); i {
+		file_superservice_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsersResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3561,8 +10477,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserInfoResp); i {
+		file_superservice_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3573,8 +10489,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserPasswordReq); i {
+		file_superservice_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserCountResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3585,8 +10501,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserPasswordResp); i {
+		file_superservice_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanFeatures); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3597,8 +10513,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserReq); i {
+		file_superservice_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VipPlan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3609,8 +10525,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserResp); i {
+		file_superservice_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVipPlanReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3621,8 +10537,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserVipReq); i {
+		file_superservice_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVipPlanResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3633,8 +10549,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserVipResp); i {
+		file_superservice_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVipPlanReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3645,8 +10561,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersReq); i {
+		file_superservice_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVipPlanResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3657,8 +10573,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsersResp); i {
+		file_superservice_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVipPlansReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3669,8 +10585,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserCountReq); i {
+		file_superservice_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVipPlansResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3681,8 +10597,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserCountResp); i {
+		file_superservice_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditPack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3693,8 +10609,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VipPlan); i {
+		file_superservice_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCreditPacksReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3705,8 +10621,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVipPlanReq); i {
+		file_superservice_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCreditPacksResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3717,8 +10633,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVipPlanResp); i {
+		file_superservice_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCreditPackReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3729,8 +10645,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVipPlanReq); i {
+		file_superservice_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCreditPackResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3741,8 +10657,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVipPlanResp); i {
+		file_superservice_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VipOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3753,8 +10669,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVipPlansReq); i {
+		file_superservice_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVipOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3765,8 +10681,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVipPlansResp); i {
+		file_superservice_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVipOrderResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3777,8 +10693,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VipOrder); i {
+		file_superservice_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayVipOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3789,8 +10705,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVipOrderReq); i {
+		file_superservice_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayVipOrderResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3801,8 +10717,8 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateVipOrderResp); i {
+		file_superservice_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelVipOrderReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3813,7 +10729,43 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelVipOrderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentNotifyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentNotifyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetVipOrdersReq); i {
 			case 0:
 				return &v.state
@@ -3825,7 +10777,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetVipOrdersResp); i {
 			case 0:
 				return &v.state
@@ -3837,7 +10789,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VipRecord); i {
 			case 0:
 				return &v.state
@@ -3849,7 +10801,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetVipRecordsReq); i {
 			case 0:
 				return &v.state
@@ -3861,7 +10813,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetVipRecordsResp); i {
 			case 0:
 				return &v.state
@@ -3873,7 +10825,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserActiveVipRecordReq); i {
 			case 0:
 				return &v.state
@@ -3885,7 +10837,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserActiveVipRecordResp); i {
 			case 0:
 				return &v.state
@@ -3897,7 +10849,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserVipStatusReq); i {
 			case 0:
 				return &v.state
@@ -3909,7 +10861,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserVipStatusResp); i {
 			case 0:
 				return &v.state
@@ -3921,7 +10873,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckUserVipReq); i {
 			case 0:
 				return &v.state
@@ -3933,7 +10885,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckUserVipResp); i {
 			case 0:
 				return &v.state
@@ -3945,7 +10897,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateAutoRenewReq); i {
 			case 0:
 				return &v.state
@@ -3957,7 +10909,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateAutoRenewResp); i {
 			case 0:
 				return &v.state
@@ -3969,7 +10921,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncUserVipStatusReq); i {
 			case 0:
 				return &v.state
@@ -3981,7 +10933,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SyncUserVipStatusResp); i {
 			case 0:
 				return &v.state
@@ -3993,7 +10945,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AIUsageData); i {
 			case 0:
 				return &v.state
@@ -4005,7 +10957,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAIUsageReq); i {
 			case 0:
 				return &v.state
@@ -4017,7 +10969,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetAIUsageResp); i {
 			case 0:
 				return &v.state
@@ -4029,7 +10981,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateAIUsageReq); i {
 			case 0:
 				return &v.state
@@ -4041,7 +10993,7 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
-		file_superservice_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_superservice_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateAIUsageResp); i {
 			case 0:
 				return &v.state
@@ -4053,6 +11005,150 @@ func file_superservice_proto_init() {
 				return nil
 			}
 		}
+		file_superservice_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AIUsageLedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAIUsageHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAIUsageHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AICreditGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAICreditsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAICreditsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AICreditLedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAICreditLedgerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAICreditLedgerResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AIRequestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AIRequestResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_superservice_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AIStreamChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4060,7 +11156,7 @@ func file_superservice_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_superservice_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   51,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
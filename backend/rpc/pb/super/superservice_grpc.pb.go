@@ -21,19 +21,54 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Super_Register_FullMethodName               = "/superservice.Super/Register"
 	Super_Login_FullMethodName                  = "/superservice.Super/Login"
+	Super_VerifyTwoFactorLogin_FullMethodName   = "/superservice.Super/VerifyTwoFactorLogin"
 	Super_GetUserInfo_FullMethodName            = "/superservice.Super/GetUserInfo"
 	Super_GetUser_FullMethodName                = "/superservice.Super/GetUser"
 	Super_UpdateUserInfo_FullMethodName         = "/superservice.Super/UpdateUserInfo"
 	Super_UpdateUserPassword_FullMethodName     = "/superservice.Super/UpdateUserPassword"
+	Super_VerifyEmail_FullMethodName            = "/superservice.Super/VerifyEmail"
+	Super_SendVerificationEmail_FullMethodName  = "/superservice.Super/SendVerificationEmail"
+	Super_ForgotPassword_FullMethodName         = "/superservice.Super/ForgotPassword"
+	Super_ResetPassword_FullMethodName          = "/superservice.Super/ResetPassword"
 	Super_DeleteUser_FullMethodName             = "/superservice.Super/DeleteUser"
 	Super_UpdateUserVip_FullMethodName          = "/superservice.Super/UpdateUserVip"
 	Super_GetUsers_FullMethodName               = "/superservice.Super/GetUsers"
 	Super_GetUserCount_FullMethodName           = "/superservice.Super/GetUserCount"
+	Super_GetAccountDeletion_FullMethodName     = "/superservice.Super/GetAccountDeletion"
+	Super_RequestAccountDeletion_FullMethodName = "/superservice.Super/RequestAccountDeletion"
+	Super_CancelAccountDeletion_FullMethodName  = "/superservice.Super/CancelAccountDeletion"
+	Super_StartDataExport_FullMethodName        = "/superservice.Super/StartDataExport"
+	Super_GetDataExport_FullMethodName          = "/superservice.Super/GetDataExport"
+	Super_DownloadDataExport_FullMethodName     = "/superservice.Super/DownloadDataExport"
+	Super_RefreshToken_FullMethodName           = "/superservice.Super/RefreshToken"
+	Super_Logout_FullMethodName                 = "/superservice.Super/Logout"
+	Super_CheckTokenRevoked_FullMethodName      = "/superservice.Super/CheckTokenRevoked"
+	Super_SetUserRole_FullMethodName            = "/superservice.Super/SetUserRole"
+	Super_UnlockUser_FullMethodName             = "/superservice.Super/UnlockUser"
+	Super_ResetTwoFactor_FullMethodName         = "/superservice.Super/ResetTwoFactor"
+	Super_ReconcileUsers_FullMethodName         = "/superservice.Super/ReconcileUsers"
+	Super_RestoreUser_FullMethodName            = "/superservice.Super/RestoreUser"
+	Super_GetDeletedUsers_FullMethodName        = "/superservice.Super/GetDeletedUsers"
+	Super_GetTwoFactorStatus_FullMethodName     = "/superservice.Super/GetTwoFactorStatus"
+	Super_SetupTwoFactor_FullMethodName         = "/superservice.Super/SetupTwoFactor"
+	Super_EnableTwoFactor_FullMethodName        = "/superservice.Super/EnableTwoFactor"
+	Super_DisableTwoFactor_FullMethodName       = "/superservice.Super/DisableTwoFactor"
+	Super_GetOAuthProviders_FullMethodName      = "/superservice.Super/GetOAuthProviders"
+	Super_StartOAuth_FullMethodName             = "/superservice.Super/StartOAuth"
+	Super_OAuthLogin_FullMethodName             = "/superservice.Super/OAuthLogin"
+	Super_LinkOAuthIdentity_FullMethodName      = "/superservice.Super/LinkOAuthIdentity"
+	Super_GetUserIdentities_FullMethodName      = "/superservice.Super/GetUserIdentities"
+	Super_UnlinkUserIdentity_FullMethodName     = "/superservice.Super/UnlinkUserIdentity"
 	Super_GetVipPlans_FullMethodName            = "/superservice.Super/GetVipPlans"
 	Super_GetVipPlan_FullMethodName             = "/superservice.Super/GetVipPlan"
 	Super_CreateVipPlan_FullMethodName          = "/superservice.Super/CreateVipPlan"
+	Super_GetCreditPacks_FullMethodName         = "/superservice.Super/GetCreditPacks"
+	Super_CreateCreditPack_FullMethodName       = "/superservice.Super/CreateCreditPack"
 	Super_CreateVipOrder_FullMethodName         = "/superservice.Super/CreateVipOrder"
 	Super_GetVipOrders_FullMethodName           = "/superservice.Super/GetVipOrders"
+	Super_PayVipOrder_FullMethodName            = "/superservice.Super/PayVipOrder"
+	Super_CancelVipOrder_FullMethodName         = "/superservice.Super/CancelVipOrder"
+	Super_PaymentNotify_FullMethodName          = "/superservice.Super/PaymentNotify"
 	Super_GetVipRecords_FullMethodName          = "/superservice.Super/GetVipRecords"
 	Super_GetUserActiveVipRecord_FullMethodName = "/superservice.Super/GetUserActiveVipRecord"
 	Super_GetUserVipStatus_FullMethodName       = "/superservice.Super/GetUserVipStatus"
@@ -42,6 +77,11 @@ const (
 	Super_SyncUserVipStatus_FullMethodName      = "/superservice.Super/SyncUserVipStatus"
 	Super_GetAIUsage_FullMethodName             = "/superservice.Super/GetAIUsage"
 	Super_UpdateAIUsage_FullMethodName          = "/superservice.Super/UpdateAIUsage"
+	Super_GetAIUsageHistory_FullMethodName      = "/superservice.Super/GetAIUsageHistory"
+	Super_GetAICredits_FullMethodName           = "/superservice.Super/GetAICredits"
+	Super_GetAICreditLedger_FullMethodName      = "/superservice.Super/GetAICreditLedger"
+	Super_AIRequest_FullMethodName              = "/superservice.Super/AIRequest"
+	Super_AIRequestStream_FullMethodName        = "/superservice.Super/AIRequestStream"
 )
 
 // SuperClient is the client API for Super service.
@@ -51,21 +91,61 @@ type SuperClient interface {
 	// 用户相关服务
 	Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	VerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoResp, error)
 	GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error)
 	UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*UpdateUserInfoResp, error)
 	UpdateUserPassword(ctx context.Context, in *UpdateUserPasswordReq, opts ...grpc.CallOption) (*UpdateUserPasswordResp, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*VerifyEmailResp, error)
+	SendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq, opts ...grpc.CallOption) (*SendVerificationEmailResp, error)
+	ForgotPassword(ctx context.Context, in *ForgotPasswordReq, opts ...grpc.CallOption) (*ForgotPasswordResp, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 	DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error)
 	UpdateUserVip(ctx context.Context, in *UpdateUserVipReq, opts ...grpc.CallOption) (*UpdateUserVipResp, error)
 	GetUsers(ctx context.Context, in *GetUsersReq, opts ...grpc.CallOption) (*GetUsersResp, error)
 	GetUserCount(ctx context.Context, in *GetUserCountReq, opts ...grpc.CallOption) (*GetUserCountResp, error)
+	GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error)
+	RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error)
+	StartDataExport(ctx context.Context, in *StartDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error)
+	GetDataExport(ctx context.Context, in *GetDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error)
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportReq, opts ...grpc.CallOption) (Super_DownloadDataExportClient, error)
+	// 认证相关服务
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error)
+	CheckTokenRevoked(ctx context.Context, in *CheckTokenRevokedReq, opts ...grpc.CallOption) (*CheckTokenRevokedResp, error)
+	// 权限管理服务
+	SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*SetUserRoleResp, error)
+	UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserResp, error)
+	ResetTwoFactor(ctx context.Context, in *ResetTwoFactorReq, opts ...grpc.CallOption) (*ResetTwoFactorResp, error)
+	ReconcileUsers(ctx context.Context, in *ReconcileUsersReq, opts ...grpc.CallOption) (*ReconcileUsersResp, error)
+	RestoreUser(ctx context.Context, in *RestoreUserReq, opts ...grpc.CallOption) (*RestoreUserResp, error)
+	GetDeletedUsers(ctx context.Context, in *GetDeletedUsersReq, opts ...grpc.CallOption) (*GetDeletedUsersResp, error)
+	// 两步验证相关服务
+	GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusReq, opts ...grpc.CallOption) (*GetTwoFactorStatusResp, error)
+	SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error)
+	EnableTwoFactor(ctx context.Context, in *EnableTwoFactorReq, opts ...grpc.CallOption) (*EnableTwoFactorResp, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error)
+	// 第三方登录相关服务
+	GetOAuthProviders(ctx context.Context, in *GetOAuthProvidersReq, opts ...grpc.CallOption) (*GetOAuthProvidersResp, error)
+	StartOAuth(ctx context.Context, in *StartOAuthReq, opts ...grpc.CallOption) (*StartOAuthResp, error)
+	OAuthLogin(ctx context.Context, in *OAuthLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityReq, opts ...grpc.CallOption) (*LinkOAuthIdentityResp, error)
+	GetUserIdentities(ctx context.Context, in *GetUserIdentitiesReq, opts ...grpc.CallOption) (*GetUserIdentitiesResp, error)
+	UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityReq, opts ...grpc.CallOption) (*UnlinkUserIdentityResp, error)
 	// VIP套餐相关服务
 	GetVipPlans(ctx context.Context, in *GetVipPlansReq, opts ...grpc.CallOption) (*GetVipPlansResp, error)
 	GetVipPlan(ctx context.Context, in *GetVipPlanReq, opts ...grpc.CallOption) (*GetVipPlanResp, error)
 	CreateVipPlan(ctx context.Context, in *CreateVipPlanReq, opts ...grpc.CallOption) (*CreateVipPlanResp, error)
+	// AI额度加油包相关服务
+	GetCreditPacks(ctx context.Context, in *GetCreditPacksReq, opts ...grpc.CallOption) (*GetCreditPacksResp, error)
+	CreateCreditPack(ctx context.Context, in *CreateCreditPackReq, opts ...grpc.CallOption) (*CreateCreditPackResp, error)
 	// VIP订单相关服务
 	CreateVipOrder(ctx context.Context, in *CreateVipOrderReq, opts ...grpc.CallOption) (*CreateVipOrderResp, error)
 	GetVipOrders(ctx context.Context, in *GetVipOrdersReq, opts ...grpc.CallOption) (*GetVipOrdersResp, error)
+	PayVipOrder(ctx context.Context, in *PayVipOrderReq, opts ...grpc.CallOption) (*PayVipOrderResp, error)
+	CancelVipOrder(ctx context.Context, in *CancelVipOrderReq, opts ...grpc.CallOption) (*CancelVipOrderResp, error)
+	PaymentNotify(ctx context.Context, in *PaymentNotifyReq, opts ...grpc.CallOption) (*PaymentNotifyResp, error)
 	// VIP记录相关服务
 	GetVipRecords(ctx context.Context, in *GetVipRecordsReq, opts ...grpc.CallOption) (*GetVipRecordsResp, error)
 	GetUserActiveVipRecord(ctx context.Context, in *GetUserActiveVipRecordReq, opts ...grpc.CallOption) (*GetUserActiveVipRecordResp, error)
@@ -77,6 +157,12 @@ type SuperClient interface {
 	// AI使用量相关服务
 	GetAIUsage(ctx context.Context, in *GetAIUsageReq, opts ...grpc.CallOption) (*GetAIUsageResp, error)
 	UpdateAIUsage(ctx context.Context, in *UpdateAIUsageReq, opts ...grpc.CallOption) (*UpdateAIUsageResp, error)
+	GetAIUsageHistory(ctx context.Context, in *GetAIUsageHistoryReq, opts ...grpc.CallOption) (*GetAIUsageHistoryResp, error)
+	GetAICredits(ctx context.Context, in *GetAICreditsReq, opts ...grpc.CallOption) (*GetAICreditsResp, error)
+	GetAICreditLedger(ctx context.Context, in *GetAICreditLedgerReq, opts ...grpc.CallOption) (*GetAICreditLedgerResp, error)
+	// AI请求相关服务
+	AIRequest(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (*AIRequestResp, error)
+	AIRequestStream(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (Super_AIRequestStreamClient, error)
 }
 
 type superClient struct {
@@ -105,6 +191,15 @@ func (c *superClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *superClient) VerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Super_VerifyTwoFactorLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoResp, error) {
 	out := new(GetUserInfoResp)
 	err := c.cc.Invoke(ctx, Super_GetUserInfo_FullMethodName, in, out, opts...)
@@ -141,6 +236,42 @@ func (c *superClient) UpdateUserPassword(ctx context.Context, in *UpdateUserPass
 	return out, nil
 }
 
+func (c *superClient) VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*VerifyEmailResp, error) {
+	out := new(VerifyEmailResp)
+	err := c.cc.Invoke(ctx, Super_VerifyEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq, opts ...grpc.CallOption) (*SendVerificationEmailResp, error) {
+	out := new(SendVerificationEmailResp)
+	err := c.cc.Invoke(ctx, Super_SendVerificationEmail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ForgotPassword(ctx context.Context, in *ForgotPasswordReq, opts ...grpc.CallOption) (*ForgotPasswordResp, error) {
+	out := new(ForgotPasswordResp)
+	err := c.cc.Invoke(ctx, Super_ForgotPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
+	out := new(ResetPasswordResp)
+	err := c.cc.Invoke(ctx, Super_ResetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error) {
 	out := new(DeleteUserResp)
 	err := c.cc.Invoke(ctx, Super_DeleteUser_FullMethodName, in, out, opts...)
@@ -177,6 +308,254 @@ func (c *superClient) GetUserCount(ctx context.Context, in *GetUserCountReq, opt
 	return out, nil
 }
 
+func (c *superClient) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error) {
+	out := new(AccountDeletionResp)
+	err := c.cc.Invoke(ctx, Super_GetAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error) {
+	out := new(AccountDeletionResp)
+	err := c.cc.Invoke(ctx, Super_RequestAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error) {
+	out := new(CancelAccountDeletionResp)
+	err := c.cc.Invoke(ctx, Super_CancelAccountDeletion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) StartDataExport(ctx context.Context, in *StartDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error) {
+	out := new(DataExportResp)
+	err := c.cc.Invoke(ctx, Super_StartDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetDataExport(ctx context.Context, in *GetDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error) {
+	out := new(DataExportResp)
+	err := c.cc.Invoke(ctx, Super_GetDataExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportReq, opts ...grpc.CallOption) (Super_DownloadDataExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Super_ServiceDesc.Streams[0], Super_DownloadDataExport_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &superDownloadDataExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Super_DownloadDataExportClient interface {
+	Recv() (*DataExportChunk, error)
+	grpc.ClientStream
+}
+
+type superDownloadDataExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *superDownloadDataExportClient) Recv() (*DataExportChunk, error) {
+	m := new(DataExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *superClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, Super_RefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error) {
+	out := new(LogoutResp)
+	err := c.cc.Invoke(ctx, Super_Logout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) CheckTokenRevoked(ctx context.Context, in *CheckTokenRevokedReq, opts ...grpc.CallOption) (*CheckTokenRevokedResp, error) {
+	out := new(CheckTokenRevokedResp)
+	err := c.cc.Invoke(ctx, Super_CheckTokenRevoked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*SetUserRoleResp, error) {
+	out := new(SetUserRoleResp)
+	err := c.cc.Invoke(ctx, Super_SetUserRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserResp, error) {
+	out := new(UnlockUserResp)
+	err := c.cc.Invoke(ctx, Super_UnlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ResetTwoFactor(ctx context.Context, in *ResetTwoFactorReq, opts ...grpc.CallOption) (*ResetTwoFactorResp, error) {
+	out := new(ResetTwoFactorResp)
+	err := c.cc.Invoke(ctx, Super_ResetTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ReconcileUsers(ctx context.Context, in *ReconcileUsersReq, opts ...grpc.CallOption) (*ReconcileUsersResp, error) {
+	out := new(ReconcileUsersResp)
+	err := c.cc.Invoke(ctx, Super_ReconcileUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RestoreUser(ctx context.Context, in *RestoreUserReq, opts ...grpc.CallOption) (*RestoreUserResp, error) {
+	out := new(RestoreUserResp)
+	err := c.cc.Invoke(ctx, Super_RestoreUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetDeletedUsers(ctx context.Context, in *GetDeletedUsersReq, opts ...grpc.CallOption) (*GetDeletedUsersResp, error) {
+	out := new(GetDeletedUsersResp)
+	err := c.cc.Invoke(ctx, Super_GetDeletedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusReq, opts ...grpc.CallOption) (*GetTwoFactorStatusResp, error) {
+	out := new(GetTwoFactorStatusResp)
+	err := c.cc.Invoke(ctx, Super_GetTwoFactorStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error) {
+	out := new(SetupTwoFactorResp)
+	err := c.cc.Invoke(ctx, Super_SetupTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) EnableTwoFactor(ctx context.Context, in *EnableTwoFactorReq, opts ...grpc.CallOption) (*EnableTwoFactorResp, error) {
+	out := new(EnableTwoFactorResp)
+	err := c.cc.Invoke(ctx, Super_EnableTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error) {
+	out := new(DisableTwoFactorResp)
+	err := c.cc.Invoke(ctx, Super_DisableTwoFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetOAuthProviders(ctx context.Context, in *GetOAuthProvidersReq, opts ...grpc.CallOption) (*GetOAuthProvidersResp, error) {
+	out := new(GetOAuthProvidersResp)
+	err := c.cc.Invoke(ctx, Super_GetOAuthProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) StartOAuth(ctx context.Context, in *StartOAuthReq, opts ...grpc.CallOption) (*StartOAuthResp, error) {
+	out := new(StartOAuthResp)
+	err := c.cc.Invoke(ctx, Super_StartOAuth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) OAuthLogin(ctx context.Context, in *OAuthLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Super_OAuthLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityReq, opts ...grpc.CallOption) (*LinkOAuthIdentityResp, error) {
+	out := new(LinkOAuthIdentityResp)
+	err := c.cc.Invoke(ctx, Super_LinkOAuthIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetUserIdentities(ctx context.Context, in *GetUserIdentitiesReq, opts ...grpc.CallOption) (*GetUserIdentitiesResp, error) {
+	out := new(GetUserIdentitiesResp)
+	err := c.cc.Invoke(ctx, Super_GetUserIdentities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityReq, opts ...grpc.CallOption) (*UnlinkUserIdentityResp, error) {
+	out := new(UnlinkUserIdentityResp)
+	err := c.cc.Invoke(ctx, Super_UnlinkUserIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) GetVipPlans(ctx context.Context, in *GetVipPlansReq, opts ...grpc.CallOption) (*GetVipPlansResp, error) {
 	out := new(GetVipPlansResp)
 	err := c.cc.Invoke(ctx, Super_GetVipPlans_FullMethodName, in, out, opts...)
@@ -204,6 +583,24 @@ func (c *superClient) CreateVipPlan(ctx context.Context, in *CreateVipPlanReq, o
 	return out, nil
 }
 
+func (c *superClient) GetCreditPacks(ctx context.Context, in *GetCreditPacksReq, opts ...grpc.CallOption) (*GetCreditPacksResp, error) {
+	out := new(GetCreditPacksResp)
+	err := c.cc.Invoke(ctx, Super_GetCreditPacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) CreateCreditPack(ctx context.Context, in *CreateCreditPackReq, opts ...grpc.CallOption) (*CreateCreditPackResp, error) {
+	out := new(CreateCreditPackResp)
+	err := c.cc.Invoke(ctx, Super_CreateCreditPack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) CreateVipOrder(ctx context.Context, in *CreateVipOrderReq, opts ...grpc.CallOption) (*CreateVipOrderResp, error) {
 	out := new(CreateVipOrderResp)
 	err := c.cc.Invoke(ctx, Super_CreateVipOrder_FullMethodName, in, out, opts...)
@@ -222,6 +619,33 @@ func (c *superClient) GetVipOrders(ctx context.Context, in *GetVipOrdersReq, opt
 	return out, nil
 }
 
+func (c *superClient) PayVipOrder(ctx context.Context, in *PayVipOrderReq, opts ...grpc.CallOption) (*PayVipOrderResp, error) {
+	out := new(PayVipOrderResp)
+	err := c.cc.Invoke(ctx, Super_PayVipOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) CancelVipOrder(ctx context.Context, in *CancelVipOrderReq, opts ...grpc.CallOption) (*CancelVipOrderResp, error) {
+	out := new(CancelVipOrderResp)
+	err := c.cc.Invoke(ctx, Super_CancelVipOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) PaymentNotify(ctx context.Context, in *PaymentNotifyReq, opts ...grpc.CallOption) (*PaymentNotifyResp, error) {
+	out := new(PaymentNotifyResp)
+	err := c.cc.Invoke(ctx, Super_PaymentNotify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) GetVipRecords(ctx context.Context, in *GetVipRecordsReq, opts ...grpc.CallOption) (*GetVipRecordsResp, error) {
 	out := new(GetVipRecordsResp)
 	err := c.cc.Invoke(ctx, Super_GetVipRecords_FullMethodName, in, out, opts...)
@@ -294,39 +718,153 @@ func (c *superClient) UpdateAIUsage(ctx context.Context, in *UpdateAIUsageReq, o
 	return out, nil
 }
 
-// SuperServer is the server API for Super service.
-// All implementations must embed UnimplementedSuperServer
-// for forward compatibility
-type SuperServer interface {
-	// 用户相关服务
-	Register(context.Context, *RegisterReq) (*RegisterResp, error)
-	Login(context.Context, *LoginReq) (*LoginResp, error)
-	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error)
-	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
-	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
-	UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error)
-	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
-	UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error)
-	GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error)
-	GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error)
-	// VIP套餐相关服务
-	GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error)
-	GetVipPlan(context.Context, *GetVipPlanReq) (*GetVipPlanResp, error)
-	CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error)
-	// VIP订单相关服务
-	CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error)
-	GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error)
-	// VIP记录相关服务
-	GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error)
-	GetUserActiveVipRecord(context.Context, *GetUserActiveVipRecordReq) (*GetUserActiveVipRecordResp, error)
-	// VIP状态相关服务
-	GetUserVipStatus(context.Context, *GetUserVipStatusReq) (*GetUserVipStatusResp, error)
-	CheckUserVip(context.Context, *CheckUserVipReq) (*CheckUserVipResp, error)
-	UpdateAutoRenew(context.Context, *UpdateAutoRenewReq) (*UpdateAutoRenewResp, error)
-	SyncUserVipStatus(context.Context, *SyncUserVipStatusReq) (*SyncUserVipStatusResp, error)
-	// AI使用量相关服务
-	GetAIUsage(context.Context, *GetAIUsageReq) (*GetAIUsageResp, error)
-	UpdateAIUsage(context.Context, *UpdateAIUsageReq) (*UpdateAIUsageResp, error)
+func (c *superClient) GetAIUsageHistory(ctx context.Context, in *GetAIUsageHistoryReq, opts ...grpc.CallOption) (*GetAIUsageHistoryResp, error) {
+	out := new(GetAIUsageHistoryResp)
+	err := c.cc.Invoke(ctx, Super_GetAIUsageHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetAICredits(ctx context.Context, in *GetAICreditsReq, opts ...grpc.CallOption) (*GetAICreditsResp, error) {
+	out := new(GetAICreditsResp)
+	err := c.cc.Invoke(ctx, Super_GetAICredits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetAICreditLedger(ctx context.Context, in *GetAICreditLedgerReq, opts ...grpc.CallOption) (*GetAICreditLedgerResp, error) {
+	out := new(GetAICreditLedgerResp)
+	err := c.cc.Invoke(ctx, Super_GetAICreditLedger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) AIRequest(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (*AIRequestResp, error) {
+	out := new(AIRequestResp)
+	err := c.cc.Invoke(ctx, Super_AIRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) AIRequestStream(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (Super_AIRequestStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Super_ServiceDesc.Streams[1], Super_AIRequestStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &superAIRequestStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Super_AIRequestStreamClient interface {
+	Recv() (*AIStreamChunk, error)
+	grpc.ClientStream
+}
+
+type superAIRequestStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *superAIRequestStreamClient) Recv() (*AIStreamChunk, error) {
+	m := new(AIStreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SuperServer is the server API for Super service.
+// All implementations must embed UnimplementedSuperServer
+// for forward compatibility
+type SuperServer interface {
+	// 用户相关服务
+	Register(context.Context, *RegisterReq) (*RegisterResp, error)
+	Login(context.Context, *LoginReq) (*LoginResp, error)
+	VerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginReq) (*LoginResp, error)
+	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error)
+	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
+	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
+	UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error)
+	VerifyEmail(context.Context, *VerifyEmailReq) (*VerifyEmailResp, error)
+	SendVerificationEmail(context.Context, *SendVerificationEmailReq) (*SendVerificationEmailResp, error)
+	ForgotPassword(context.Context, *ForgotPasswordReq) (*ForgotPasswordResp, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
+	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
+	UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error)
+	GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error)
+	GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error)
+	GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*AccountDeletionResp, error)
+	RequestAccountDeletion(context.Context, *RequestAccountDeletionReq) (*AccountDeletionResp, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error)
+	StartDataExport(context.Context, *StartDataExportReq) (*DataExportResp, error)
+	GetDataExport(context.Context, *GetDataExportReq) (*DataExportResp, error)
+	DownloadDataExport(*DownloadDataExportReq, Super_DownloadDataExportServer) error
+	// 认证相关服务
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	Logout(context.Context, *LogoutReq) (*LogoutResp, error)
+	CheckTokenRevoked(context.Context, *CheckTokenRevokedReq) (*CheckTokenRevokedResp, error)
+	// 权限管理服务
+	SetUserRole(context.Context, *SetUserRoleReq) (*SetUserRoleResp, error)
+	UnlockUser(context.Context, *UnlockUserReq) (*UnlockUserResp, error)
+	ResetTwoFactor(context.Context, *ResetTwoFactorReq) (*ResetTwoFactorResp, error)
+	ReconcileUsers(context.Context, *ReconcileUsersReq) (*ReconcileUsersResp, error)
+	RestoreUser(context.Context, *RestoreUserReq) (*RestoreUserResp, error)
+	GetDeletedUsers(context.Context, *GetDeletedUsersReq) (*GetDeletedUsersResp, error)
+	// 两步验证相关服务
+	GetTwoFactorStatus(context.Context, *GetTwoFactorStatusReq) (*GetTwoFactorStatusResp, error)
+	SetupTwoFactor(context.Context, *SetupTwoFactorReq) (*SetupTwoFactorResp, error)
+	EnableTwoFactor(context.Context, *EnableTwoFactorReq) (*EnableTwoFactorResp, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorResp, error)
+	// 第三方登录相关服务
+	GetOAuthProviders(context.Context, *GetOAuthProvidersReq) (*GetOAuthProvidersResp, error)
+	StartOAuth(context.Context, *StartOAuthReq) (*StartOAuthResp, error)
+	OAuthLogin(context.Context, *OAuthLoginReq) (*LoginResp, error)
+	LinkOAuthIdentity(context.Context, *LinkOAuthIdentityReq) (*LinkOAuthIdentityResp, error)
+	GetUserIdentities(context.Context, *GetUserIdentitiesReq) (*GetUserIdentitiesResp, error)
+	UnlinkUserIdentity(context.Context, *UnlinkUserIdentityReq) (*UnlinkUserIdentityResp, error)
+	// VIP套餐相关服务
+	GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error)
+	GetVipPlan(context.Context, *GetVipPlanReq) (*GetVipPlanResp, error)
+	CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error)
+	// AI额度加油包相关服务
+	GetCreditPacks(context.Context, *GetCreditPacksReq) (*GetCreditPacksResp, error)
+	CreateCreditPack(context.Context, *CreateCreditPackReq) (*CreateCreditPackResp, error)
+	// VIP订单相关服务
+	CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error)
+	GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error)
+	PayVipOrder(context.Context, *PayVipOrderReq) (*PayVipOrderResp, error)
+	CancelVipOrder(context.Context, *CancelVipOrderReq) (*CancelVipOrderResp, error)
+	PaymentNotify(context.Context, *PaymentNotifyReq) (*PaymentNotifyResp, error)
+	// VIP记录相关服务
+	GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error)
+	GetUserActiveVipRecord(context.Context, *GetUserActiveVipRecordReq) (*GetUserActiveVipRecordResp, error)
+	// VIP状态相关服务
+	GetUserVipStatus(context.Context, *GetUserVipStatusReq) (*GetUserVipStatusResp, error)
+	CheckUserVip(context.Context, *CheckUserVipReq) (*CheckUserVipResp, error)
+	UpdateAutoRenew(context.Context, *UpdateAutoRenewReq) (*UpdateAutoRenewResp, error)
+	SyncUserVipStatus(context.Context, *SyncUserVipStatusReq) (*SyncUserVipStatusResp, error)
+	// AI使用量相关服务
+	GetAIUsage(context.Context, *GetAIUsageReq) (*GetAIUsageResp, error)
+	UpdateAIUsage(context.Context, *UpdateAIUsageReq) (*UpdateAIUsageResp, error)
+	GetAIUsageHistory(context.Context, *GetAIUsageHistoryReq) (*GetAIUsageHistoryResp, error)
+	GetAICredits(context.Context, *GetAICreditsReq) (*GetAICreditsResp, error)
+	GetAICreditLedger(context.Context, *GetAICreditLedgerReq) (*GetAICreditLedgerResp, error)
+	// AI请求相关服务
+	AIRequest(context.Context, *AIRequestReq) (*AIRequestResp, error)
+	AIRequestStream(*AIRequestReq, Super_AIRequestStreamServer) error
 	mustEmbedUnimplementedSuperServer()
 }
 
@@ -340,6 +878,9 @@ func (UnimplementedSuperServer) Register(context.Context, *RegisterReq) (*Regist
 func (UnimplementedSuperServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedSuperServer) VerifyTwoFactorLogin(context.Context, *VerifyTwoFactorLoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTwoFactorLogin not implemented")
+}
 func (UnimplementedSuperServer) GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
 }
@@ -352,6 +893,18 @@ func (UnimplementedSuperServer) UpdateUserInfo(context.Context, *UpdateUserInfoR
 func (UnimplementedSuperServer) UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
 }
+func (UnimplementedSuperServer) VerifyEmail(context.Context, *VerifyEmailReq) (*VerifyEmailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedSuperServer) SendVerificationEmail(context.Context, *SendVerificationEmailReq) (*SendVerificationEmailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationEmail not implemented")
+}
+func (UnimplementedSuperServer) ForgotPassword(context.Context, *ForgotPasswordReq) (*ForgotPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgotPassword not implemented")
+}
+func (UnimplementedSuperServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
 func (UnimplementedSuperServer) DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
@@ -364,6 +917,81 @@ func (UnimplementedSuperServer) GetUsers(context.Context, *GetUsersReq) (*GetUse
 func (UnimplementedSuperServer) GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserCount not implemented")
 }
+func (UnimplementedSuperServer) GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*AccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDeletion not implemented")
+}
+func (UnimplementedSuperServer) RequestAccountDeletion(context.Context, *RequestAccountDeletionReq) (*AccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (UnimplementedSuperServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedSuperServer) StartDataExport(context.Context, *StartDataExportReq) (*DataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDataExport not implemented")
+}
+func (UnimplementedSuperServer) GetDataExport(context.Context, *GetDataExportReq) (*DataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedSuperServer) DownloadDataExport(*DownloadDataExportReq, Super_DownloadDataExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
+func (UnimplementedSuperServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedSuperServer) Logout(context.Context, *LogoutReq) (*LogoutResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedSuperServer) CheckTokenRevoked(context.Context, *CheckTokenRevokedReq) (*CheckTokenRevokedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTokenRevoked not implemented")
+}
+func (UnimplementedSuperServer) SetUserRole(context.Context, *SetUserRoleReq) (*SetUserRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedSuperServer) UnlockUser(context.Context, *UnlockUserReq) (*UnlockUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedSuperServer) ResetTwoFactor(context.Context, *ResetTwoFactorReq) (*ResetTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTwoFactor not implemented")
+}
+func (UnimplementedSuperServer) ReconcileUsers(context.Context, *ReconcileUsersReq) (*ReconcileUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileUsers not implemented")
+}
+func (UnimplementedSuperServer) RestoreUser(context.Context, *RestoreUserReq) (*RestoreUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedSuperServer) GetDeletedUsers(context.Context, *GetDeletedUsersReq) (*GetDeletedUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedUsers not implemented")
+}
+func (UnimplementedSuperServer) GetTwoFactorStatus(context.Context, *GetTwoFactorStatusReq) (*GetTwoFactorStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwoFactorStatus not implemented")
+}
+func (UnimplementedSuperServer) SetupTwoFactor(context.Context, *SetupTwoFactorReq) (*SetupTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupTwoFactor not implemented")
+}
+func (UnimplementedSuperServer) EnableTwoFactor(context.Context, *EnableTwoFactorReq) (*EnableTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTwoFactor not implemented")
+}
+func (UnimplementedSuperServer) DisableTwoFactor(context.Context, *DisableTwoFactorReq) (*DisableTwoFactorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedSuperServer) GetOAuthProviders(context.Context, *GetOAuthProvidersReq) (*GetOAuthProvidersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOAuthProviders not implemented")
+}
+func (UnimplementedSuperServer) StartOAuth(context.Context, *StartOAuthReq) (*StartOAuthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOAuth not implemented")
+}
+func (UnimplementedSuperServer) OAuthLogin(context.Context, *OAuthLoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OAuthLogin not implemented")
+}
+func (UnimplementedSuperServer) LinkOAuthIdentity(context.Context, *LinkOAuthIdentityReq) (*LinkOAuthIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOAuthIdentity not implemented")
+}
+func (UnimplementedSuperServer) GetUserIdentities(context.Context, *GetUserIdentitiesReq) (*GetUserIdentitiesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserIdentities not implemented")
+}
+func (UnimplementedSuperServer) UnlinkUserIdentity(context.Context, *UnlinkUserIdentityReq) (*UnlinkUserIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkUserIdentity not implemented")
+}
 func (UnimplementedSuperServer) GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVipPlans not implemented")
 }
@@ -373,12 +1001,27 @@ func (UnimplementedSuperServer) GetVipPlan(context.Context, *GetVipPlanReq) (*Ge
 func (UnimplementedSuperServer) CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVipPlan not implemented")
 }
+func (UnimplementedSuperServer) GetCreditPacks(context.Context, *GetCreditPacksReq) (*GetCreditPacksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditPacks not implemented")
+}
+func (UnimplementedSuperServer) CreateCreditPack(context.Context, *CreateCreditPackReq) (*CreateCreditPackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCreditPack not implemented")
+}
 func (UnimplementedSuperServer) CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateVipOrder not implemented")
 }
 func (UnimplementedSuperServer) GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVipOrders not implemented")
 }
+func (UnimplementedSuperServer) PayVipOrder(context.Context, *PayVipOrderReq) (*PayVipOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayVipOrder not implemented")
+}
+func (UnimplementedSuperServer) CancelVipOrder(context.Context, *CancelVipOrderReq) (*CancelVipOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelVipOrder not implemented")
+}
+func (UnimplementedSuperServer) PaymentNotify(context.Context, *PaymentNotifyReq) (*PaymentNotifyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentNotify not implemented")
+}
 func (UnimplementedSuperServer) GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVipRecords not implemented")
 }
@@ -403,6 +1046,21 @@ func (UnimplementedSuperServer) GetAIUsage(context.Context, *GetAIUsageReq) (*Ge
 func (UnimplementedSuperServer) UpdateAIUsage(context.Context, *UpdateAIUsageReq) (*UpdateAIUsageResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAIUsage not implemented")
 }
+func (UnimplementedSuperServer) GetAIUsageHistory(context.Context, *GetAIUsageHistoryReq) (*GetAIUsageHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAIUsageHistory not implemented")
+}
+func (UnimplementedSuperServer) GetAICredits(context.Context, *GetAICreditsReq) (*GetAICreditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAICredits not implemented")
+}
+func (UnimplementedSuperServer) GetAICreditLedger(context.Context, *GetAICreditLedgerReq) (*GetAICreditLedgerResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAICreditLedger not implemented")
+}
+func (UnimplementedSuperServer) AIRequest(context.Context, *AIRequestReq) (*AIRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AIRequest not implemented")
+}
+func (UnimplementedSuperServer) AIRequestStream(*AIRequestReq, Super_AIRequestStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AIRequestStream not implemented")
+}
 func (UnimplementedSuperServer) mustEmbedUnimplementedSuperServer() {}
 
 // UnsafeSuperServer may be embedded to opt out of forward compatibility for this service.
@@ -422,176 +1080,719 @@ func _Super_Register_Handler(srv interface{}, ctx context.Context, dec func(inte
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).Register(ctx, in)
+		return srv.(SuperServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).Register(ctx, req.(*RegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_VerifyTwoFactorLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTwoFactorLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).VerifyTwoFactorLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_VerifyTwoFactorLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).VerifyTwoFactorLogin(ctx, req.(*VerifyTwoFactorLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserInfo(ctx, req.(*GetUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUser(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserPassword(ctx, req.(*UpdateUserPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).VerifyEmail(ctx, req.(*VerifyEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_SendVerificationEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).SendVerificationEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_SendVerificationEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).SendVerificationEmail(ctx, req.(*SendVerificationEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ForgotPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ForgotPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ForgotPassword(ctx, req.(*ForgotPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).DeleteUser(ctx, req.(*DeleteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpdateUserVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserVipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserVip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserVip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserVip(ctx, req.(*UpdateUserVipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUsers(ctx, req.(*GetUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserCount(ctx, req.(*GetUserCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetAccountDeletion(ctx, req.(*GetAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RequestAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RequestAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RequestAccountDeletion(ctx, req.(*RequestAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_StartDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDataExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).StartDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_StartDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).StartDataExport(ctx, req.(*StartDataExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetDataExport(ctx, req.(*GetDataExportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDataExportReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SuperServer).DownloadDataExport(m, &superDownloadDataExportServer{stream})
+}
+
+type Super_DownloadDataExportServer interface {
+	Send(*DataExportChunk) error
+	grpc.ServerStream
+}
+
+type superDownloadDataExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *superDownloadDataExportServer) Send(m *DataExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Super_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).Logout(ctx, req.(*LogoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CheckTokenRevoked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTokenRevokedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CheckTokenRevoked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CheckTokenRevoked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CheckTokenRevoked(ctx, req.(*CheckTokenRevokedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_SetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).SetUserRole(ctx, req.(*SetUserRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UnlockUser(ctx, req.(*UnlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ResetTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTwoFactorReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ResetTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ResetTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ResetTwoFactor(ctx, req.(*ResetTwoFactorReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ReconcileUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ReconcileUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ReconcileUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ReconcileUsers(ctx, req.(*ReconcileUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RestoreUser(ctx, req.(*RestoreUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetDeletedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetDeletedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetDeletedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetDeletedUsers(ctx, req.(*GetDeletedUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetTwoFactorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTwoFactorStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetTwoFactorStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_Register_FullMethodName,
+		FullMethod: Super_GetTwoFactorStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).Register(ctx, req.(*RegisterReq))
+		return srv.(SuperServer).GetTwoFactorStatus(ctx, req.(*GetTwoFactorStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Super_SetupTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).Login(ctx, in)
+		return srv.(SuperServer).SetupTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_Login_FullMethodName,
+		FullMethod: Super_SetupTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).Login(ctx, req.(*LoginReq))
+		return srv.(SuperServer).SetupTwoFactor(ctx, req.(*SetupTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserInfoReq)
+func _Super_EnableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserInfo(ctx, in)
+		return srv.(SuperServer).EnableTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserInfo_FullMethodName,
+		FullMethod: Super_EnableTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserInfo(ctx, req.(*GetUserInfoReq))
+		return srv.(SuperServer).EnableTwoFactor(ctx, req.(*EnableTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserReq)
+func _Super_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUser(ctx, in)
+		return srv.(SuperServer).DisableTwoFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUser_FullMethodName,
+		FullMethod: Super_DisableTwoFactor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUser(ctx, req.(*GetUserReq))
+		return srv.(SuperServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserInfoReq)
+func _Super_GetOAuthProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOAuthProvidersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateUserInfo(ctx, in)
+		return srv.(SuperServer).GetOAuthProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateUserInfo_FullMethodName,
+		FullMethod: Super_GetOAuthProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+		return srv.(SuperServer).GetOAuthProviders(ctx, req.(*GetOAuthProvidersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserPasswordReq)
+func _Super_StartOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateUserPassword(ctx, in)
+		return srv.(SuperServer).StartOAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateUserPassword_FullMethodName,
+		FullMethod: Super_StartOAuth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateUserPassword(ctx, req.(*UpdateUserPasswordReq))
+		return srv.(SuperServer).StartOAuth(ctx, req.(*StartOAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserReq)
+func _Super_OAuthLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OAuthLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).DeleteUser(ctx, in)
+		return srv.(SuperServer).OAuthLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_DeleteUser_FullMethodName,
+		FullMethod: Super_OAuthLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).DeleteUser(ctx, req.(*DeleteUserReq))
+		return srv.(SuperServer).OAuthLogin(ctx, req.(*OAuthLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateUserVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserVipReq)
+func _Super_LinkOAuthIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkOAuthIdentityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateUserVip(ctx, in)
+		return srv.(SuperServer).LinkOAuthIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateUserVip_FullMethodName,
+		FullMethod: Super_LinkOAuthIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateUserVip(ctx, req.(*UpdateUserVipReq))
+		return srv.(SuperServer).LinkOAuthIdentity(ctx, req.(*LinkOAuthIdentityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUsersReq)
+func _Super_GetUserIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserIdentitiesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUsers(ctx, in)
+		return srv.(SuperServer).GetUserIdentities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUsers_FullMethodName,
+		FullMethod: Super_GetUserIdentities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUsers(ctx, req.(*GetUsersReq))
+		return srv.(SuperServer).GetUserIdentities(ctx, req.(*GetUserIdentitiesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserCountReq)
+func _Super_UnlinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserIdentityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserCount(ctx, in)
+		return srv.(SuperServer).UnlinkUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserCount_FullMethodName,
+		FullMethod: Super_UnlinkUserIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserCount(ctx, req.(*GetUserCountReq))
+		return srv.(SuperServer).UnlinkUserIdentity(ctx, req.(*UnlinkUserIdentityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -650,6 +1851,42 @@ func _Super_CreateVipPlan_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Super_GetCreditPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditPacksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetCreditPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetCreditPacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetCreditPacks(ctx, req.(*GetCreditPacksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CreateCreditPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCreditPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CreateCreditPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CreateCreditPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CreateCreditPack(ctx, req.(*CreateCreditPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Super_CreateVipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVipOrderReq)
 	if err := dec(in); err != nil {
@@ -686,6 +1923,60 @@ func _Super_GetVipOrders_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Super_PayVipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayVipOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).PayVipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_PayVipOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).PayVipOrder(ctx, req.(*PayVipOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CancelVipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelVipOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CancelVipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CancelVipOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CancelVipOrder(ctx, req.(*CancelVipOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_PaymentNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentNotifyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).PaymentNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_PaymentNotify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).PaymentNotify(ctx, req.(*PaymentNotifyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Super_GetVipRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVipRecordsReq)
 	if err := dec(in); err != nil {
@@ -830,6 +2121,99 @@ func _Super_UpdateAIUsage_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Super_GetAIUsageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAIUsageHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetAIUsageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetAIUsageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetAIUsageHistory(ctx, req.(*GetAIUsageHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetAICredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAICreditsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetAICredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetAICredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetAICredits(ctx, req.(*GetAICreditsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetAICreditLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAICreditLedgerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetAICreditLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetAICreditLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetAICreditLedger(ctx, req.(*GetAICreditLedgerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_AIRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AIRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).AIRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_AIRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).AIRequest(ctx, req.(*AIRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_AIRequestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AIRequestReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SuperServer).AIRequestStream(m, &superAIRequestStreamServer{stream})
+}
+
+type Super_AIRequestStreamServer interface {
+	Send(*AIStreamChunk) error
+	grpc.ServerStream
+}
+
+type superAIRequestStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *superAIRequestStreamServer) Send(m *AIStreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Super_ServiceDesc is the grpc.ServiceDesc for Super service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -845,6 +2229,10 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Super_Login_Handler,
 		},
+		{
+			MethodName: "VerifyTwoFactorLogin",
+			Handler:    _Super_VerifyTwoFactorLogin_Handler,
+		},
 		{
 			MethodName: "GetUserInfo",
 			Handler:    _Super_GetUserInfo_Handler,
@@ -861,6 +2249,22 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserPassword",
 			Handler:    _Super_UpdateUserPassword_Handler,
 		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Super_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "SendVerificationEmail",
+			Handler:    _Super_SendVerificationEmail_Handler,
+		},
+		{
+			MethodName: "ForgotPassword",
+			Handler:    _Super_ForgotPassword_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Super_ResetPassword_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _Super_DeleteUser_Handler,
@@ -877,6 +2281,102 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserCount",
 			Handler:    _Super_GetUserCount_Handler,
 		},
+		{
+			MethodName: "GetAccountDeletion",
+			Handler:    _Super_GetAccountDeletion_Handler,
+		},
+		{
+			MethodName: "RequestAccountDeletion",
+			Handler:    _Super_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _Super_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "StartDataExport",
+			Handler:    _Super_StartDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _Super_GetDataExport_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Super_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Super_Logout_Handler,
+		},
+		{
+			MethodName: "CheckTokenRevoked",
+			Handler:    _Super_CheckTokenRevoked_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _Super_SetUserRole_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _Super_UnlockUser_Handler,
+		},
+		{
+			MethodName: "ResetTwoFactor",
+			Handler:    _Super_ResetTwoFactor_Handler,
+		},
+		{
+			MethodName: "ReconcileUsers",
+			Handler:    _Super_ReconcileUsers_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _Super_RestoreUser_Handler,
+		},
+		{
+			MethodName: "GetDeletedUsers",
+			Handler:    _Super_GetDeletedUsers_Handler,
+		},
+		{
+			MethodName: "GetTwoFactorStatus",
+			Handler:    _Super_GetTwoFactorStatus_Handler,
+		},
+		{
+			MethodName: "SetupTwoFactor",
+			Handler:    _Super_SetupTwoFactor_Handler,
+		},
+		{
+			MethodName: "EnableTwoFactor",
+			Handler:    _Super_EnableTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _Super_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "GetOAuthProviders",
+			Handler:    _Super_GetOAuthProviders_Handler,
+		},
+		{
+			MethodName: "StartOAuth",
+			Handler:    _Super_StartOAuth_Handler,
+		},
+		{
+			MethodName: "OAuthLogin",
+			Handler:    _Super_OAuthLogin_Handler,
+		},
+		{
+			MethodName: "LinkOAuthIdentity",
+			Handler:    _Super_LinkOAuthIdentity_Handler,
+		},
+		{
+			MethodName: "GetUserIdentities",
+			Handler:    _Super_GetUserIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkUserIdentity",
+			Handler:    _Super_UnlinkUserIdentity_Handler,
+		},
 		{
 			MethodName: "GetVipPlans",
 			Handler:    _Super_GetVipPlans_Handler,
@@ -889,6 +2389,14 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateVipPlan",
 			Handler:    _Super_CreateVipPlan_Handler,
 		},
+		{
+			MethodName: "GetCreditPacks",
+			Handler:    _Super_GetCreditPacks_Handler,
+		},
+		{
+			MethodName: "CreateCreditPack",
+			Handler:    _Super_CreateCreditPack_Handler,
+		},
 		{
 			MethodName: "CreateVipOrder",
 			Handler:    _Super_CreateVipOrder_Handler,
@@ -897,6 +2405,18 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVipOrders",
 			Handler:    _Super_GetVipOrders_Handler,
 		},
+		{
+			MethodName: "PayVipOrder",
+			Handler:    _Super_PayVipOrder_Handler,
+		},
+		{
+			MethodName: "CancelVipOrder",
+			Handler:    _Super_CancelVipOrder_Handler,
+		},
+		{
+			MethodName: "PaymentNotify",
+			Handler:    _Super_PaymentNotify_Handler,
+		},
 		{
 			MethodName: "GetVipRecords",
 			Handler:    _Super_GetVipRecords_Handler,
@@ -929,7 +2449,34 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAIUsage",
 			Handler:    _Super_UpdateAIUsage_Handler,
 		},
+		{
+			MethodName: "GetAIUsageHistory",
+			Handler:    _Super_GetAIUsageHistory_Handler,
+		},
+		{
+			MethodName: "GetAICredits",
+			Handler:    _Super_GetAICredits_Handler,
+		},
+		{
+			MethodName: "GetAICreditLedger",
+			Handler:    _Super_GetAICreditLedger_Handler,
+		},
+		{
+			MethodName: "AIRequest",
+			Handler:    _Super_AIRequest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _Super_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AIRequestStream",
+			Handler:       _Super_AIRequestStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "superservice.proto",
 }
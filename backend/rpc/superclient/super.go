@@ -14,21 +14,65 @@ import (
 )
 
 type (
+	AICreditGrant              = super.AICreditGrant
+	AICreditLedgerEntry        = super.AICreditLedgerEntry
+	AIRequestReq               = super.AIRequestReq
+	AIRequestResp              = super.AIRequestResp
+	AIStreamChunk              = super.AIStreamChunk
 	AIUsageData                = super.AIUsageData
+	AIUsageLedgerEntry         = super.AIUsageLedgerEntry
+	AccountDeletionResp        = super.AccountDeletionResp
+	CancelAccountDeletionReq   = super.CancelAccountDeletionReq
+	CancelAccountDeletionResp  = super.CancelAccountDeletionResp
+	CancelVipOrderReq          = super.CancelVipOrderReq
+	CancelVipOrderResp         = super.CancelVipOrderResp
+	CheckTokenRevokedReq       = super.CheckTokenRevokedReq
+	CheckTokenRevokedResp      = super.CheckTokenRevokedResp
 	CheckUserVipReq            = super.CheckUserVipReq
 	CheckUserVipResp           = super.CheckUserVipResp
+	CreateCreditPackReq        = super.CreateCreditPackReq
+	CreateCreditPackResp       = super.CreateCreditPackResp
 	CreateVipOrderReq          = super.CreateVipOrderReq
 	CreateVipOrderResp         = super.CreateVipOrderResp
 	CreateVipPlanReq           = super.CreateVipPlanReq
 	CreateVipPlanResp          = super.CreateVipPlanResp
+	CreditPack                 = super.CreditPack
+	DataExportChunk            = super.DataExportChunk
+	DataExportResp             = super.DataExportResp
 	DeleteUserReq              = super.DeleteUserReq
 	DeleteUserResp             = super.DeleteUserResp
+	DeletedUser                = super.DeletedUser
+	DisableTwoFactorReq        = super.DisableTwoFactorReq
+	DisableTwoFactorResp       = super.DisableTwoFactorResp
+	DownloadDataExportReq      = super.DownloadDataExportReq
+	EnableTwoFactorReq         = super.EnableTwoFactorReq
+	EnableTwoFactorResp        = super.EnableTwoFactorResp
+	ForgotPasswordReq          = super.ForgotPasswordReq
+	ForgotPasswordResp         = super.ForgotPasswordResp
+	GetAICreditLedgerReq       = super.GetAICreditLedgerReq
+	GetAICreditLedgerResp      = super.GetAICreditLedgerResp
+	GetAICreditsReq            = super.GetAICreditsReq
+	GetAICreditsResp           = super.GetAICreditsResp
+	GetAIUsageHistoryReq       = super.GetAIUsageHistoryReq
+	GetAIUsageHistoryResp      = super.GetAIUsageHistoryResp
 	GetAIUsageReq              = super.GetAIUsageReq
 	GetAIUsageResp             = super.GetAIUsageResp
+	GetAccountDeletionReq      = super.GetAccountDeletionReq
+	GetCreditPacksReq          = super.GetCreditPacksReq
+	GetCreditPacksResp         = super.GetCreditPacksResp
+	GetDataExportReq           = super.GetDataExportReq
+	GetDeletedUsersReq         = super.GetDeletedUsersReq
+	GetDeletedUsersResp        = super.GetDeletedUsersResp
+	GetOAuthProvidersReq       = super.GetOAuthProvidersReq
+	GetOAuthProvidersResp      = super.GetOAuthProvidersResp
+	GetTwoFactorStatusReq      = super.GetTwoFactorStatusReq
+	GetTwoFactorStatusResp     = super.GetTwoFactorStatusResp
 	GetUserActiveVipRecordReq  = super.GetUserActiveVipRecordReq
 	GetUserActiveVipRecordResp = super.GetUserActiveVipRecordResp
 	GetUserCountReq            = super.GetUserCountReq
 	GetUserCountResp           = super.GetUserCountResp
+	GetUserIdentitiesReq       = super.GetUserIdentitiesReq
+	GetUserIdentitiesResp      = super.GetUserIdentitiesResp
 	GetUserInfoReq             = super.GetUserInfoReq
 	GetUserInfoResp            = super.GetUserInfoResp
 	GetUserReq                 = super.GetUserReq
@@ -45,12 +89,47 @@ type (
 	GetVipPlansResp            = super.GetVipPlansResp
 	GetVipRecordsReq           = super.GetVipRecordsReq
 	GetVipRecordsResp          = super.GetVipRecordsResp
+	LinkOAuthIdentityReq       = super.LinkOAuthIdentityReq
+	LinkOAuthIdentityResp      = super.LinkOAuthIdentityResp
 	LoginReq                   = super.LoginReq
 	LoginResp                  = super.LoginResp
+	LogoutReq                  = super.LogoutReq
+	LogoutResp                 = super.LogoutResp
+	OAuthLoginReq              = super.OAuthLoginReq
+	OAuthProvider              = super.OAuthProvider
+	PayVipOrderReq             = super.PayVipOrderReq
+	PayVipOrderResp            = super.PayVipOrderResp
+	PaymentNotifyReq           = super.PaymentNotifyReq
+	PaymentNotifyResp          = super.PaymentNotifyResp
+	PlanFeatures               = super.PlanFeatures
+	ReconcileUsersReq          = super.ReconcileUsersReq
+	ReconcileUsersResp         = super.ReconcileUsersResp
+	RefreshTokenReq            = super.RefreshTokenReq
+	RefreshTokenResp           = super.RefreshTokenResp
 	RegisterReq                = super.RegisterReq
 	RegisterResp               = super.RegisterResp
+	RequestAccountDeletionReq  = super.RequestAccountDeletionReq
+	ResetPasswordReq           = super.ResetPasswordReq
+	ResetPasswordResp          = super.ResetPasswordResp
+	ResetTwoFactorReq          = super.ResetTwoFactorReq
+	ResetTwoFactorResp         = super.ResetTwoFactorResp
+	RestoreUserReq             = super.RestoreUserReq
+	RestoreUserResp            = super.RestoreUserResp
+	SendVerificationEmailReq   = super.SendVerificationEmailReq
+	SendVerificationEmailResp  = super.SendVerificationEmailResp
+	SetUserRoleReq             = super.SetUserRoleReq
+	SetUserRoleResp            = super.SetUserRoleResp
+	SetupTwoFactorReq          = super.SetupTwoFactorReq
+	SetupTwoFactorResp         = super.SetupTwoFactorResp
+	StartDataExportReq         = super.StartDataExportReq
+	StartOAuthReq              = super.StartOAuthReq
+	StartOAuthResp             = super.StartOAuthResp
 	SyncUserVipStatusReq       = super.SyncUserVipStatusReq
 	SyncUserVipStatusResp      = super.SyncUserVipStatusResp
+	UnlinkUserIdentityReq      = super.UnlinkUserIdentityReq
+	UnlinkUserIdentityResp     = super.UnlinkUserIdentityResp
+	UnlockUserReq              = super.UnlockUserReq
+	UnlockUserResp             = super.UnlockUserResp
 	UpdateAIUsageReq           = super.UpdateAIUsageReq
 	UpdateAIUsageResp          = super.UpdateAIUsageResp
 	UpdateAutoRenewReq         = super.UpdateAutoRenewReq
@@ -62,6 +141,11 @@ type (
 	UpdateUserVipReq           = super.UpdateUserVipReq
 	UpdateUserVipResp          = super.UpdateUserVipResp
 	User                       = super.User
+	UserDrift                  = super.UserDrift
+	UserIdentity               = super.UserIdentity
+	VerifyEmailReq             = super.VerifyEmailReq
+	VerifyEmailResp            = super.VerifyEmailResp
+	VerifyTwoFactorLoginReq    = super.VerifyTwoFactorLoginReq
 	VipOrder                   = super.VipOrder
 	VipPlan                    = super.VipPlan
 	VipRecord                  = super.VipRecord
@@ -70,21 +154,61 @@ type (
 		// 用户相关服务
 		Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterResp, error)
 		Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+		VerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 		GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoResp, error)
 		GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserResp, error)
 		UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*UpdateUserInfoResp, error)
 		UpdateUserPassword(ctx context.Context, in *UpdateUserPasswordReq, opts ...grpc.CallOption) (*UpdateUserPasswordResp, error)
+		VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*VerifyEmailResp, error)
+		SendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq, opts ...grpc.CallOption) (*SendVerificationEmailResp, error)
+		ForgotPassword(ctx context.Context, in *ForgotPasswordReq, opts ...grpc.CallOption) (*ForgotPasswordResp, error)
+		ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 		DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error)
 		UpdateUserVip(ctx context.Context, in *UpdateUserVipReq, opts ...grpc.CallOption) (*UpdateUserVipResp, error)
 		GetUsers(ctx context.Context, in *GetUsersReq, opts ...grpc.CallOption) (*GetUsersResp, error)
 		GetUserCount(ctx context.Context, in *GetUserCountReq, opts ...grpc.CallOption) (*GetUserCountResp, error)
+		GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error)
+		RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error)
+		CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error)
+		StartDataExport(ctx context.Context, in *StartDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error)
+		GetDataExport(ctx context.Context, in *GetDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error)
+		DownloadDataExport(ctx context.Context, in *DownloadDataExportReq, opts ...grpc.CallOption) (super.Super_DownloadDataExportClient, error)
+		// 认证相关服务
+		RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+		Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error)
+		CheckTokenRevoked(ctx context.Context, in *CheckTokenRevokedReq, opts ...grpc.CallOption) (*CheckTokenRevokedResp, error)
+		// 权限管理服务
+		SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*SetUserRoleResp, error)
+		UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserResp, error)
+		ResetTwoFactor(ctx context.Context, in *ResetTwoFactorReq, opts ...grpc.CallOption) (*ResetTwoFactorResp, error)
+		ReconcileUsers(ctx context.Context, in *ReconcileUsersReq, opts ...grpc.CallOption) (*ReconcileUsersResp, error)
+		RestoreUser(ctx context.Context, in *RestoreUserReq, opts ...grpc.CallOption) (*RestoreUserResp, error)
+		GetDeletedUsers(ctx context.Context, in *GetDeletedUsersReq, opts ...grpc.CallOption) (*GetDeletedUsersResp, error)
+		// 两步验证相关服务
+		GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusReq, opts ...grpc.CallOption) (*GetTwoFactorStatusResp, error)
+		SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error)
+		EnableTwoFactor(ctx context.Context, in *EnableTwoFactorReq, opts ...grpc.CallOption) (*EnableTwoFactorResp, error)
+		DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error)
+		// 第三方登录相关服务
+		GetOAuthProviders(ctx context.Context, in *GetOAuthProvidersReq, opts ...grpc.CallOption) (*GetOAuthProvidersResp, error)
+		StartOAuth(ctx context.Context, in *StartOAuthReq, opts ...grpc.CallOption) (*StartOAuthResp, error)
+		OAuthLogin(ctx context.Context, in *OAuthLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+		LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityReq, opts ...grpc.CallOption) (*LinkOAuthIdentityResp, error)
+		GetUserIdentities(ctx context.Context, in *GetUserIdentitiesReq, opts ...grpc.CallOption) (*GetUserIdentitiesResp, error)
+		UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityReq, opts ...grpc.CallOption) (*UnlinkUserIdentityResp, error)
 		// VIP套餐相关服务
 		GetVipPlans(ctx context.Context, in *GetVipPlansReq, opts ...grpc.CallOption) (*GetVipPlansResp, error)
 		GetVipPlan(ctx context.Context, in *GetVipPlanReq, opts ...grpc.CallOption) (*GetVipPlanResp, error)
 		CreateVipPlan(ctx context.Context, in *CreateVipPlanReq, opts ...grpc.CallOption) (*CreateVipPlanResp, error)
+		// AI额度加油包相关服务
+		GetCreditPacks(ctx context.Context, in *GetCreditPacksReq, opts ...grpc.CallOption) (*GetCreditPacksResp, error)
+		CreateCreditPack(ctx context.Context, in *CreateCreditPackReq, opts ...grpc.CallOption) (*CreateCreditPackResp, error)
 		// VIP订单相关服务
 		CreateVipOrder(ctx context.Context, in *CreateVipOrderReq, opts ...grpc.CallOption) (*CreateVipOrderResp, error)
 		GetVipOrders(ctx context.Context, in *GetVipOrdersReq, opts ...grpc.CallOption) (*GetVipOrdersResp, error)
+		PayVipOrder(ctx context.Context, in *PayVipOrderReq, opts ...grpc.CallOption) (*PayVipOrderResp, error)
+		CancelVipOrder(ctx context.Context, in *CancelVipOrderReq, opts ...grpc.CallOption) (*CancelVipOrderResp, error)
+		PaymentNotify(ctx context.Context, in *PaymentNotifyReq, opts ...grpc.CallOption) (*PaymentNotifyResp, error)
 		// VIP记录相关服务
 		GetVipRecords(ctx context.Context, in *GetVipRecordsReq, opts ...grpc.CallOption) (*GetVipRecordsResp, error)
 		GetUserActiveVipRecord(ctx context.Context, in *GetUserActiveVipRecordReq, opts ...grpc.CallOption) (*GetUserActiveVipRecordResp, error)
@@ -96,6 +220,12 @@ type (
 		// AI使用量相关服务
 		GetAIUsage(ctx context.Context, in *GetAIUsageReq, opts ...grpc.CallOption) (*GetAIUsageResp, error)
 		UpdateAIUsage(ctx context.Context, in *UpdateAIUsageReq, opts ...grpc.CallOption) (*UpdateAIUsageResp, error)
+		GetAIUsageHistory(ctx context.Context, in *GetAIUsageHistoryReq, opts ...grpc.CallOption) (*GetAIUsageHistoryResp, error)
+		GetAICredits(ctx context.Context, in *GetAICreditsReq, opts ...grpc.CallOption) (*GetAICreditsResp, error)
+		GetAICreditLedger(ctx context.Context, in *GetAICreditLedgerReq, opts ...grpc.CallOption) (*GetAICreditLedgerResp, error)
+		// AI请求相关服务
+		AIRequest(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (*AIRequestResp, error)
+		AIRequestStream(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (super.Super_AIRequestStreamClient, error)
 	}
 
 	defaultSuper struct {
@@ -120,6 +250,11 @@ func (m *defaultSuper) Login(ctx context.Context, in *LoginReq, opts ...grpc.Cal
 	return client.Login(ctx, in, opts...)
 }
 
+func (m *defaultSuper) VerifyTwoFactorLogin(ctx context.Context, in *VerifyTwoFactorLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.VerifyTwoFactorLogin(ctx, in, opts...)
+}
+
 func (m *defaultSuper) GetUserInfo(ctx context.Context, in *GetUserInfoReq, opts ...grpc.CallOption) (*GetUserInfoResp, error) {
 	client := super.NewSuperClient(m.cli.Conn())
 	return client.GetUserInfo(ctx, in, opts...)
@@ -140,6 +275,26 @@ func (m *defaultSuper) UpdateUserPassword(ctx context.Context, in *UpdateUserPas
 	return client.UpdateUserPassword(ctx, in, opts...)
 }
 
+func (m *defaultSuper) VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*VerifyEmailResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.VerifyEmail(ctx, in, opts...)
+}
+
+func (m *defaultSuper) SendVerificationEmail(ctx context.Context, in *SendVerificationEmailReq, opts ...grpc.CallOption) (*SendVerificationEmailResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.SendVerificationEmail(ctx, in, opts...)
+}
+
+func (m *defaultSuper) ForgotPassword(ctx context.Context, in *ForgotPasswordReq, opts ...grpc.CallOption) (*ForgotPasswordResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.ForgotPassword(ctx, in, opts...)
+}
+
+func (m *defaultSuper) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.ResetPassword(ctx, in, opts...)
+}
+
 func (m *defaultSuper) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error) {
 	client := super.NewSuperClient(m.cli.Conn())
 	return client.DeleteUser(ctx, in, opts...)
@@ -160,6 +315,135 @@ func (m *defaultSuper) GetUserCount(ctx context.Context, in *GetUserCountReq, op
 	return client.GetUserCount(ctx, in, opts...)
 }
 
+func (m *defaultSuper) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultSuper) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*AccountDeletionResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.RequestAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultSuper) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.CancelAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultSuper) StartDataExport(ctx context.Context, in *StartDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.StartDataExport(ctx, in, opts...)
+}
+
+func (m *defaultSuper) GetDataExport(ctx context.Context, in *GetDataExportReq, opts ...grpc.CallOption) (*DataExportResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetDataExport(ctx, in, opts...)
+}
+
+func (m *defaultSuper) DownloadDataExport(ctx context.Context, in *DownloadDataExportReq, opts ...grpc.CallOption) (super.Super_DownloadDataExportClient, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.DownloadDataExport(ctx, in, opts...)
+}
+
+// 认证相关服务
+func (m *defaultSuper) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.RefreshToken(ctx, in, opts...)
+}
+
+func (m *defaultSuper) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.Logout(ctx, in, opts...)
+}
+
+func (m *defaultSuper) CheckTokenRevoked(ctx context.Context, in *CheckTokenRevokedReq, opts ...grpc.CallOption) (*CheckTokenRevokedResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.CheckTokenRevoked(ctx, in, opts...)
+}
+
+// 权限管理服务
+func (m *defaultSuper) SetUserRole(ctx context.Context, in *SetUserRoleReq, opts ...grpc.CallOption) (*SetUserRoleResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.SetUserRole(ctx, in, opts...)
+}
+
+func (m *defaultSuper) UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*UnlockUserResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.UnlockUser(ctx, in, opts...)
+}
+
+func (m *defaultSuper) ResetTwoFactor(ctx context.Context, in *ResetTwoFactorReq, opts ...grpc.CallOption) (*ResetTwoFactorResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.ResetTwoFactor(ctx, in, opts...)
+}
+
+func (m *defaultSuper) ReconcileUsers(ctx context.Context, in *ReconcileUsersReq, opts ...grpc.CallOption) (*ReconcileUsersResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.ReconcileUsers(ctx, in, opts...)
+}
+
+func (m *defaultSuper) RestoreUser(ctx context.Context, in *RestoreUserReq, opts ...grpc.CallOption) (*RestoreUserResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.RestoreUser(ctx, in, opts...)
+}
+
+func (m *defaultSuper) GetDeletedUsers(ctx context.Context, in *GetDeletedUsersReq, opts ...grpc.CallOption) (*GetDeletedUsersResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetDeletedUsers(ctx, in, opts...)
+}
+
+// 两步验证相关服务
+func (m *defaultSuper) GetTwoFactorStatus(ctx context.Context, in *GetTwoFactorStatusReq, opts ...grpc.CallOption) (*GetTwoFactorStatusResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetTwoFactorStatus(ctx, in, opts...)
+}
+
+func (m *defaultSuper) SetupTwoFactor(ctx context.Context, in *SetupTwoFactorReq, opts ...grpc.CallOption) (*SetupTwoFactorResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.SetupTwoFactor(ctx, in, opts...)
+}
+
+func (m *defaultSuper) EnableTwoFactor(ctx context.Context, in *EnableTwoFactorReq, opts ...grpc.CallOption) (*EnableTwoFactorResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.EnableTwoFactor(ctx, in, opts...)
+}
+
+func (m *defaultSuper) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorReq, opts ...grpc.CallOption) (*DisableTwoFactorResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.DisableTwoFactor(ctx, in, opts...)
+}
+
+// 第三方登录相关服务
+func (m *defaultSuper) GetOAuthProviders(ctx context.Context, in *GetOAuthProvidersReq, opts ...grpc.CallOption) (*GetOAuthProvidersResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetOAuthProviders(ctx, in, opts...)
+}
+
+func (m *defaultSuper) StartOAuth(ctx context.Context, in *StartOAuthReq, opts ...grpc.CallOption) (*StartOAuthResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.StartOAuth(ctx, in, opts...)
+}
+
+func (m *defaultSuper) OAuthLogin(ctx context.Context, in *OAuthLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.OAuthLogin(ctx, in, opts...)
+}
+
+func (m *defaultSuper) LinkOAuthIdentity(ctx context.Context, in *LinkOAuthIdentityReq, opts ...grpc.CallOption) (*LinkOAuthIdentityResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.LinkOAuthIdentity(ctx, in, opts...)
+}
+
+func (m *defaultSuper) GetUserIdentities(ctx context.Context, in *GetUserIdentitiesReq, opts ...grpc.CallOption) (*GetUserIdentitiesResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetUserIdentities(ctx, in, opts...)
+}
+
+func (m *defaultSuper) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityReq, opts ...grpc.CallOption) (*UnlinkUserIdentityResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.UnlinkUserIdentity(ctx, in, opts...)
+}
+
 // VIP套餐相关服务
 func (m *defaultSuper) GetVipPlans(ctx context.Context, in *GetVipPlansReq, opts ...grpc.CallOption) (*GetVipPlansResp, error) {
 	client := super.NewSuperClient(m.cli.Conn())
@@ -176,6 +460,17 @@ func (m *defaultSuper) CreateVipPlan(ctx context.Context, in *CreateVipPlanReq,
 	return client.CreateVipPlan(ctx, in, opts...)
 }
 
+// AI额度加油包相关服务
+func (m *defaultSuper) GetCreditPacks(ctx context.Context, in *GetCreditPacksReq, opts ...grpc.CallOption) (*GetCreditPacksResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetCreditPacks(ctx, in, opts...)
+}
+
+func (m *defaultSuper) CreateCreditPack(ctx context.Context, in *CreateCreditPackReq, opts ...grpc.CallOption) (*CreateCreditPackResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.CreateCreditPack(ctx, in, opts...)
+}
+
 // VIP订单相关服务
 func (m *defaultSuper) CreateVipOrder(ctx context.Context, in *CreateVipOrderReq, opts ...grpc.CallOption) (*CreateVipOrderResp, error) {
 	client := super.NewSuperClient(m.cli.Conn())
@@ -187,6 +482,21 @@ func (m *defaultSuper) GetVipOrders(ctx context.Context, in *GetVipOrdersReq, op
 	return client.GetVipOrders(ctx, in, opts...)
 }
 
+func (m *defaultSuper) PayVipOrder(ctx context.Context, in *PayVipOrderReq, opts ...grpc.CallOption) (*PayVipOrderResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.PayVipOrder(ctx, in, opts...)
+}
+
+func (m *defaultSuper) CancelVipOrder(ctx context.Context, in *CancelVipOrderReq, opts ...grpc.CallOption) (*CancelVipOrderResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.CancelVipOrder(ctx, in, opts...)
+}
+
+func (m *defaultSuper) PaymentNotify(ctx context.Context, in *PaymentNotifyReq, opts ...grpc.CallOption) (*PaymentNotifyResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.PaymentNotify(ctx, in, opts...)
+}
+
 // VIP记录相关服务
 func (m *defaultSuper) GetVipRecords(ctx context.Context, in *GetVipRecordsReq, opts ...grpc.CallOption) (*GetVipRecordsResp, error) {
 	client := super.NewSuperClient(m.cli.Conn())
@@ -229,3 +539,29 @@ func (m *defaultSuper) UpdateAIUsage(ctx context.Context, in *UpdateAIUsageReq,
 	client := super.NewSuperClient(m.cli.Conn())
 	return client.UpdateAIUsage(ctx, in, opts...)
 }
+
+func (m *defaultSuper) GetAIUsageHistory(ctx context.Context, in *GetAIUsageHistoryReq, opts ...grpc.CallOption) (*GetAIUsageHistoryResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetAIUsageHistory(ctx, in, opts...)
+}
+
+func (m *defaultSuper) GetAICredits(ctx context.Context, in *GetAICreditsReq, opts ...grpc.CallOption) (*GetAICreditsResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetAICredits(ctx, in, opts...)
+}
+
+func (m *defaultSuper) GetAICreditLedger(ctx context.Context, in *GetAICreditLedgerReq, opts ...grpc.CallOption) (*GetAICreditLedgerResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.GetAICreditLedger(ctx, in, opts...)
+}
+
+// AI请求相关服务
+func (m *defaultSuper) AIRequest(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (*AIRequestResp, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.AIRequest(ctx, in, opts...)
+}
+
+func (m *defaultSuper) AIRequestStream(ctx context.Context, in *AIRequestReq, opts ...grpc.CallOption) (super.Super_AIRequestStreamClient, error) {
+	client := super.NewSuperClient(m.cli.Conn())
+	return client.AIRequestStream(ctx, in, opts...)
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"flag"
 	"fmt"
+	_ "time/tzdata" // 内置时区数据，运行环境缺少系统时区数据时也能加载用户时区
 
 	"backend/rpc/internal/config"
+	"backend/rpc/internal/job"
 	"backend/rpc/internal/server"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
@@ -23,8 +25,12 @@ func main() {
 
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
+	c = c.WithAIRequestTimeout()
 	ctx := svc.NewServiceContext(c)
 
+	group := service.NewServiceGroup()
+	defer group.Stop()
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		super.RegisterSuperServer(grpcServer, server.NewSuperServer(ctx))
 
@@ -32,8 +38,14 @@ func main() {
 			reflection.Register(grpcServer)
 		}
 	})
-	defer s.Stop()
+	group.Add(s)
+
+	// 后台任务
+	group.Add(job.NewOrderExpirySweeper(ctx))
+	group.Add(job.NewOutboxDispatcher(ctx))
+	group.Add(job.NewUserPurger(ctx))
+	group.Add(job.NewDataExporter(ctx))
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
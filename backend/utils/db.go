@@ -41,6 +41,25 @@ func InitDB() error {
 		&model.VipOrder{},
 		&model.VipRecord{},
 		&model.AIUsage{},
+		&model.AIMeter{},
+		&model.AIUsageLedger{},
+		&model.CreditPack{},
+		&model.AICreditGrant{},
+		&model.AICreditLedger{},
+		&model.RefreshToken{},
+		&model.RevokedToken{},
+		&model.UserToken{},
+		&model.UserTOTP{},
+		&model.RecoveryCode{},
+		&model.TwoFactorChallenge{},
+		&model.UserIdentity{},
+		&model.OAuthState{},
+		&model.LoginThrottle{},
+		&model.AuditLog{},
+		&model.OutboxEvent{},
+		&model.UserDeletion{},
+		&model.DataExport{},
+		&model.SchemaMigration{},
 	)
 
 	// 直接使用 viper 配置初始化数据库
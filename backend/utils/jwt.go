@@ -1,51 +1,321 @@
 package utils
 
 import (
+	"crypto"
 	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// JWT密钥，实际项目中应该从配置文件或环境变量中获取
-var jwtSecret = []byte("your-secret-key")
+// 支持的JWT签名算法
+const (
+	JWTAlgHS256 = "HS256"
+	JWTAlgRS256 = "RS256"
+	JWTAlgEdDSA = "EdDSA"
+)
+
+// HS256密钥的最小长度（字节）
+const minHMACSecretLen = 32
+
+// JWTKeyConfig 单个签名密钥配置
+// HS256 使用 Secret；RS256/EdDSA 使用PEM格式的密钥文件，
+// 签发方需要配置私钥，只做校验的服务配置公钥即可
+type JWTKeyConfig struct {
+	Kid            string `json:"Kid"`                                      // 密钥ID，写入Token头部的kid
+	Alg            string `json:",default=HS256,options=HS256|RS256|EdDSA"` // 签名算法
+	Secret         string `json:",optional"`                                // HS256密钥
+	PrivateKeyFile string `json:",optional"`                                // RS256/EdDSA私钥文件
+	PublicKeyFile  string `json:",optional"`                                // RS256/EdDSA公钥文件，配置了私钥时可省略
+}
+
+// JWTConfig JWT配置
+// 只配置 Secret 时使用单个HS256密钥；配置 Keys 时使用密钥环，
+// 新Token使用 ActiveKid 对应的密钥签发，其余密钥只用于校验轮换前签发的Token
+type JWTConfig struct {
+	Secret        string         `json:",optional,env=JWT_SECRET"` // 单密钥模式的HS256密钥
+	Expire        int64          `json:",default=900"`             // 访问Token有效期（秒）
+	RefreshExpire int64          `json:",default=2592000"`         // 刷新令牌有效期（秒），仅签发方使用
+	ActiveKid     string         `json:",optional"`                // 当前用于签发的密钥ID
+	Keys          []JWTKeyConfig `json:",optional"`                // 密钥环
+}
+
+// jwtKey 密钥环中的密钥
+type jwtKey struct {
+	kid       string
+	method    jwt.SigningMethod
+	signKey   any // 签名密钥，只校验时为nil
+	verifyKey any // 校验密钥
+}
 
-// JWT过期时间，设置为24小时
-var jwtExpireTime = 24 * time.Hour
+// jwtKeyRing JWT密钥环
+type jwtKeyRing struct {
+	active  *jwtKey
+	keys    map[string]*jwtKey
+	methods []string // 允许的签名算法
+	expire  time.Duration
+	refresh time.Duration
+}
+
+var (
+	jwtMu   sync.RWMutex
+	jwtRing *jwtKeyRing
+)
+
+// defaultJWTKid 单密钥模式下的密钥ID
+const defaultJWTKid = "default"
 
 // CustomClaims 自定义JWT声明结构体
 type CustomClaims struct {
 	UserID   uint   `json:"user_id"`
 	Username string `json:"username"`
+	Role     string `json:"role,omitempty"` // 用户角色，权限变更在重新签发Token后生效
 	jwt.RegisteredClaims
 }
 
+// InitJWT 根据配置初始化JWT密钥环，签发和校验Token前必须调用
+func InitJWT(c JWTConfig) error {
+	keyConfigs := c.Keys
+	activeKid := c.ActiveKid
+	if len(keyConfigs) == 0 {
+		if c.Secret == "" {
+			return errors.New("JWT配置缺少Secret或Keys")
+		}
+		keyConfigs = []JWTKeyConfig{{Kid: defaultJWTKid, Alg: JWTAlgHS256, Secret: c.Secret}}
+		activeKid = defaultJWTKid
+	}
+	if c.Expire <= 0 {
+		return errors.New("JWT配置的Expire必须大于0")
+	}
+
+	ring := &jwtKeyRing{
+		keys:    make(map[string]*jwtKey, len(keyConfigs)),
+		expire:  time.Duration(c.Expire) * time.Second,
+		refresh: time.Duration(c.RefreshExpire) * time.Second,
+	}
+	seenMethods := make(map[string]bool)
+	for _, kc := range keyConfigs {
+		if kc.Kid == "" {
+			return errors.New("JWT密钥缺少Kid")
+		}
+		if _, ok := ring.keys[kc.Kid]; ok {
+			return fmt.Errorf("JWT密钥Kid重复: %s", kc.Kid)
+		}
+
+		key, err := loadJWTKey(kc)
+		if err != nil {
+			return fmt.Errorf("加载JWT密钥%s失败: %w", kc.Kid, err)
+		}
+		ring.keys[kc.Kid] = key
+
+		if alg := key.method.Alg(); !seenMethods[alg] {
+			seenMethods[alg] = true
+			ring.methods = append(ring.methods, alg)
+		}
+	}
+
+	// 未指定ActiveKid且只有一个密钥时，直接使用该密钥签发
+	if activeKid == "" && len(keyConfigs) == 1 {
+		activeKid = keyConfigs[0].Kid
+	}
+	if activeKid != "" {
+		active, ok := ring.keys[activeKid]
+		if !ok {
+			return fmt.Errorf("JWT配置的ActiveKid不存在: %s", activeKid)
+		}
+		ring.active = active
+	}
+
+	jwtMu.Lock()
+	jwtRing = ring
+	jwtMu.Unlock()
+
+	return nil
+}
+
+// MustInitJWT 初始化JWT密钥环，失败时panic
+func MustInitJWT(c JWTConfig) {
+	if err := InitJWT(c); err != nil {
+		panic(err)
+	}
+}
+
+// loadJWTKey 根据配置加载密钥
+func loadJWTKey(kc JWTKeyConfig) (*jwtKey, error) {
+	key := &jwtKey{kid: kc.Kid}
+
+	switch kc.Alg {
+	case "", JWTAlgHS256:
+		if len(kc.Secret) < minHMACSecretLen {
+			return nil, fmt.Errorf("HS256密钥长度不能少于%d字节", minHMACSecretLen)
+		}
+		key.method = jwt.SigningMethodHS256
+		key.signKey = []byte(kc.Secret)
+		key.verifyKey = []byte(kc.Secret)
+
+	case JWTAlgRS256:
+		key.method = jwt.SigningMethodRS256
+		if kc.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(kc.PrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			key.signKey = privateKey
+			key.verifyKey = &privateKey.PublicKey
+		}
+		if kc.PublicKeyFile != "" {
+			pem, err := os.ReadFile(kc.PublicKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			publicKey, err := jwt.ParseRSAPublicKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			key.verifyKey = publicKey
+		}
+
+	case JWTAlgEdDSA:
+		key.method = jwt.SigningMethodEdDSA
+		if kc.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(kc.PrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			privateKey, err := jwt.ParseEdPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			signer, ok := privateKey.(crypto.Signer)
+			if !ok {
+				return nil, errors.New("无效的EdDSA私钥")
+			}
+			key.signKey = privateKey
+			key.verifyKey = signer.Public()
+		}
+		if kc.PublicKeyFile != "" {
+			pem, err := os.ReadFile(kc.PublicKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			publicKey, err := jwt.ParseEdPublicKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			key.verifyKey = publicKey
+		}
+
+	default:
+		return nil, fmt.Errorf("不支持的签名算法: %s", kc.Alg)
+	}
+
+	if key.verifyKey == nil {
+		return nil, errors.New("缺少PrivateKeyFile或PublicKeyFile")
+	}
+
+	return key, nil
+}
+
+// getJWTKeyRing 获取已初始化的密钥环
+func getJWTKeyRing() (*jwtKeyRing, error) {
+	jwtMu.RLock()
+	defer jwtMu.RUnlock()
+
+	if jwtRing == nil {
+		return nil, errors.New("JWT未初始化")
+	}
+	return jwtRing, nil
+}
+
 // GenerateToken 生成JWT Token
-func GenerateToken(userID uint, username string) (string, error) {
+func GenerateToken(userID uint, username, role string) (string, error) {
+	ring, err := getJWTKeyRing()
+	if err != nil {
+		return "", err
+	}
+	if ring.active == nil || ring.active.signKey == nil {
+		return "", errors.New("没有可用于签发Token的密钥")
+	}
+
+	// 每个Token带唯一的jti，用于登出后加入黑名单
+	jti, err := GenerateRandomToken(16)
+	if err != nil {
+		return "", err
+	}
+
 	// 创建声明
+	now := time.Now()
 	claims := CustomClaims{
 		UserID:   userID,
 		Username: username,
+		Role:     role,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(jwtExpireTime)), // 过期时间
-			IssuedAt:  jwt.NewNumericDate(time.Now()),                    // 签发时间
-			NotBefore: jwt.NewNumericDate(time.Now()),                    // 生效时间
+			ID:        jti,                                      // Token ID
+			Subject:   strconv.FormatUint(uint64(userID), 10),   // 用户ID
+			ExpiresAt: jwt.NewNumericDate(now.Add(ring.expire)), // 过期时间
+			IssuedAt:  jwt.NewNumericDate(now),                  // 签发时间
+			NotBefore: jwt.NewNumericDate(now),                  // 生效时间
 		},
 	}
 
-	// 创建Token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// 创建Token，在头部写入kid以便校验时选择密钥
+	token := jwt.NewWithClaims(ring.active.method, claims)
+	token.Header["kid"] = ring.active.kid
 
 	// 签名并获取完整的编码后的字符串
-	return token.SignedString(jwtSecret)
+	return token.SignedString(ring.active.signKey)
+}
+
+// AccessTokenExpire 访问Token有效期
+func AccessTokenExpire() time.Duration {
+	ring, err := getJWTKeyRing()
+	if err != nil {
+		return 0
+	}
+	return ring.expire
+}
+
+// RefreshTokenExpire 刷新令牌有效期
+func RefreshTokenExpire() time.Duration {
+	ring, err := getJWTKeyRing()
+	if err != nil {
+		return 0
+	}
+	return ring.refresh
 }
 
 // ParseToken 解析JWT Token
+// 根据头部的kid选择密钥，并要求Token的签名算法与该密钥的算法一致，防止算法混淆攻击
 func ParseToken(tokenString string) (*CustomClaims, error) {
+	ring, err := getJWTKeyRing()
+	if err != nil {
+		return nil, err
+	}
+
 	// 解析Token
 	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return jwtSecret, nil
-	})
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ring.keys[kid]
+		if !ok {
+			// 兼容未携带kid的Token：只使用当前签发密钥校验
+			if kid != "" || ring.active == nil {
+				return nil, fmt.Errorf("未知的密钥ID: %q", kid)
+			}
+			key = ring.active
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("签名算法不匹配: %s", token.Method.Alg())
+		}
+		return key.verifyKey, nil
+	}, jwt.WithValidMethods(ring.methods), jwt.WithExpirationRequired())
 
 	if err != nil {
 		return nil, err
@@ -1,6 +1,7 @@
 import { useState } from 'react'
 import Login from './components/Login'
 import Register from './components/Register'
+import ForgotPassword from './components/ForgotPassword'
 import './App.css'
 
 function App() {
@@ -28,7 +29,9 @@ function App() {
             </div>
           </div>
           <div className="auth-content">
-            {activeTab === 'login' ? <Login onTabChange={setActiveTab} /> : <Register onTabChange={setActiveTab} />}
+            {activeTab === 'login' && <Login onTabChange={setActiveTab} />}
+            {activeTab === 'register' && <Register onTabChange={setActiveTab} />}
+            {activeTab === 'forgot' && <ForgotPassword onTabChange={setActiveTab} />}
           </div>
         </div>
       </main>
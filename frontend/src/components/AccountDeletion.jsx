@@ -0,0 +1,113 @@
+import { useState, useEffect } from 'react';
+import { getAccountDeletion, requestAccountDeletion, cancelAccountDeletion } from '../utils/api';
+
+// 个人资料页的注销账号：验证密码后申请注销，冷静期内可撤销，到期后数据被彻底清除
+const AccountDeletion = ({ userId }) => {
+  const [deletion, setDeletion] = useState(null);
+  const [password, setPassword] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+  const [error, setError] = useState('');
+  const [success, setSuccess] = useState('');
+
+  const fetchDeletion = async () => {
+    try {
+      setDeletion(await getAccountDeletion(userId));
+    } catch (err) {
+      setError(err.message);
+    }
+  };
+
+  useEffect(() => {
+    fetchDeletion();
+  }, [userId]);
+
+  // 执行操作并统一处理加载状态和提示
+  const run = async (action) => {
+    setError('');
+    setSuccess('');
+    setIsLoading(true);
+    try {
+      await action();
+    } catch (err) {
+      setError(err.message);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  const handleRequest = () => {
+    if (!window.confirm('确定要注销账号吗？冷静期结束后，账号、VIP记录和AI使用记录将被彻底清除且无法恢复')) {
+      return;
+    }
+    run(async () => {
+      setDeletion(await requestAccountDeletion(userId, password));
+      setPassword('');
+      setSuccess('已提交注销申请');
+    });
+  };
+
+  const handleCancel = () => run(async () => {
+    await cancelAccountDeletion(userId);
+    setSuccess('已撤销注销申请');
+    await fetchDeletion();
+  });
+
+  return (
+    <div className="profile-section">
+      <div className="section-header">
+        <h2>注销账号</h2>
+      </div>
+
+      <div className="password-form-container">
+        {error && (
+          <div className="error-message">{error}</div>
+        )}
+        {success && (
+          <div className="success-message">{success}</div>
+        )}
+
+        {deletion?.scheduled ? (
+          <>
+            <p>你已于 {deletion.requested_at} 申请注销账号，账号将于 {deletion.purge_at} 被彻底清除。在此之前账号可正常使用，也可以随时撤销申请。</p>
+            <div className="form-actions">
+              <button
+                type="button"
+                className="save-button"
+                onClick={handleCancel}
+                disabled={isLoading}
+              >
+                {isLoading ? '处理中...' : '撤销注销申请'}
+              </button>
+            </div>
+          </>
+        ) : (
+          <>
+            <p>注销后进入冷静期，冷静期内账号可正常使用并可撤销；冷静期结束后，账号、VIP记录、AI使用记录和绑定的第三方账号将被彻底清除，订单仅作为财务记录匿名保留。</p>
+            <div className="form-group">
+              <label htmlFor="deletionPassword">当前密码</label>
+              <input
+                type="password"
+                id="deletionPassword"
+                value={password}
+                onChange={(e) => setPassword(e.target.value)}
+                autoComplete="current-password"
+              />
+            </div>
+            <div className="form-actions">
+              <button
+                type="button"
+                className="cancel-button"
+                onClick={handleRequest}
+                disabled={isLoading || !password}
+              >
+                {isLoading ? '处理中...' : '申请注销账号'}
+              </button>
+            </div>
+          </>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default AccountDeletion;
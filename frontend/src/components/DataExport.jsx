@@ -0,0 +1,126 @@
+import { useState, useEffect } from 'react';
+import { startDataExport, getDataExport, dataExportDownloadUrl } from '../utils/api';
+
+// 导出生成中时轮询状态的间隔（毫秒）
+const POLL_INTERVAL = 3000;
+
+const STATUS_TEXT = {
+  pending: '等待生成',
+  running: '生成中',
+  ready: '已生成',
+  failed: '生成失败，请重新导出',
+  expired: '已过期，请重新导出',
+};
+
+const formatSize = (size) => {
+  if (size >= 1024 * 1024) {
+    return `${(size / 1024 / 1024).toFixed(1)} MB`;
+  }
+  return `${Math.max(1, Math.round(size / 1024))} KB`;
+};
+
+// 个人资料页的导出数据：申请后由后台生成ZIP文件，生成期间轮询状态，完成后通过带签名的链接下载
+const DataExport = ({ userId }) => {
+  const [dataExport, setDataExport] = useState(null);
+  const [isLoading, setIsLoading] = useState(false);
+  const [error, setError] = useState('');
+
+  const inProgress = dataExport && (dataExport.status === 'pending' || dataExport.status === 'running');
+
+  // 生成期间定时查询状态
+  useEffect(() => {
+    if (!inProgress) {
+      return undefined;
+    }
+    const timer = setTimeout(async () => {
+      try {
+        setDataExport(await getDataExport(userId, dataExport.id));
+      } catch (err) {
+        setError(err.message);
+      }
+    }, POLL_INTERVAL);
+    return () => clearTimeout(timer);
+  }, [userId, dataExport, inProgress]);
+
+  const handleStart = async (fresh) => {
+    setError('');
+    setIsLoading(true);
+    try {
+      setDataExport(await startDataExport(userId, fresh));
+    } catch (err) {
+      setError(err.message);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  // 下载链接有效期较短，下载前重新查询以获取新链接
+  const handleDownload = async () => {
+    setError('');
+    try {
+      const latest = await getDataExport(userId, dataExport.id);
+      setDataExport(latest);
+      if (latest.download_url) {
+        window.location.assign(dataExportDownloadUrl(latest.download_url));
+      }
+    } catch (err) {
+      setError(err.message);
+    }
+  };
+
+  return (
+    <div className="profile-section">
+      <div className="section-header">
+        <h2>导出数据</h2>
+      </div>
+
+      <div className="password-form-container">
+        {error && (
+          <div className="error-message">{error}</div>
+        )}
+
+        <p>导出你的账号资料、VIP订单和开通记录、AI使用记录等全部个人数据。导出文件为ZIP格式，每类数据一个JSON文件，生成后保留一段时间供下载，过期后自动删除。</p>
+
+        {dataExport && (
+          <p>
+            导出状态：{STATUS_TEXT[dataExport.status] || dataExport.status}
+            {dataExport.status === 'ready' && `（${formatSize(dataExport.size)}，${dataExport.expires_at} 前可下载）`}
+          </p>
+        )}
+
+        <div className="form-actions">
+          {dataExport?.status === 'ready' ? (
+            <>
+              <button
+                type="button"
+                className="save-button"
+                onClick={handleDownload}
+              >
+                下载导出文件
+              </button>
+              <button
+                type="button"
+                className="cancel-button"
+                onClick={() => handleStart(true)}
+                disabled={isLoading}
+              >
+                {isLoading ? '处理中...' : '重新导出'}
+              </button>
+            </>
+          ) : (
+            <button
+              type="button"
+              className="save-button"
+              onClick={() => handleStart(false)}
+              disabled={isLoading || inProgress}
+            >
+              {isLoading || inProgress ? '正在生成...' : '导出我的数据'}
+            </button>
+          )}
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default DataExport;
@@ -0,0 +1,87 @@
+import { useState } from 'react';
+import { FaEnvelope, FaSmile, FaRegMeh } from 'react-icons/fa';
+import { forgotPassword } from '../utils/api';
+import './AuthMacaron.css';
+
+const ForgotPassword = ({ onTabChange }) => {
+  const [email, setEmail] = useState('');
+  const [error, setError] = useState('');
+  const [success, setSuccess] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+
+  const handleSubmit = async (e) => {
+    e.preventDefault();
+    setError('');
+    setSuccess('');
+    setIsLoading(true);
+
+    try {
+      await forgotPassword(email);
+      setSuccess('如果该邮箱已注册，重置密码邮件已发送，请查收');
+    } catch (err) {
+      setError(err.message);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  return (
+    <div className="auth-container-macaron">
+      <h2 className="auth-title-macaron">忘记密码</h2>
+      <p className="auth-subtitle-macaron">输入注册邮箱，我们会发送重置密码的链接</p>
+
+      <form onSubmit={handleSubmit} className="auth-form-macaron">
+        <div className="form-group-macaron">
+          <label htmlFor="forgot-email" className="form-label-macaron">
+            <FaEnvelope className="label-icon" />
+            邮箱地址
+          </label>
+          <input
+            type="email"
+            id="forgot-email"
+            value={email}
+            onChange={(e) => setEmail(e.target.value)}
+            required
+            placeholder="your@email.com"
+            className="form-input-macaron"
+          />
+        </div>
+
+        {error && (
+          <div className="message-macaron error-macaron">
+            <FaRegMeh className="message-icon" />
+            <span>{error}</span>
+          </div>
+        )}
+
+        {success && (
+          <div className="message-macaron success-macaron">
+            <FaSmile className="message-icon" />
+            <span>{success}</span>
+          </div>
+        )}
+
+        <button
+          type="submit"
+          className="submit-button-macaron"
+          disabled={isLoading}
+        >
+          {isLoading ? <span className="button-loading">发送中...</span> : '发送重置邮件'}
+        </button>
+      </form>
+
+      <div className="auth-footer-macaron">
+        <p>想起密码了？ 
+          <span
+            className="auth-link-macaron"
+            onClick={() => onTabChange('login')}
+          >
+            返回登录
+          </span>
+        </p>
+      </div>
+    </div>
+  );
+};
+
+export default ForgotPassword;
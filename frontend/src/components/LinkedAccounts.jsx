@@ -0,0 +1,103 @@
+import { useState, useEffect } from 'react';
+import { getOAuthProviders, getUserIdentities, authorizeUserIdentity, unlinkUserIdentity } from '../utils/api';
+
+// 个人资料页的第三方账号：列出已配置的登录方式，绑定或解绑
+const LinkedAccounts = ({ userId }) => {
+  const [providers, setProviders] = useState([]);
+  const [identities, setIdentities] = useState([]);
+  const [isLoading, setIsLoading] = useState(false);
+  const [error, setError] = useState('');
+  const [success, setSuccess] = useState('');
+
+  const fetchIdentities = async () => {
+    try {
+      const [providerList, identityList] = await Promise.all([
+        getOAuthProviders(),
+        getUserIdentities(userId),
+      ]);
+      setProviders(providerList || []);
+      setIdentities(identityList || []);
+    } catch (err) {
+      setError(err.message);
+    }
+  };
+
+  useEffect(() => {
+    fetchIdentities();
+  }, [userId]);
+
+  // 跳转到第三方授权页，授权后回到 /oauth/callback/:provider 完成绑定
+  const handleLink = async (provider) => {
+    setError('');
+    setSuccess('');
+    setIsLoading(true);
+    try {
+      const data = await authorizeUserIdentity(userId, provider);
+      sessionStorage.setItem('oauth_intent', 'link');
+      window.location.href = data.authorization_url;
+    } catch (err) {
+      setError(err.message);
+      setIsLoading(false);
+    }
+  };
+
+  const handleUnlink = async (provider) => {
+    setError('');
+    setSuccess('');
+    setIsLoading(true);
+    try {
+      await unlinkUserIdentity(userId, provider);
+      setSuccess('已解绑');
+      await fetchIdentities();
+    } catch (err) {
+      setError(err.message);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  return (
+    <div className="profile-section">
+      <div className="section-header">
+        <h2>第三方账号</h2>
+      </div>
+
+      <div className="password-form-container">
+        {error && (
+          <div className="error-message">{error}</div>
+        )}
+        {success && (
+          <div className="success-message">{success}</div>
+        )}
+
+        {providers.length === 0 ? (
+          <p>暂未开放第三方登录</p>
+        ) : (
+          <div className="info-display">
+            {providers.map((provider) => {
+              const identity = identities.find((item) => item.provider === provider.name);
+              return (
+                <div className="info-row" key={provider.name}>
+                  <span className="info-label">{provider.display_name}</span>
+                  <span className="info-value">
+                    {identity ? `已绑定 ${identity.email || ''}` : '未绑定'}
+                  </span>
+                  <button
+                    type="button"
+                    className={identity ? 'cancel-button' : 'save-button'}
+                    onClick={() => (identity ? handleUnlink(provider.name) : handleLink(provider.name))}
+                    disabled={isLoading}
+                  >
+                    {identity ? '解绑' : '绑定'}
+                  </button>
+                </div>
+              );
+            })}
+          </div>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default LinkedAccounts;
@@ -1,6 +1,6 @@
-import { useState } from 'react';
-import { FaEnvelope, FaLock, FaEye, FaEyeSlash, FaSmile, FaRegMeh } from 'react-icons/fa';
-import { login } from '../utils/api';
+import { useState, useEffect } from 'react';
+import { FaEnvelope, FaLock, FaEye, FaEyeSlash, FaSmile, FaRegMeh, FaShieldAlt } from 'react-icons/fa';
+import { login, verifyTwoFactorLogin, getOAuthProviders, authorizeOAuth } from '../utils/api';
 import './AuthMacaron.css';
 
 const Login = ({ onTabChange }) => {
@@ -10,6 +10,31 @@ const Login = ({ onTabChange }) => {
   const [error, setError] = useState('');
   const [isLoading, setIsLoading] = useState(false);
   const [success, setSuccess] = useState('');
+  // 开启两步验证时，密码验证通过后返回的挑战令牌
+  const [challengeToken, setChallengeToken] = useState('');
+  const [code, setCode] = useState('');
+  const [oauthProviders, setOAuthProviders] = useState([]);
+
+  // 获取已配置的第三方登录方式，获取失败时只显示密码登录
+  useEffect(() => {
+    getOAuthProviders()
+      .then((providers) => setOAuthProviders(providers || []))
+      .catch(() => setOAuthProviders([]));
+  }, []);
+
+  // 跳转到第三方授权页，授权后回到 /oauth/callback/:provider 完成登录
+  const handleOAuthLogin = async (provider) => {
+    setError('');
+    setIsLoading(true);
+    try {
+      const data = await authorizeOAuth(provider);
+      sessionStorage.setItem('oauth_intent', 'login');
+      window.location.href = data.authorization_url;
+    } catch (err) {
+      setError(err.message);
+      setIsLoading(false);
+    }
+  };
 
   const handleSubmit = async (e) => {
     e.preventDefault();
@@ -18,7 +43,15 @@ const Login = ({ onTabChange }) => {
     setIsLoading(true);
     
     try {
-      const loginData = await login('', email, password);
+      const loginData = challengeToken
+        ? await verifyTwoFactorLogin(challengeToken, code.trim())
+        : await login('', email, password);
+
+      if (loginData.two_factor_required) {
+        setChallengeToken(loginData.challenge_token);
+        setCode('');
+        return;
+      }
       
       const token = loginData.token;
       const user = loginData.user;
@@ -28,6 +61,7 @@ const Login = ({ onTabChange }) => {
       }
       
       localStorage.setItem('token', token);
+      localStorage.setItem('refresh_token', loginData.refresh_token);
       localStorage.setItem('user', JSON.stringify(user));
       localStorage.setItem('userId', user.id);
       setSuccess('登录成功，正在跳转...');
@@ -37,6 +71,10 @@ const Login = ({ onTabChange }) => {
       }, 1500);
     } catch (err) {
       setError(err.message);
+      // 挑战过期或错误次数过多时需要重新输入密码
+      if (challengeToken && /重新登录/.test(err.message)) {
+        setChallengeToken('');
+      }
     } finally {
       setIsLoading(false);
     }
@@ -48,47 +86,69 @@ const Login = ({ onTabChange }) => {
       <p className="auth-subtitle-macaron">登录您的账户，继续精彩旅程</p>
       
       <form onSubmit={handleSubmit} className="auth-form-macaron">
-        <div className="form-group-macaron">
-          <label htmlFor="email" className="form-label-macaron">
-            <FaEnvelope className="label-icon" />
-            邮箱地址
-          </label>
-          <input
-            type="email"
-            id="email"
-            value={email}
-            onChange={(e) => setEmail(e.target.value)}
-            required
-            placeholder="your@email.com"
-            className="form-input-macaron"
-          />
-        </div>
-
-        <div className="form-group-macaron">
-          <label htmlFor="password" className="form-label-macaron">
-            <FaLock className="label-icon" />
-            密码
-          </label>
-          <div className="password-wrapper-macaron">
+        {challengeToken ? (
+          <div className="form-group-macaron">
+            <label htmlFor="code" className="form-label-macaron">
+              <FaShieldAlt className="label-icon" />
+              两步验证码
+            </label>
             <input
-              type={showPassword ? 'text' : 'password'}
-              id="password"
-              value={password}
-              onChange={(e) => setPassword(e.target.value)}
+              type="text"
+              id="code"
+              value={code}
+              onChange={(e) => setCode(e.target.value)}
               required
-              placeholder="••••••••"
+              autoFocus
+              autoComplete="one-time-code"
+              placeholder="验证器中的6位数字或恢复码"
               className="form-input-macaron"
             />
-            <button
-              type="button"
-              className="password-toggle-macaron"
-              onClick={() => setShowPassword(!showPassword)}
-              aria-label={showPassword ? '隐藏密码' : '显示密码'}
-            >
-              {showPassword ? <FaEyeSlash /> : <FaEye />}
-            </button>
           </div>
-        </div>
+        ) : (
+          <>
+            <div className="form-group-macaron">
+              <label htmlFor="email" className="form-label-macaron">
+                <FaEnvelope className="label-icon" />
+                邮箱地址
+              </label>
+              <input
+                type="email"
+                id="email"
+                value={email}
+                onChange={(e) => setEmail(e.target.value)}
+                required
+                placeholder="your@email.com"
+                className="form-input-macaron"
+              />
+            </div>
+
+            <div className="form-group-macaron">
+              <label htmlFor="password" className="form-label-macaron">
+                <FaLock className="label-icon" />
+                密码
+              </label>
+              <div className="password-wrapper-macaron">
+                <input
+                  type={showPassword ? 'text' : 'password'}
+                  id="password"
+                  value={password}
+                  onChange={(e) => setPassword(e.target.value)}
+                  required
+                  placeholder="••••••••"
+                  className="form-input-macaron"
+                />
+                <button
+                  type="button"
+                  className="password-toggle-macaron"
+                  onClick={() => setShowPassword(!showPassword)}
+                  aria-label={showPassword ? '隐藏密码' : '显示密码'}
+                >
+                  {showPassword ? <FaEyeSlash /> : <FaEye />}
+                </button>
+              </div>
+            </div>
+          </>
+        )}
 
         {error && (
           <div className="message-macaron error-macaron">
@@ -110,13 +170,29 @@ const Login = ({ onTabChange }) => {
           disabled={isLoading}
         >
           {isLoading ? (
-            <span className="button-loading">登录中...</span>
+            <span className="button-loading">{challengeToken ? '验证中...' : '登录中...'}</span>
           ) : (
-            '登录'
+            challengeToken ? '验证' : '登录'
           )}
         </button>
       </form>
 
+      {!challengeToken && oauthProviders.length > 0 && (
+        <div className="oauth-buttons-macaron">
+          {oauthProviders.map((provider) => (
+            <button
+              key={provider.name}
+              type="button"
+              className="oauth-button-macaron"
+              onClick={() => handleOAuthLogin(provider.name)}
+              disabled={isLoading}
+            >
+              使用 {provider.display_name} 登录
+            </button>
+          ))}
+        </div>
+      )}
+
       <div className="auth-footer-macaron">
         <p>还没有账号？ 
           <span 
@@ -126,6 +202,14 @@ const Login = ({ onTabChange }) => {
             立即注册
           </span>
         </p>
+        <p>
+          <span 
+            className="auth-link-macaron" 
+            onClick={() => onTabChange('forgot')}
+          >
+            忘记密码？
+          </span>
+        </p>
       </div>
     </div>
   );
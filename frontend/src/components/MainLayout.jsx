@@ -13,6 +13,7 @@ import {
   BellOutlined
 } from '@ant-design/icons';
 import { useNavigate, useLocation, Outlet, Link } from 'react-router-dom';
+import { logout } from '../utils/api';
 
 const { Header, Sider, Content } = Layout;
 
@@ -33,8 +34,14 @@ const MainLayout = () => {
   const navigate = useNavigate();
   const location = useLocation();
 
-  const handleLogout = () => {
+  const handleLogout = async () => {
+    try {
+      await logout();
+    } catch (e) {
+      // 登出失败不影响清理本地登录状态
+    }
     localStorage.removeItem('token');
+    localStorage.removeItem('refresh_token');
     localStorage.removeItem('user');
     navigate('/');
   };
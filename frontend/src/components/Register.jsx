@@ -58,7 +58,7 @@ const Register = ({ onTabChange }) => {
     try {
       await register(username, password, email);
       
-      setSuccess('注册成功，正在跳转到登录页面...');
+      setSuccess('注册成功，验证邮件已发送到您的邮箱，正在跳转到登录页面...');
       setTimeout(() => {
         window.location.hash = '#login';
         setUsername('');
@@ -0,0 +1,151 @@
+import { useState, useEffect } from 'react';
+import { QRCode } from 'antd';
+import { getTwoFactorStatus, setupTwoFactor, enableTwoFactor, disableTwoFactor } from '../utils/api';
+
+// 个人资料页的两步验证设置：生成密钥并扫码绑定、输入验证码启用、展示恢复码、关闭
+const TwoFactorSettings = ({ userId }) => {
+  const [status, setStatus] = useState(null);
+  const [setup, setSetup] = useState(null);
+  const [recoveryCodes, setRecoveryCodes] = useState([]);
+  const [code, setCode] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+  const [error, setError] = useState('');
+  const [success, setSuccess] = useState('');
+
+  const fetchStatus = async () => {
+    try {
+      setStatus(await getTwoFactorStatus(userId));
+    } catch (err) {
+      setError(err.message);
+    }
+  };
+
+  useEffect(() => {
+    fetchStatus();
+  }, [userId]);
+
+  // 执行操作并统一处理加载状态和提示
+  const run = async (action) => {
+    setError('');
+    setSuccess('');
+    setIsLoading(true);
+    try {
+      await action();
+    } catch (err) {
+      setError(err.message);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  const handleSetup = () => run(async () => {
+    setSetup(await setupTwoFactor(userId));
+    setRecoveryCodes([]);
+    setCode('');
+  });
+
+  const handleEnable = () => run(async () => {
+    const result = await enableTwoFactor(userId, code.trim());
+    setRecoveryCodes(result?.recovery_codes || []);
+    setSetup(null);
+    setCode('');
+    setSuccess('两步验证已启用');
+    await fetchStatus();
+  });
+
+  const handleDisable = () => run(async () => {
+    await disableTwoFactor(userId, code.trim());
+    setRecoveryCodes([]);
+    setCode('');
+    setSuccess('两步验证已关闭');
+    await fetchStatus();
+  });
+
+  const codeInput = (
+    <div className="form-group">
+      <label htmlFor="twoFactorCode">{status?.enabled ? '验证码或恢复码' : '验证码'}</label>
+      <input
+        type="text"
+        id="twoFactorCode"
+        value={code}
+        onChange={(e) => setCode(e.target.value)}
+        autoComplete="one-time-code"
+        placeholder={status?.enabled ? '验证器中的6位数字或恢复码' : '验证器中的6位数字'}
+      />
+    </div>
+  );
+
+  return (
+    <div className="profile-section">
+      <div className="section-header">
+        <h2>两步验证</h2>
+      </div>
+
+      <div className="password-form-container">
+        {error && (
+          <div className="error-message">{error}</div>
+        )}
+        {success && (
+          <div className="success-message">{success}</div>
+        )}
+
+        {recoveryCodes.length > 0 && (
+          <div className="info-display">
+            <p>请将以下恢复码保存在安全的地方。丢失验证器时可使用恢复码登录，每个恢复码只能使用一次，此后不会再次显示：</p>
+            <pre>{recoveryCodes.join('\n')}</pre>
+          </div>
+        )}
+
+        {status?.enabled ? (
+          <>
+            <p>两步验证已启用，登录时需要输入验证器中的验证码。剩余恢复码：{status.recovery_codes_remaining} 个</p>
+            {codeInput}
+            <div className="form-actions">
+              <button
+                type="button"
+                className="cancel-button"
+                onClick={handleDisable}
+                disabled={isLoading || !code.trim()}
+              >
+                {isLoading ? '处理中...' : '关闭两步验证'}
+              </button>
+            </div>
+          </>
+        ) : setup ? (
+          <>
+            <p>请使用验证器应用（如 Google Authenticator、Microsoft Authenticator）扫描二维码，然后输入显示的6位验证码：</p>
+            <QRCode value={setup.otpauth_uri} />
+            <p>无法扫码时可手动输入密钥：<code>{setup.secret}</code></p>
+            {codeInput}
+            <div className="form-actions">
+              <button
+                type="button"
+                className="save-button"
+                onClick={handleEnable}
+                disabled={isLoading || !code.trim()}
+              >
+                {isLoading ? '启用中...' : '启用'}
+              </button>
+            </div>
+          </>
+        ) : (
+          <>
+            <p>启用两步验证后，登录时除密码外还需要输入验证器应用中的验证码。</p>
+            <div className="form-actions">
+              <button
+                type="button"
+                className="save-button"
+                onClick={handleSetup}
+                disabled={isLoading || !status}
+              >
+                {isLoading ? '生成中...' : '设置两步验证'}
+              </button>
+            </div>
+          </>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default TwoFactorSettings;
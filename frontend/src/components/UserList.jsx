@@ -1,5 +1,5 @@
 import { useState, useEffect } from 'react';
-import { getUsers, updateUserInfo, updateUserVip, getUser, deleteUser } from '../utils/api';
+import { getUsers, updateUserInfo, updateUserVip, getUser, deleteUser, unlockUser, resetTwoFactor, reconcileUsers, restoreUser, getDeletedUsers, getVipPlans } from '../utils/api';
 
 const UserList = () => {
   const [users, setUsers] = useState([]);
@@ -10,10 +10,14 @@ const UserList = () => {
   const [error, setError] = useState('');
   const [isEditModalOpen, setIsEditModalOpen] = useState(false);
   const [editingUser, setEditingUser] = useState(null);
+  const [vipPlans, setVipPlans] = useState([]);
+  const [showDeleted, setShowDeleted] = useState(false);
+  const [deletedUsers, setDeletedUsers] = useState([]);
   const [editFormData, setEditFormData] = useState({
     username: '',
     email: '',
-    is_vip: false
+    is_vip: false,
+    plan_id: ''
   });
 
   // 获取用户数据
@@ -32,9 +36,47 @@ const UserList = () => {
     }
   };
   
+  // 获取已删除、尚未彻底清除的用户
+  const fetchDeletedUsers = async () => {
+    setIsLoading(true);
+    setError('');
+
+    try {
+      const usersData = await getDeletedUsers(1, 100);
+      setDeletedUsers(usersData || []);
+    } catch (err) {
+      setError(err.message);
+      console.error('获取已删除用户失败:', err);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  // 切换查看正常用户和已删除用户
+  const toggleDeleted = () => {
+    const next = !showDeleted;
+    setShowDeleted(next);
+    if (next) {
+      fetchDeletedUsers();
+    } else {
+      fetchUsers();
+    }
+  };
+
+  // 获取VIP套餐，开通VIP时按套餐时长计算有效期
+  const fetchVipPlans = async () => {
+    try {
+      const plansData = await getVipPlans();
+      setVipPlans(Array.isArray(plansData) ? plansData : []);
+    } catch (err) {
+      console.error('获取VIP套餐失败:', err);
+    }
+  };
+
   // 初始化时获取用户数据
   useEffect(() => {
     fetchUsers();
+    fetchVipPlans();
   }, []);
 
   // 过滤用户
@@ -50,9 +92,12 @@ const UserList = () => {
 
   // 处理删除用户
   const handleDelete = async (id) => {
-    if (window.confirm('确定要删除这个用户吗？')) {
+    if (window.confirm('确定要删除这个用户吗？删除后用户无法登录，保留期内可恢复，到期后数据将被彻底清除')) {
       try {
-        await deleteUser(id);
+        const result = await deleteUser(id);
+        if (result?.purge_at) {
+          alert(`已删除该用户，将于 ${result.purge_at} 彻底清除`);
+        }
         
         // 重新获取用户列表
         fetchUsers();
@@ -62,6 +107,64 @@ const UserList = () => {
     }
   };
 
+  // 处理恢复已删除的用户
+  const handleRestore = async (id) => {
+    if (window.confirm('确定要恢复这个用户吗？')) {
+      try {
+        await restoreUser(id);
+        alert('已恢复该用户');
+        fetchDeletedUsers();
+      } catch (err) {
+        setError(err.message);
+      }
+    }
+  };
+
+  // 处理解除登录锁定
+  const handleUnlock = async (id) => {
+    try {
+      const result = await unlockUser(id);
+      alert(result?.was_locked ? '已解除该用户的登录锁定' : '该用户当前未被锁定，已清零登录失败次数');
+    } catch (err) {
+      setError(err.message);
+    }
+  };
+
+  // 处理重置两步验证，用于用户丢失验证器和恢复码的情况
+  const handleResetTwoFactor = async (id) => {
+    if (window.confirm('确定要重置该用户的两步验证吗？重置后该用户仅凭密码即可登录')) {
+      try {
+        await resetTwoFactor(id);
+        alert('已重置该用户的两步验证');
+      } catch (err) {
+        setError(err.message);
+      }
+    }
+  };
+
+  // 处理用户对账：先只报告差异，确认后再修复
+  const handleReconcile = async () => {
+    try {
+      const report = await reconcileUsers(true);
+      const drifts = report?.drifts || [];
+      if (drifts.length === 0) {
+        alert(`本地与认证服务的用户数据一致（跳过 ${report?.skipped || 0} 个同步中的用户）`);
+        return;
+      }
+      const summary = drifts.slice(0, 10).map(d => `#${d.user_id} ${d.detail}`).join('\n');
+      const more = drifts.length > 10 ? `\n……共 ${drifts.length} 项` : '';
+      if (!window.confirm(`发现 ${drifts.length} 项差异：\n${summary}${more}\n\n确定要修复吗？`)) {
+        return;
+      }
+      const result = await reconcileUsers(false);
+      const failed = (result?.drifts || []).filter(d => !d.repaired).length;
+      alert(failed > 0 ? `修复完成，${failed} 项修复失败，请查看日志` : '修复完成');
+      fetchUsers();
+    } catch (err) {
+      setError(err.message);
+    }
+  };
+
   // 处理状态切换 - 暂时注释，因为后端不支持此功能
   /*
   const toggleStatus = async (id) => {
@@ -101,7 +204,8 @@ const UserList = () => {
       setEditFormData({
         username: userToEdit.username,
         email: userToEdit.email,
-        is_vip: userToEdit.is_vip
+        is_vip: userToEdit.is_vip,
+        plan_id: vipPlans.length > 0 ? String(vipPlans[0].id) : ''
       });
       setIsEditModalOpen(true);
     }
@@ -116,10 +220,9 @@ const UserList = () => {
       // 更新用户信息
       await updateUserInfo(editingUser.id, editFormData.username, editFormData.email, '');
       
-      // 更新VIP状态（如果有变化）
+      // 更新VIP状态（如果有变化），有效期由所选套餐的时长决定
       if (editFormData.is_vip !== editingUser.is_vip) {
-        const vipExpires = editFormData.is_vip ? new Date(Date.now() + 30 * 24 * 60 * 60 * 1000).toISOString() : '';
-        await updateUserVip(editingUser.id, editFormData.is_vip, vipExpires);
+        await updateUserVip(editingUser.id, editFormData.is_vip, editFormData.is_vip ? editFormData.plan_id : '');
       }
       
       // 重新获取用户列表
@@ -170,6 +273,12 @@ const UserList = () => {
           />
           <button className="search-button">🔍</button>
         </div>
+        <button className="add-user-button" onClick={handleReconcile}>
+          用户对账
+        </button>
+        <button className="add-user-button" onClick={toggleDeleted}>
+          {showDeleted ? '返回用户列表' : '已删除用户'}
+        </button>
         <button className="add-user-button">
           + 添加用户
         </button>
@@ -186,6 +295,46 @@ const UserList = () => {
           <div className="loading-spinner">🔄</div>
           <p>加载用户数据中...</p>
         </div>
+      ) : showDeleted ? (
+        <div className="user-table-container">
+          <table className="user-table">
+            <thead>
+              <tr>
+                <th>ID</th>
+                <th>用户名</th>
+                <th>邮箱</th>
+                <th>删除时间</th>
+                <th>彻底清除时间</th>
+                <th>操作</th>
+              </tr>
+            </thead>
+            <tbody>
+              {deletedUsers.length > 0 ? (
+                deletedUsers.map(user => (
+                  <tr key={user.id} className="user-row">
+                    <td>{user.id}</td>
+                    <td>{user.username}</td>
+                    <td>{user.email}</td>
+                    <td>{user.deleted_at}</td>
+                    <td>{user.purge_at || '-'}</td>
+                    <td className="action-buttons">
+                      <button 
+                        className="edit-button"
+                        onClick={() => handleRestore(user.id)}
+                      >
+                        恢复
+                      </button>
+                    </td>
+                  </tr>
+                ))
+              ) : (
+                <tr>
+                  <td colSpan="6" className="no-data">暂无已删除的用户</td>
+                </tr>
+              )}
+            </tbody>
+          </table>
+        </div>
       ) : (
         <>
           <div className="user-table-container">
@@ -226,6 +375,18 @@ const UserList = () => {
                         >
                           编辑
                         </button>
+                        <button 
+                          className="edit-button"
+                          onClick={() => handleUnlock(user.id)}
+                        >
+                          解锁
+                        </button>
+                        <button 
+                          className="edit-button"
+                          onClick={() => handleResetTwoFactor(user.id)}
+                        >
+                          重置两步验证
+                        </button>
                         <button 
                           className="delete-button"
                           onClick={() => handleDelete(user.id)}
@@ -325,6 +486,24 @@ const UserList = () => {
                 />
                 <label htmlFor="is_vip">VIP会员</label>
               </div>
+              {editFormData.is_vip && !editingUser?.is_vip && (
+                <div className="form-group">
+                  <label htmlFor="plan_id">VIP套餐</label>
+                  <select
+                    id="plan_id"
+                    name="plan_id"
+                    value={editFormData.plan_id}
+                    onChange={handleInputChange}
+                    required
+                  >
+                    {vipPlans.map(plan => (
+                      <option key={plan.id} value={String(plan.id)}>
+                        {plan.name}（{plan.duration_days}天）
+                      </option>
+                    ))}
+                  </select>
+                </div>
+              )}
               <div className="modal-actions">
                 <button type="button" className="cancel-button" onClick={() => setIsEditModalOpen(false)}>
                   取消
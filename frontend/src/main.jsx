@@ -13,6 +13,9 @@ import Settings from './pages/Settings.jsx'
 import Profile from './pages/Profile.jsx'
 import VIP from './pages/VIP.jsx'
 import AI from './pages/AI.jsx'
+import VerifyEmail from './pages/VerifyEmail.jsx'
+import ResetPassword from './pages/ResetPassword.jsx'
+import OAuthCallback from './pages/OAuthCallback.jsx'
 import AuthGuard from './components/AuthGuard.jsx'
 
 createRoot(document.getElementById('root')).render(
@@ -27,6 +30,9 @@ createRoot(document.getElementById('root')).render(
           <Routes>
             {/* 公开路由 */}
             <Route path="/" element={<App />} />
+            <Route path="/verify-email" element={<VerifyEmail />} />
+            <Route path="/reset-password" element={<ResetPassword />} />
+            <Route path="/oauth/callback/:provider" element={<OAuthCallback />} />
             
             {/* 受保护路由 */}
             <Route element={<AuthGuard />}>
@@ -30,6 +30,64 @@ const request = async (url, options = {}) => {
   
   return responseData;
 };
+
+// 流式AI请求（SSE），每收到一段内容调用onDelta，结束时返回最新的使用量
+const streamAIRequest = async (prompt, usageType, onDelta) => {
+  // 用户ID由服务端从token中解析
+  const token = localStorage.getItem('token');
+  if (!token) {
+    throw new Error('未登录');
+  }
+
+  const headers = {
+    'Content-Type': 'application/json',
+    Accept: 'text/event-stream',
+    Authorization: `Bearer ${token}`,
+  };
+
+  const response = await fetch('/api/ai/request/stream', {
+    method: 'POST',
+    headers,
+    body: JSON.stringify({ prompt, usage_type: usageType }),
+  });
+  if (!response.ok || !response.body) {
+    throw new Error(`请求失败: ${response.status}`);
+  }
+
+  const reader = response.body.getReader();
+  const decoder = new TextDecoder();
+  let buffer = '';
+  for (;;) {
+    const { done, value } = await reader.read();
+    if (done) {
+      return null;
+    }
+
+    // SSE事件以空行分隔，最后一段可能不完整，留到下次处理
+    buffer += decoder.decode(value, { stream: true });
+    const events = buffer.split('\n\n');
+    buffer = events.pop();
+
+    for (const event of events) {
+      const dataLine = event.split('\n').find((line) => line.startsWith('data:'));
+      if (!dataLine) {
+        continue;
+      }
+
+      const chunk = JSON.parse(dataLine.slice(5).trim());
+      if (chunk.code) {
+        throw new Error(chunk.message || `请求失败: ${chunk.code}`);
+      }
+      if (chunk.delta) {
+        onDelta(chunk.delta);
+      }
+      if (chunk.done) {
+        return chunk.usage || null;
+      }
+    }
+  }
+};
+
 // AI使用限制常量
 const AI_LIMITS = {
   // 普通用户限制
@@ -68,7 +126,7 @@ const fetchAIUsage = async () => {
       chat: apiData.ai_chat_count || 0,
       generate_content: apiData.ai_content_count || 0,
       analysis: apiData.ai_analysis_count || 0,
-      resetAt: apiData.ai_last_reset_at || new Date().toISOString(), // 映射ai_last_reset_at到resetAt
+      resetAt: apiData.next_reset_at || new Date().toISOString(), // 下次重置时间，按用户时区和套餐的重置策略计算
       chatLimit: getAILimits(apiData.is_vip || false).chat,
       contentLimit: getAILimits(apiData.is_vip || false).generate_content,
       analysisLimit: getAILimits(apiData.is_vip || false).analysis,
@@ -97,40 +155,6 @@ const fetchAIUsage = async () => {
   }
 };
 
-// 更新AI使用次数
-const updateAIUsage = async (usageType) => {
-  try {
-    // 从localStorage获取userId
-    const userId = localStorage.getItem('userId');
-    if (!userId) {
-      throw new Error('未登录');
-    }
-    
-    const response = await request(`/api/ai/usage/${userId}`, {
-      method: 'POST',
-      body: JSON.stringify({ usage_type: usageType })
-    });
-    return response.data;
-  } catch (error) {
-    console.error('更新AI使用次数失败:', error);
-    // 失败时使用模拟更新
-    const currentUsage = JSON.parse(localStorage.getItem('aiUsage')) || {
-      chat: 0,
-      generate_content: 0,
-      analysis: 0,
-      resetAt: new Date(Date.now() + 30 * 24 * 60 * 60 * 1000).toISOString()
-    };
-    
-    const updatedUsage = {
-      ...currentUsage,
-      [usageType]: currentUsage[usageType] + 1
-    };
-    
-    localStorage.setItem('aiUsage', JSON.stringify(updatedUsage));
-    return updatedUsage;
-  }
-};
-
 const AI = () => {
   const [activeTab, setActiveTab] = useState('assistant');
   const [messages, setMessages] = useState([
@@ -219,29 +243,33 @@ const AI = () => {
       sender: 'user',
       time: new Date().toLocaleTimeString()
     };
-    setMessages([...messages, newUserMessage]);
+    const aiMessageId = messages.length + 2;
+    setMessages([
+      ...messages,
+      newUserMessage,
+      {
+        id: aiMessageId,
+        content: '',
+        sender: 'ai',
+        time: new Date().toLocaleTimeString()
+      }
+    ]);
     setInputValue('');
     setIsLoading(true);
 
+    // 将流式返回的内容追加到AI消息中
+    const appendToAIMessage = (delta) => {
+      setMessages(prev => prev.map(message => (
+        message.id === aiMessageId
+          ? { ...message, content: message.content + delta }
+          : message
+      )));
+    };
+
     try {
-      // 更新AI使用次数
-      await updateAIUsage('chat');
-      
-      // 调用AI API
-      const response = await fetch(`https://api.52vmy.cn/api/chat/spark?msg=${encodeURIComponent(inputValue.trim())}`);
-      const data = await response.json();
-      
-      // 添加AI回复
-      if (data.code === 200) {
-        const newAIMessage = {
-          id: messages.length + 2,
-          content: data.data.answer,
-          sender: 'ai',
-          time: new Date().toLocaleTimeString()
-        };
-        setMessages(prev => [...prev, newAIMessage]);
-      }
-      
+      // 调用后端流式AI接口，使用次数由后端在本次对话结束时扣减
+      await streamAIRequest(newUserMessage.content, 'chat', appendToAIMessage);
+
       // 更新AI使用情况
       const usage = await fetchAIUsage();
       if (usage) {
@@ -249,13 +277,11 @@ const AI = () => {
       }
     } catch (error) {
       console.error('AI API调用失败:', error);
-      const errorMessage = {
-        id: messages.length + 2,
-        content: '抱歉，AI服务暂时不可用，请稍后再试。',
-        sender: 'ai',
-        time: new Date().toLocaleTimeString()
-      };
-      setMessages(prev => [...prev, errorMessage]);
+      setMessages(prev => prev.map(message => (
+        message.id === aiMessageId && !message.content
+          ? { ...message, content: '抱歉，AI服务暂时不可用，请稍后再试。' }
+          : message
+      )));
     } finally {
       setIsLoading(false);
     }
@@ -282,19 +308,16 @@ const AI = () => {
     setGeneratedContent('正在生成内容...');
 
     try {
-      // 更新AI使用次数
-      await updateAIUsage('generate_content');
-      
       // 构建生成内容的prompt
       const prompt = `生成一篇${contentLength}字的${contentType}，主题是${contentTopic}${contentKeywords ? `，关键词包括：${contentKeywords}` : ''}。`;
       
-      // 调用AI API
-      const response = await fetch(`https://api.52vmy.cn/api/chat/spark?msg=${encodeURIComponent(prompt)}`);
-      const data = await response.json();
-      
-      if (data.code === 200) {
-        setGeneratedContent(data.data.answer);
-      } else {
+      // 调用后端流式AI接口，边生成边显示
+      let generated = '';
+      await streamAIRequest(prompt, 'content', (delta) => {
+        generated += delta;
+        setGeneratedContent(generated);
+      });
+      if (!generated) {
         setGeneratedContent('生成失败，请稍后重试。');
       }
       
@@ -0,0 +1,151 @@
+import { useEffect, useRef, useState } from 'react';
+import { useParams, useSearchParams } from 'react-router-dom';
+import { FaSmile, FaRegMeh, FaShieldAlt } from 'react-icons/fa';
+import { oauthLogin, linkUserIdentity, verifyTwoFactorLogin } from '../utils/api';
+import '../components/AuthMacaron.css';
+import '../App.css';
+
+// 保存登录结果并进入控制台，与密码登录一致
+const saveLogin = (loginData) => {
+  localStorage.setItem('token', loginData.token);
+  localStorage.setItem('refresh_token', loginData.refresh_token);
+  localStorage.setItem('user', JSON.stringify(loginData.user));
+  localStorage.setItem('userId', loginData.user.id);
+  window.location.href = '/dashboard';
+};
+
+// 第三方授权后的回调页：根据发起时的意图完成登录或绑定
+const OAuthCallback = () => {
+  const { provider } = useParams();
+  const [searchParams] = useSearchParams();
+  const code = searchParams.get('code') || '';
+  const state = searchParams.get('state') || '';
+  const denied = searchParams.get('error');
+  const [status, setStatus] = useState(code && state ? 'processing' : 'error');
+  const [message, setMessage] = useState(
+    code && state ? '正在完成授权...' : denied ? '已取消授权' : '授权回调无效'
+  );
+  // 开启两步验证时的挑战令牌
+  const [challengeToken, setChallengeToken] = useState('');
+  const [twoFactorCode, setTwoFactorCode] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+  // 授权码只能使用一次，避免开发模式下effect执行两次导致第二次失败
+  const submitted = useRef(false);
+
+  useEffect(() => {
+    if (!code || !state || submitted.current) {
+      return;
+    }
+    submitted.current = true;
+
+    const intent = sessionStorage.getItem('oauth_intent');
+    sessionStorage.removeItem('oauth_intent');
+
+    if (intent === 'link') {
+      linkUserIdentity(localStorage.getItem('userId'), provider, code, state)
+        .then(() => {
+          setStatus('success');
+          setMessage('绑定成功，正在返回...');
+          setTimeout(() => {
+            window.location.href = '/profile?tab=identities';
+          }, 1500);
+        })
+        .catch((err) => {
+          setStatus('error');
+          setMessage(err.message);
+        });
+      return;
+    }
+
+    oauthLogin(provider, code, state)
+      .then((loginData) => {
+        if (loginData.two_factor_required) {
+          setChallengeToken(loginData.challenge_token);
+          setStatus('twoFactor');
+          setMessage('');
+          return;
+        }
+        setStatus('success');
+        setMessage('登录成功，正在跳转...');
+        saveLogin(loginData);
+      })
+      .catch((err) => {
+        setStatus('error');
+        setMessage(err.message);
+      });
+  }, [provider, code, state]);
+
+  const handleVerify = async (e) => {
+    e.preventDefault();
+    setMessage('');
+    setIsLoading(true);
+    try {
+      const loginData = await verifyTwoFactorLogin(challengeToken, twoFactorCode.trim());
+      setStatus('success');
+      setMessage('登录成功，正在跳转...');
+      saveLogin(loginData);
+    } catch (err) {
+      setMessage(err.message);
+      // 挑战过期或错误次数过多时需要重新登录
+      if (/重新登录/.test(err.message)) {
+        setStatus('error');
+      }
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  return (
+    <div className="app-container">
+      <main className="app-main">
+        <div className="auth-card-wrapper">
+          <div className="auth-content">
+            <div className="auth-container-macaron">
+              <h2 className="auth-title-macaron">第三方登录</h2>
+
+              {status === 'twoFactor' && (
+                <form onSubmit={handleVerify} className="auth-form-macaron">
+                  <div className="form-group-macaron">
+                    <label htmlFor="code" className="form-label-macaron">
+                      <FaShieldAlt className="label-icon" />
+                      两步验证码
+                    </label>
+                    <input
+                      type="text"
+                      id="code"
+                      value={twoFactorCode}
+                      onChange={(e) => setTwoFactorCode(e.target.value)}
+                      required
+                      autoFocus
+                      autoComplete="one-time-code"
+                      placeholder="验证器中的6位数字或恢复码"
+                      className="form-input-macaron"
+                    />
+                  </div>
+                  <button type="submit" className="submit-button-macaron" disabled={isLoading}>
+                    {isLoading ? '验证中...' : '验证'}
+                  </button>
+                </form>
+              )}
+
+              {message && (
+                <div className={`message-macaron ${status === 'error' || status === 'twoFactor' ? 'error-macaron' : 'success-macaron'}`}>
+                  {status === 'error' || status === 'twoFactor' ? <FaRegMeh className="message-icon" /> : <FaSmile className="message-icon" />}
+                  <span>{message}</span>
+                </div>
+              )}
+
+              <div className="auth-footer-macaron">
+                <p>
+                  <a className="auth-link-macaron" href="/">返回登录</a>
+                </p>
+              </div>
+            </div>
+          </div>
+        </div>
+      </main>
+    </div>
+  );
+};
+
+export default OAuthCallback;
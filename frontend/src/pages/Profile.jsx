@@ -1,6 +1,10 @@
 import { useState, useEffect } from 'react';
 import { FaCrown, FaStar } from 'react-icons/fa';
 import { Typography } from 'antd';
+import TwoFactorSettings from '../components/TwoFactorSettings';
+import LinkedAccounts from '../components/LinkedAccounts';
+import DataExport from '../components/DataExport';
+import AccountDeletion from '../components/AccountDeletion';
 
 const { Title, Paragraph } = Typography;
 
@@ -60,7 +64,8 @@ const Profile = () => {
   useEffect(() => {
     fetchUserInfo();
   }, []);
-  const [activeTab, setActiveTab] = useState('info');
+  // 绑定第三方账号后通过 ?tab= 回到对应标签页
+  const [activeTab, setActiveTab] = useState(() => new URLSearchParams(window.location.search).get('tab') || 'info');
   const [isEditing, setIsEditing] = useState(false);
   const [isSaving, setIsSaving] = useState(false);
   const [saveSuccess, setSaveSuccess] = useState('');
@@ -177,6 +182,10 @@ const Profile = () => {
   const tabs = [
     { id: 'info', name: '个人信息', icon: '👤' },
     { id: 'password', name: '修改密码', icon: '🔒' },
+    { id: 'twoFactor', name: '两步验证', icon: '🛡️' },
+    { id: 'identities', name: '第三方账号', icon: '🔗' },
+    { id: 'export', name: '导出数据', icon: '📦' },
+    { id: 'deletion', name: '注销账号', icon: '🗑️' },
     { id: 'activity', name: '活动记录', icon: '📋' }
   ];
   
@@ -442,6 +451,26 @@ const Profile = () => {
                 </div>
               )}
               
+              {/* 两步验证 */}
+              {activeTab === 'twoFactor' && (
+                <TwoFactorSettings userId={user.id} />
+              )}
+              
+              {/* 第三方账号 */}
+              {activeTab === 'identities' && (
+                <LinkedAccounts userId={user.id} />
+              )}
+              
+              {/* 导出数据 */}
+              {activeTab === 'export' && (
+                <DataExport userId={user.id} />
+              )}
+              
+              {/* 注销账号 */}
+              {activeTab === 'deletion' && (
+                <AccountDeletion userId={user.id} />
+              )}
+              
               {/* 活动记录 */}
               {activeTab === 'activity' && (
                 <div className="profile-section">
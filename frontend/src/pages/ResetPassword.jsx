@@ -0,0 +1,122 @@
+import { useState } from 'react';
+import { useSearchParams } from 'react-router-dom';
+import { FaLock, FaSmile, FaRegMeh } from 'react-icons/fa';
+import { resetPassword } from '../utils/api';
+import '../components/AuthMacaron.css';
+import '../App.css';
+
+const ResetPassword = () => {
+  const [searchParams] = useSearchParams();
+  const token = searchParams.get('token') || '';
+  const [password, setPassword] = useState('');
+  const [confirmPassword, setConfirmPassword] = useState('');
+  const [error, setError] = useState('');
+  const [success, setSuccess] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+
+  const handleSubmit = async (e) => {
+    e.preventDefault();
+    setError('');
+    setSuccess('');
+
+    if (password.length < 6) {
+      setError('密码长度不能少于6位');
+      return;
+    }
+    if (password !== confirmPassword) {
+      setError('两次输入的密码不一致');
+      return;
+    }
+
+    setIsLoading(true);
+    try {
+      await resetPassword(token, password);
+      setSuccess('密码已重置，正在跳转到登录页面...');
+      setTimeout(() => {
+        window.location.href = '/';
+      }, 1500);
+    } catch (err) {
+      setError(err.message);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  return (
+    <div className="app-container">
+      <main className="app-main">
+        <div className="auth-card-wrapper">
+          <div className="auth-content">
+            <div className="auth-container-macaron">
+              <h2 className="auth-title-macaron">重置密码</h2>
+              {!token ? (
+                <div className="message-macaron error-macaron">
+                  <FaRegMeh className="message-icon" />
+                  <span>重置链接无效，请重新申请</span>
+                </div>
+              ) : (
+                <form onSubmit={handleSubmit} className="auth-form-macaron">
+                  <div className="form-group-macaron">
+                    <label htmlFor="new-password" className="form-label-macaron">
+                      <FaLock className="label-icon" />
+                      新密码
+                    </label>
+                    <input
+                      type="password"
+                      id="new-password"
+                      value={password}
+                      onChange={(e) => setPassword(e.target.value)}
+                      required
+                      placeholder="至少6位"
+                      className="form-input-macaron"
+                    />
+                  </div>
+
+                  <div className="form-group-macaron">
+                    <label htmlFor="confirm-new-password" className="form-label-macaron">
+                      <FaLock className="label-icon" />
+                      确认新密码
+                    </label>
+                    <input
+                      type="password"
+                      id="confirm-new-password"
+                      value={confirmPassword}
+                      onChange={(e) => setConfirmPassword(e.target.value)}
+                      required
+                      placeholder="再次输入新密码"
+                      className="form-input-macaron"
+                    />
+                  </div>
+
+                  {error && (
+                    <div className="message-macaron error-macaron">
+                      <FaRegMeh className="message-icon" />
+                      <span>{error}</span>
+                    </div>
+                  )}
+
+                  {success && (
+                    <div className="message-macaron success-macaron">
+                      <FaSmile className="message-icon" />
+                      <span>{success}</span>
+                    </div>
+                  )}
+
+                  <button
+                    type="submit"
+                    className="submit-button-macaron"
+                    disabled={isLoading}
+                  >
+                    {isLoading ? <span className="button-loading">提交中...</span> : '设置新密码'}
+                  </button>
+                </form>
+              )}
+            </div>
+          </div>
+        </div>
+      </main>
+    </div>
+  );
+};
+
+export default ResetPassword;
@@ -1,10 +1,29 @@
 import { useState, useEffect, useCallback } from 'react';
 import { FaCrown, FaStar } from 'react-icons/fa';
 import { Typography } from 'antd';
-import { getVipOrders, getVipHistory, createVipOrder, getVipPlans, getUserInfo, updateAutoRenew } from '../utils/api';
+import { getVipOrders, getVipHistory, createVipOrder, createCreditPackOrder, payVipOrder, getVipPlans, getCreditPacks, getUserInfo, updateAutoRenew } from '../utils/api';
 
 const { Title, Paragraph } = Typography;
 
+// 模型等级名称
+const modelTierNames = {
+  basic: '基础模型',
+  advanced: '高级模型',
+  premium: '旗舰模型'
+};
+
+// 将套餐介绍和AI权益转换为展示用的文字列表
+const formatPlanFeatures = (plan) => {
+  const items = (plan.description || '').split('\n').filter(Boolean).map(line => `✅ ${line}`);
+  const { quotas = {}, model_tiers: modelTiers = [], max_tokens: maxTokens } = plan.features || {};
+  if (quotas.chat !== undefined) items.push(`✅ 每月AI聊天${quotas.chat}次`);
+  if (quotas.content !== undefined) items.push(`✅ 每月AI内容生成${quotas.content}次`);
+  if (quotas.analysis !== undefined) items.push(`✅ 每月AI数据分析${quotas.analysis}次`);
+  if (modelTiers.length > 0) items.push(`✅ 可用${modelTiers.map(tier => modelTierNames[tier] || tier).join('、')}`);
+  if (maxTokens) items.push(`✅ 单次回复最多${maxTokens} tokens`);
+  return items;
+};
+
 const VIP = () => {
   const [user, setUser] = useState(() => {
     try {
@@ -68,6 +87,7 @@ const VIP = () => {
 
   // 自动续期状态
   const [autoRenew, setAutoRenew] = useState(false);
+  const [creditPacks, setCreditPacks] = useState([]);
   
   // 标签页状态管理
   const [activeTab, setActiveTab] = useState('status');
@@ -142,11 +162,11 @@ const VIP = () => {
       const plansData = await getVipPlans();
       console.log('获取到的VIP套餐数据:', plansData);
       if (plansData && Array.isArray(plansData)) {
-        // 确保每个套餐都有features属性，并且是数组
+        // 将套餐权益转换为展示用的文字列表
         const formattedPlans = plansData.map(plan => ({
           ...plan,
-          // 处理features：确保是数组，如果是字符串则解析
-          features: typeof plan.features === 'string' ? JSON.parse(plan.features) : (plan.features || [])
+          duration: plan.duration_days,
+          features: formatPlanFeatures(plan)
         }));
         setVipPlans(formattedPlans);
       }
@@ -155,11 +175,24 @@ const VIP = () => {
     }
   }, []);
 
-  // 组件加载时获取最新用户信息和套餐列表
+  // 获取AI额度加油包列表
+  const fetchCreditPacks = useCallback(async () => {
+    try {
+      const packsData = await getCreditPacks();
+      if (Array.isArray(packsData)) {
+        setCreditPacks(packsData);
+      }
+    } catch (error) {
+      console.error('获取加油包列表失败:', error);
+    }
+  }, []);
+
+  // 组件加载时获取最新用户信息、套餐和加油包列表
   useEffect(() => {
     fetchUserInfo();
     fetchPlans();
-  }, [fetchUserInfo, fetchPlans]);
+    fetchCreditPacks();
+  }, [fetchUserInfo, fetchPlans, fetchCreditPacks]);
 
   // 标签页切换时获取对应数据
   useEffect(() => {
@@ -179,12 +212,14 @@ const VIP = () => {
       // 创建VIP订单
       console.log('创建VIP订单:', plan.id);
       const order = await createVipOrder(user.id, plan.id);
-      
-      // 刷新用户信息
-      await fetchUserInfo();
-      
-      // 显示成功提示
-      alert(`成功创建 ${plan.name} 计划订单！订单号：${order.order_no || 'N/A'}`);
+
+      // 发起支付，跳转到支付页面；支付结果由支付回调更新
+      const payment = await payVipOrder(user.id, order.order_no);
+      if (payment.pay_url) {
+        window.open(payment.pay_url, '_blank');
+      }
+
+      alert(`已创建 ${plan.name} 计划订单，请在新窗口完成支付。订单号：${order.order_no}`);
       
     } catch (error) {
       console.error('订阅失败:', error);
@@ -194,6 +229,28 @@ const VIP = () => {
     }
   };
 
+  // 购买加油包，支付完成后额度在每月AI额度用完后自动抵扣
+  const handleBuyCreditPack = async (pack) => {
+    try {
+      setIsLoading(true);
+
+      if (!user) return;
+
+      const order = await createCreditPackOrder(user.id, pack.id);
+      const payment = await payVipOrder(user.id, order.order_no);
+      if (payment.pay_url) {
+        window.open(payment.pay_url, '_blank');
+      }
+
+      alert(`已创建 ${pack.name} 订单，请在新窗口完成支付。订单号：${order.order_no}`);
+    } catch (error) {
+      console.error('购买加油包失败:', error);
+      alert(`购买失败：${error.message}`);
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
   if (!user) {
     return (
       <div className="loading-container">
@@ -349,6 +406,36 @@ const VIP = () => {
                   </div>
                 ))}
               </div>
+
+              {creditPacks.length > 0 && (
+                <>
+                  <h2>AI额度加油包</h2>
+                  <Paragraph type="secondary">每月AI额度用完后自动抵扣加油包额度</Paragraph>
+                  <div className="vip-plans-grid">
+                    {creditPacks.map((pack) => (
+                      <div key={pack.id} className="vip-plan-card">
+                        <div className="plan-header">
+                          <h3>{pack.name}</h3>
+                          <div className="plan-price">
+                            <span className="price">¥{parseFloat(pack.price).toFixed(2)}</span>
+                          </div>
+                        </div>
+                        <div className="plan-features">
+                          <div className="feature-item">{pack.credits}次AI调用</div>
+                          <div className="feature-item">购买后{pack.valid_days}天内有效</div>
+                          {pack.description && <div className="feature-item">{pack.description}</div>}
+                        </div>
+                        <button
+                          className="subscribe-button"
+                          onClick={() => handleBuyCreditPack(pack)}
+                        >
+                          立即购买
+                        </button>
+                      </div>
+                    ))}
+                  </div>
+                </>
+              )}
             </div>
           )}
           
@@ -367,7 +454,7 @@ const VIP = () => {
                     <thead>
                       <tr>
                         <th>订单号</th>
-                        <th>套餐名称</th>
+                        <th>商品名称</th>
                         <th>金额</th>
                         <th>支付状态</th>
                         <th>创建时间</th>
@@ -0,0 +1,57 @@
+import { useEffect, useRef, useState } from 'react';
+import { useSearchParams } from 'react-router-dom';
+import { FaSmile, FaRegMeh } from 'react-icons/fa';
+import { verifyEmail } from '../utils/api';
+import '../components/AuthMacaron.css';
+import '../App.css';
+
+const VerifyEmail = () => {
+  const [searchParams] = useSearchParams();
+  const token = searchParams.get('token') || '';
+  const [status, setStatus] = useState(token ? 'verifying' : 'error');
+  const [message, setMessage] = useState(token ? '正在验证邮箱...' : '验证链接无效');
+  // 令牌只能使用一次，避免开发模式下effect执行两次导致第二次验证失败
+  const submitted = useRef(false);
+
+  useEffect(() => {
+    if (!token || submitted.current) {
+      return;
+    }
+    submitted.current = true;
+
+    verifyEmail(token)
+      .then((data) => {
+        setStatus('success');
+        setMessage(`邮箱 ${data?.email || ''} 验证成功`);
+      })
+      .catch((err) => {
+        setStatus('error');
+        setMessage(err.message);
+      });
+  }, [token]);
+
+  return (
+    <div className="app-container">
+      <main className="app-main">
+        <div className="auth-card-wrapper">
+          <div className="auth-content">
+            <div className="auth-container-macaron">
+              <h2 className="auth-title-macaron">邮箱验证</h2>
+              <div className={`message-macaron ${status === 'error' ? 'error-macaron' : 'success-macaron'}`}>
+                {status === 'error' ? <FaRegMeh className="message-icon" /> : <FaSmile className="message-icon" />}
+                <span>{message}</span>
+              </div>
+              <div className="auth-footer-macaron">
+                <p>
+                  <a className="auth-link-macaron" href="/">返回登录</a>
+                </p>
+              </div>
+            </div>
+          </div>
+        </div>
+      </main>
+    </div>
+  );
+};
+
+export default VerifyEmail;
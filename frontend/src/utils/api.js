@@ -8,8 +8,40 @@ const getToken = () => {
   return localStorage.getItem('token');
 };
 
-// 通用请求函数
-const request = async (url, options = {}) => {
+// 使用刷新令牌换取新的访问Token，并发请求共用同一次刷新
+let refreshing = null;
+const refreshAccessToken = () => {
+  if (!refreshing) {
+    refreshing = (async () => {
+      const refreshToken = localStorage.getItem('refresh_token');
+      if (!refreshToken) {
+        return false;
+      }
+
+      const response = await fetch(`${BASE_URL}/api/user/refresh`, {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ refresh_token: refreshToken }),
+      });
+      const responseData = await response.json().catch(() => ({}));
+      if (!response.ok || !responseData.success) {
+        localStorage.removeItem('token');
+        localStorage.removeItem('refresh_token');
+        return false;
+      }
+
+      localStorage.setItem('token', responseData.data.token);
+      localStorage.setItem('refresh_token', responseData.data.refresh_token);
+      return true;
+    })().finally(() => {
+      refreshing = null;
+    });
+  }
+  return refreshing;
+};
+
+// 通用请求函数，访问Token过期时自动刷新并重试一次
+const request = async (url, options = {}, retried = false) => {
   const token = getToken();
   
   const headers = {
@@ -25,6 +57,10 @@ const request = async (url, options = {}) => {
     ...options,
     headers,
   });
+
+  if (response.status === 401 && token && !retried && await refreshAccessToken()) {
+    return request(url, options, true);
+  }
   
   const responseData = await response.json().catch(() => ({}));
   
@@ -77,14 +113,39 @@ export const getVipHistory = async (userId, page = 1, pageSize = 10) => {
   });
 };
 
-// 创建VIP订单
-export const createVipOrder = async (userId, planId) => {
+// 创建VIP订单，idempotencyKey 用于重试时避免重复下单
+export const createVipOrder = async (userId, planId, idempotencyKey = crypto.randomUUID()) => {
   return request(`/api/user/${userId}/vip/orders`, {
     method: 'POST',
+    headers: { 'Idempotency-Key': idempotencyKey },
     body: JSON.stringify({ plan_id: planId }),
   });
 };
 
+// 创建AI额度加油包订单，与VIP订单使用相同的支付流程
+export const createCreditPackOrder = async (userId, creditPackId, idempotencyKey = crypto.randomUUID()) => {
+  return request(`/api/user/${userId}/vip/orders`, {
+    method: 'POST',
+    headers: { 'Idempotency-Key': idempotencyKey },
+    body: JSON.stringify({ product_type: 'credit_pack', credit_pack_id: creditPackId }),
+  });
+};
+
+// 发起订单支付，返回支付链接或二维码内容
+export const payVipOrder = async (userId, orderNo, payMethod = '') => {
+  return request(`/api/user/${userId}/vip/orders/${orderNo}/pay`, {
+    method: 'POST',
+    body: JSON.stringify({ pay_method: payMethod }),
+  });
+};
+
+// 取消待支付订单
+export const cancelVipOrder = async (userId, orderNo) => {
+  return request(`/api/user/${userId}/vip/orders/${orderNo}/cancel`, {
+    method: 'POST',
+  });
+};
+
 // 获取VIP套餐列表
 export const getVipPlans = async () => {
   return request('/api/vip/plans', {
@@ -92,6 +153,20 @@ export const getVipPlans = async () => {
   });
 };
 
+// 获取AI额度加油包列表
+export const getCreditPacks = async () => {
+  return request('/api/vip/credit-packs', {
+    method: 'GET',
+  });
+};
+
+// 获取当前用户可用的AI额度
+export const getAICredits = async () => {
+  return request('/api/ai/credits', {
+    method: 'GET',
+  });
+};
+
 // 获取用户VIP状态
 export const getUserVipStatus = async (userId) => {
   return request(`/api/user/${userId}/vip`, {
@@ -129,6 +204,173 @@ export const login = async (username, email, password) => {
   });
 };
 
+// 登录第二步：提交两步验证码或恢复码
+export const verifyTwoFactorLogin = async (challengeToken, code) => {
+  return request('/api/user/login/2fa', {
+    method: 'POST',
+    body: JSON.stringify({ challenge_token: challengeToken, code }),
+  });
+};
+
+// 获取第三方登录提供方API
+export const getOAuthProviders = async () => {
+  return request('/api/oauth/providers', {
+    method: 'GET',
+  });
+};
+
+// 获取第三方登录授权页地址API
+export const authorizeOAuth = async (provider) => {
+  return request(`/api/oauth/${provider}/authorize`, {
+    method: 'POST',
+  });
+};
+
+// 第三方授权后完成登录API，code和state来自回调地址
+export const oauthLogin = async (provider, code, state) => {
+  return request(`/api/oauth/${provider}/login`, {
+    method: 'POST',
+    body: JSON.stringify({ code, state }),
+  });
+};
+
+// 获取绑定的第三方账号API
+export const getUserIdentities = async (userId) => {
+  return request(`/api/user/${userId}/identities`, {
+    method: 'GET',
+  });
+};
+
+// 获取绑定第三方账号的授权页地址API
+export const authorizeUserIdentity = async (userId, provider) => {
+  return request(`/api/user/${userId}/identities/${provider}/authorize`, {
+    method: 'POST',
+  });
+};
+
+// 第三方授权后完成绑定API，code和state来自回调地址
+export const linkUserIdentity = async (userId, provider, code, state) => {
+  return request(`/api/user/${userId}/identities/${provider}/link`, {
+    method: 'POST',
+    body: JSON.stringify({ code, state }),
+  });
+};
+
+// 解绑第三方账号API
+export const unlinkUserIdentity = async (userId, provider) => {
+  return request(`/api/user/${userId}/identities/${provider}`, {
+    method: 'DELETE',
+  });
+};
+
+// 获取两步验证状态API
+export const getTwoFactorStatus = async (userId) => {
+  return request(`/api/user/${userId}/2fa`, {
+    method: 'GET',
+  });
+};
+
+// 生成两步验证密钥API，返回密钥和用于生成二维码的otpauth地址
+export const setupTwoFactor = async (userId) => {
+  return request(`/api/user/${userId}/2fa/setup`, {
+    method: 'POST',
+  });
+};
+
+// 启用两步验证API，返回一次性恢复码
+export const enableTwoFactor = async (userId, code) => {
+  return request(`/api/user/${userId}/2fa/enable`, {
+    method: 'POST',
+    body: JSON.stringify({ code }),
+  });
+};
+
+// 关闭两步验证API，需要验证码或恢复码
+export const disableTwoFactor = async (userId, code) => {
+  return request(`/api/user/${userId}/2fa/disable`, {
+    method: 'POST',
+    body: JSON.stringify({ code }),
+  });
+};
+
+// 验证邮箱API，token来自验证邮件中的链接
+export const verifyEmail = async (token) => {
+  return request('/api/user/verify-email', {
+    method: 'POST',
+    body: JSON.stringify({ token }),
+  });
+};
+
+// 重新发送验证邮件API
+export const sendVerificationEmail = async (userId) => {
+  return request(`/api/user/${userId}/verify-email/send`, {
+    method: 'POST',
+  });
+};
+
+// 忘记密码API，发送重置密码邮件
+export const forgotPassword = async (email) => {
+  return request('/api/user/password/forgot', {
+    method: 'POST',
+    body: JSON.stringify({ email }),
+  });
+};
+
+// 重置密码API，token来自重置密码邮件中的链接
+export const resetPassword = async (token, newPassword) => {
+  return request('/api/user/password/reset', {
+    method: 'POST',
+    body: JSON.stringify({ token, new_password: newPassword }),
+  });
+};
+
+// 获取注销申请API
+export const getAccountDeletion = async (userId) => {
+  return request(`/api/user/${userId}/deletion`, {
+    method: 'GET',
+  });
+};
+
+// 申请注销账号API
+export const requestAccountDeletion = async (userId, password) => {
+  return request(`/api/user/${userId}/deletion`, {
+    method: 'POST',
+    body: JSON.stringify({ password }),
+  });
+};
+
+// 撤销注销申请API
+export const cancelAccountDeletion = async (userId) => {
+  return request(`/api/user/${userId}/deletion`, {
+    method: 'DELETE',
+  });
+};
+
+// 申请导出个人数据API，已有进行中或未过期的导出时直接返回；fresh为true时重新生成
+export const startDataExport = async (userId, fresh = false) => {
+  return request(`/api/user/${userId}/export${fresh ? '?fresh=true' : ''}`, {
+    method: 'GET',
+  });
+};
+
+// 查询个人数据导出状态API，已生成时返回新签发的下载链接
+export const getDataExport = async (userId, exportId) => {
+  return request(`/api/user/${userId}/export/${exportId}`, {
+    method: 'GET',
+  });
+};
+
+// 导出文件的下载地址，下载链接自带签名，无需携带登录凭证
+export const dataExportDownloadUrl = (downloadUrl) => `${BASE_URL}${downloadUrl}`;
+
+// 登出API，同时吊销刷新令牌
+export const logout = async () => {
+  return request('/api/user/logout', {
+    method: 'POST',
+    body: JSON.stringify({ refresh_token: localStorage.getItem('refresh_token') || '' }),
+  });
+};
+
 // 更新用户信息API
 export const updateUserInfo = async (userId, username, email, avatar) => {
   return request(`/api/user/${userId}`, {
@@ -175,10 +417,46 @@ export const deleteUser = async (userId) => {
 };
 
 // 更新用户VIP状态API
-export const updateUserVip = async (userId, isVip, vipExpires) => {
+export const updateUserVip = async (userId, isVip, planId) => {
   return request(`/api/user/${userId}/vip`, {
     method: 'POST',
-    body: JSON.stringify({ is_vip: isVip, vip_expires: vipExpires }),
+    body: JSON.stringify({ is_vip: isVip, plan_id: planId }),
+  });
+};
+
+// 解除用户登录锁定API
+export const unlockUser = async (userId) => {
+  return request(`/api/user/${userId}/unlock`, {
+    method: 'POST',
+  });
+};
+
+// 用户对账API，dryRun为true时只报告差异不修复
+export const reconcileUsers = async (dryRun) => {
+  return request('/api/users/reconcile', {
+    method: 'POST',
+    body: JSON.stringify({ dry_run: dryRun }),
+  });
+};
+
+// 重置用户两步验证API
+export const resetTwoFactor = async (userId) => {
+  return request(`/api/user/${userId}/2fa/reset`, {
+    method: 'POST',
+  });
+};
+
+// 恢复已删除的用户API
+export const restoreUser = async (userId) => {
+  return request(`/api/user/${userId}/restore`, {
+    method: 'POST',
+  });
+};
+
+// 获取已删除用户列表API
+export const getDeletedUsers = async (page = 1, pageSize = 10) => {
+  return request(`/api/users/deleted?page=${page}&page_size=${pageSize}`, {
+    method: 'GET',
   });
 };
 
@@ -197,9 +475,10 @@ export const getVipPlan = async (planId) => {
 };
 
 // 创建VIP套餐API
-export const createVipPlan = async (name, description, price, durationDays) => {
+// features: { quotas: { chat, content, analysis }, model_tiers: ['basic', ...], max_tokens }
+export const createVipPlan = async (name, description, price, durationDays, features) => {
   return request('/api/vip/plans', {
     method: 'POST',
-    body: JSON.stringify({ name, description, price, duration_days: durationDays }),
+    body: JSON.stringify({ name, description, price, duration_days: durationDays, features }),
   });
 };
@@ -1,40 +1,70 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
+// 主服务配置文件，从中读取认证后端
+const rpcConfigFile = "backend/rpc/etc/super.yaml"
+
+// 外部Auth服务目录，可通过环境变量 AUTH_SERVICE_DIR 修改
+const defaultAuthServiceDir = "/Users/admin/Documents/SuperAI_WebProject/SuperAI_WebProject_Auth_副本/rpc"
+
+type serviceDef struct {
+	name    string
+	path    string
+	command []string
+	delay   time.Duration
+}
+
 func main() {
+	// 在项目根目录运行：go run super.go
+	root, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("无法获取当前目录: %v\n", err)
+	}
+
 	// 定义服务列表
-	services := []struct {
-		name    string
-		path    string
-		command []string
-		delay   time.Duration
-	}{
-		{
+	var services []serviceDef
+
+	// 认证后端为local时主服务使用本地用户表，不需要启动外部Auth服务
+	authBackend := readAuthBackend(filepath.Join(root, rpcConfigFile))
+	if authBackend == "local" {
+		fmt.Println("AuthBackend为local，跳过Auth Service")
+	} else {
+		authDir := os.Getenv("AUTH_SERVICE_DIR")
+		if authDir == "" {
+			authDir = defaultAuthServiceDir
+		}
+		services = append(services, serviceDef{
 			name:    "Auth Service",
-			path:    "/Users/admin/Documents/SuperAI_WebProject/SuperAI_WebProject_Auth_副本/rpc",
+			path:    authDir,
 			command: []string{"go", "run", "authservice.go"},
 			delay:   2 * time.Second,
-		},
-		{
+		})
+	}
+
+	services = append(services,
+		serviceDef{
 			name:    "Super Service",
-			path:    "/Users/admin/Documents/SuperAI_WebProject/backend/rpc",
-			command: []string{"go", "run", "super.go"},
+			path:    filepath.Join(root, "backend/rpc"),
+			command: []string{"go", "run", "superservice.go"},
 			delay:   2 * time.Second,
 		},
-		{
+		serviceDef{
 			name:    "API Service",
-			path:    "/Users/admin/Documents/SuperAI_WebProject/backend/api",
+			path:    filepath.Join(root, "backend/api"),
 			command: []string{"go", "run", "super.go"},
 			delay:   2 * time.Second,
 		},
-	}
+	)
 
 	fmt.Println("========================================")
 	fmt.Println("Super启动文件 - 统一管理服务启动")
@@ -42,37 +72,23 @@ func main() {
 
 	// 启动所有服务
 	for _, service := range services {
-		go func(s struct {
-			name    string
-			path    string
-			command []string
-			delay   time.Duration
-		}) {
+		go func(s serviceDef) {
 			// 延迟启动
 			time.Sleep(s.delay)
 
-			// 切换到服务目录
-			cwd, err := os.Getwd()
-			if err != nil {
-				log.Printf("%s - 无法获取当前目录: %v\n", s.name, err)
-				return
-			}
-
-			err = os.Chdir(s.path)
-			if err != nil {
-				log.Printf("%s - 无法切换到目录 %s: %v\n", s.name, s.path, err)
-				os.Chdir(cwd)
+			if _, err := os.Stat(s.path); err != nil {
+				log.Printf("%s - 无法访问目录 %s: %v\n", s.name, s.path, err)
 				return
 			}
-			defer os.Chdir(cwd)
 
-			// 启动服务
+			// 启动服务，在服务目录中运行
 			fmt.Printf("%s - 启动中...\n", s.name)
 			cmd := exec.Command(s.command[0], s.command[1:]...)
+			cmd.Dir = s.path
 			cmd.Stdout = os.Stdout
 			cmd.Stderr = os.Stderr
 
-			err = cmd.Run()
+			err := cmd.Run()
 			if err != nil {
 				log.Printf("%s - 启动失败: %v\n", s.name, err)
 			}
@@ -85,3 +101,24 @@ func main() {
 	// 保持主进程运行
 	select {}
 }
+
+// readAuthBackend 读取主服务配置中的 AuthBackend，未配置或读取失败时为默认的rpc
+func readAuthBackend(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Printf("无法读取配置文件 %s，按AuthBackend为rpc启动: %v\n", path, err)
+		return "rpc"
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), "AuthBackend:")
+		if !ok {
+			continue
+		}
+		value, _, _ = strings.Cut(value, "#")
+		return strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	return "rpc"
+}